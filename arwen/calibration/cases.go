@@ -0,0 +1,306 @@
+package calibration
+
+import (
+	"fmt"
+)
+
+// Gas schedule sections calibrated by the built-in cases
+const (
+	BigIntAPICostSection        = "BigIntAPICost"
+	ManagedBufferAPICostSection = "ManagedBufferAPICost"
+	CryptoAPICostSection        = "CryptoAPICost"
+	ElrondAPICostSection        = "ElrondAPICost"
+	WASMOpcodeCostSection       = "WASMOpcodeCost"
+)
+
+// Case is a micro-contract which measures the cost of one EEI function or of
+// one class of WASM opcodes, together with the gas schedule entries it calibrates.
+type Case struct {
+	Name       string
+	Section    string
+	GasEntries []string
+
+	// PerInstruction means that the time measured for an iteration of the
+	// body is spread evenly over its instructions, instead of being
+	// attributed entirely to the calibrated entries.
+	PerInstruction bool
+
+	Data  []byte
+	Setup []Instruction
+	Body  []Instruction
+}
+
+// Module returns the micro-contract of the case, running the given number of iterations
+func (c *Case) Module(iterations int32) (*Module, error) {
+	imports, err := importsOf(c.Setup, c.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", c.Name, err)
+	}
+
+	return &Module{
+		Imports:    imports,
+		Data:       c.Data,
+		Setup:      c.Setup,
+		Body:       c.Body,
+		Iterations: iterations,
+	}, nil
+}
+
+// eeiSignatures holds the WASM signatures of the EEI functions used by the calibration cases
+var eeiSignatures = map[string]Signature{
+	"bigIntNew":               {Params: []ValueType{I64}, Results: []ValueType{I32}},
+	"bigIntSetInt64":          {Params: []ValueType{I32, I64}},
+	"bigIntGetInt64":          {Params: []ValueType{I32}, Results: []ValueType{I64}},
+	"bigIntAdd":               {Params: []ValueType{I32, I32, I32}},
+	"bigIntSub":               {Params: []ValueType{I32, I32, I32}},
+	"bigIntMul":               {Params: []ValueType{I32, I32, I32}},
+	"bigIntTDiv":              {Params: []ValueType{I32, I32, I32}},
+	"bigIntPow":               {Params: []ValueType{I32, I32, I32}},
+	"bigIntModPow":            {Params: []ValueType{I32, I32, I32, I32}},
+	"bigIntModInverse":        {Params: []ValueType{I32, I32, I32}},
+	"bigIntGCD":               {Params: []ValueType{I32, I32, I32}},
+	"bigIntSqrt":              {Params: []ValueType{I32, I32}},
+	"bigIntCmp":               {Params: []ValueType{I32, I32}, Results: []ValueType{I32}},
+	"mBufferNew":              {Results: []ValueType{I32}},
+	"mBufferSetBytes":         {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"mBufferGetLength":        {Params: []ValueType{I32}, Results: []ValueType{I32}},
+	"mBufferGetBytes":         {Params: []ValueType{I32, I32}, Results: []ValueType{I32}},
+	"mBufferCopyByteSlice":    {Params: []ValueType{I32, I32, I32, I32}, Results: []ValueType{I32}},
+	"mBufferAppend":           {Params: []ValueType{I32, I32}, Results: []ValueType{I32}},
+	"mBufferToBigIntUnsigned": {Params: []ValueType{I32, I32}, Results: []ValueType{I32}},
+	"mBufferStorageStore":     {Params: []ValueType{I32, I32}, Results: []ValueType{I32}},
+	"mBufferStorageLoad":      {Params: []ValueType{I32, I32}, Results: []ValueType{I32}},
+	"sha256":                  {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"keccak256":               {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"ripemd160":               {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"sha512":                  {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"sha3256":                 {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"blake2b256":              {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"blake2b512":              {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"blake2s256":              {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+	"storageStore":            {Params: []ValueType{I32, I32, I32, I32}, Results: []ValueType{I32}},
+	"storageLoad":             {Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}},
+}
+
+func importsOf(instructionLists ...[]Instruction) ([]Import, error) {
+	imports := make([]Import, 0)
+	seen := make(map[string]bool)
+	for _, instructions := range instructionLists {
+		for _, instruction := range instructions {
+			if len(instruction.callee) == 0 || instruction.callee == noopFunctionName || seen[instruction.callee] {
+				continue
+			}
+
+			signature, ok := eeiSignatures[instruction.callee]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownEEIFunction, instruction.callee)
+			}
+			seen[instruction.callee] = true
+			imports = append(imports, Import{Name: instruction.callee, Signature: signature})
+		}
+	}
+	return imports, nil
+}
+
+// The memory of every EEI micro-contract starts with a 32-byte key, followed
+// by a 32-byte value; the following 32 bytes are used for results.
+const (
+	keyOffset    = 0
+	valueOffset  = 32
+	resultOffset = 64
+	chunkLength  = 32
+)
+
+var calibrationData = []byte("calibration.key.0123456789abcdef" + "calibration.value.0123456789abcd")
+
+// Scratch locals of the bench function
+const (
+	firstHandle  = 1
+	secondHandle = 2
+	thirdHandle  = 3
+)
+
+// BaselineCase is an empty loop, whose time is subtracted from every other case
+var BaselineCase = &Case{
+	Name: "Baseline",
+}
+
+func eeiCase(section string, entry string, setup []Instruction, body ...Instruction) *Case {
+	return &Case{
+		Name:       section + "." + entry,
+		Section:    section,
+		GasEntries: []string{entry},
+		Data:       calibrationData,
+		Setup:      setup,
+		Body:       body,
+	}
+}
+
+func bigIntSetup() []Instruction {
+	return []Instruction{
+		I64Const(1234567890123456789), Call("bigIntNew"), LocalSet(firstHandle),
+		I64Const(3), Call("bigIntNew"), LocalSet(secondHandle),
+		I64Const(0), Call("bigIntNew"), LocalSet(thirdHandle),
+	}
+}
+
+func binaryBigIntOp(name string) []Instruction {
+	return []Instruction{LocalGet(thirdHandle), LocalGet(firstHandle), LocalGet(secondHandle), Call(name)}
+}
+
+// BigIntCases returns the calibration cases of the bigInt EEI family
+func BigIntCases() []*Case {
+	return []*Case{
+		eeiCase(BigIntAPICostSection, "BigIntNew", nil,
+			I64Const(1234567), Call("bigIntNew"), Drop()),
+		eeiCase(BigIntAPICostSection, "BigIntSetInt64", bigIntSetup(),
+			LocalGet(thirdHandle), I64Const(1234567), Call("bigIntSetInt64")),
+		eeiCase(BigIntAPICostSection, "BigIntGetInt64", bigIntSetup(),
+			LocalGet(secondHandle), Call("bigIntGetInt64"), Drop()),
+		eeiCase(BigIntAPICostSection, "BigIntAdd", bigIntSetup(), binaryBigIntOp("bigIntAdd")...),
+		eeiCase(BigIntAPICostSection, "BigIntSub", bigIntSetup(), binaryBigIntOp("bigIntSub")...),
+		eeiCase(BigIntAPICostSection, "BigIntMul", bigIntSetup(), binaryBigIntOp("bigIntMul")...),
+		eeiCase(BigIntAPICostSection, "BigIntTDiv", bigIntSetup(), binaryBigIntOp("bigIntTDiv")...),
+		eeiCase(BigIntAPICostSection, "BigIntPow", bigIntSetup(), binaryBigIntOp("bigIntPow")...),
+		eeiCase(BigIntAPICostSection, "BigIntModPow", bigIntSetup(),
+			LocalGet(thirdHandle), LocalGet(secondHandle), LocalGet(firstHandle), LocalGet(firstHandle), Call("bigIntModPow")),
+		eeiCase(BigIntAPICostSection, "BigIntModInverse",
+			append(bigIntSetup(), I64Const(1000000007), Call("bigIntNew"), LocalSet(thirdHandle)),
+			LocalGet(firstHandle), LocalGet(secondHandle), LocalGet(thirdHandle), Call("bigIntModInverse")),
+		eeiCase(BigIntAPICostSection, "BigIntGCD", bigIntSetup(), binaryBigIntOp("bigIntGCD")...),
+		eeiCase(BigIntAPICostSection, "BigIntSqrt", bigIntSetup(),
+			LocalGet(thirdHandle), LocalGet(firstHandle), Call("bigIntSqrt")),
+		eeiCase(BigIntAPICostSection, "BigIntCmp", bigIntSetup(),
+			LocalGet(firstHandle), LocalGet(secondHandle), Call("bigIntCmp"), Drop()),
+	}
+}
+
+func managedBufferSetup() []Instruction {
+	return []Instruction{
+		Call("mBufferNew"), LocalSet(firstHandle),
+		LocalGet(firstHandle), I32Const(keyOffset), I32Const(chunkLength), Call("mBufferSetBytes"), Drop(),
+		Call("mBufferNew"), LocalSet(secondHandle),
+		LocalGet(secondHandle), I32Const(valueOffset), I32Const(chunkLength), Call("mBufferSetBytes"), Drop(),
+	}
+}
+
+// ManagedBufferCases returns the calibration cases of the managed buffer EEI family
+func ManagedBufferCases() []*Case {
+	return []*Case{
+		eeiCase(ManagedBufferAPICostSection, "MBufferNew", nil,
+			Call("mBufferNew"), Drop()),
+		eeiCase(ManagedBufferAPICostSection, "MBufferSetBytes", managedBufferSetup(),
+			LocalGet(secondHandle), I32Const(valueOffset), I32Const(chunkLength), Call("mBufferSetBytes"), Drop()),
+		eeiCase(ManagedBufferAPICostSection, "MBufferGetLength", managedBufferSetup(),
+			LocalGet(firstHandle), Call("mBufferGetLength"), Drop()),
+		eeiCase(ManagedBufferAPICostSection, "MBufferGetBytes", managedBufferSetup(),
+			LocalGet(firstHandle), I32Const(resultOffset), Call("mBufferGetBytes"), Drop()),
+		eeiCase(ManagedBufferAPICostSection, "MBufferCopyByteSlice", managedBufferSetup(),
+			LocalGet(firstHandle), I32Const(0), I32Const(chunkLength/2), LocalGet(secondHandle), Call("mBufferCopyByteSlice"), Drop()),
+		eeiCase(ManagedBufferAPICostSection, "MBufferAppend", managedBufferSetup(),
+			LocalGet(secondHandle), LocalGet(firstHandle), Call("mBufferAppend"), Drop()),
+		eeiCase(ManagedBufferAPICostSection, "MBufferToBigIntUnsigned",
+			append(managedBufferSetup(), I64Const(0), Call("bigIntNew"), LocalSet(thirdHandle)),
+			LocalGet(firstHandle), LocalGet(thirdHandle), Call("mBufferToBigIntUnsigned"), Drop()),
+	}
+}
+
+// CryptoCases returns the calibration cases of the crypto EEI family
+func CryptoCases() []*Case {
+	hashCase := func(entry string, function string) *Case {
+		return eeiCase(CryptoAPICostSection, entry, nil,
+			I32Const(keyOffset), I32Const(2*chunkLength), I32Const(resultOffset), Call(function), Drop())
+	}
+
+	return []*Case{
+		hashCase("SHA256", "sha256"),
+		hashCase("Keccak256", "keccak256"),
+		hashCase("Ripemd160", "ripemd160"),
+		hashCase("SHA512", "sha512"),
+		hashCase("SHA3256", "sha3256"),
+		hashCase("Blake2b256", "blake2b256"),
+		hashCase("Blake2b512", "blake2b512"),
+		hashCase("Blake2s256", "blake2s256"),
+	}
+}
+
+// StorageCases returns the calibration cases of the storage EEI family
+func StorageCases() []*Case {
+	storeOnce := []Instruction{
+		I32Const(keyOffset), I32Const(chunkLength), I32Const(valueOffset), I32Const(chunkLength), Call("storageStore"), Drop(),
+	}
+
+	return []*Case{
+		eeiCase(ElrondAPICostSection, "StorageStore", nil,
+			I32Const(keyOffset), I32Const(chunkLength), I32Const(valueOffset), I32Const(chunkLength), Call("storageStore"), Drop()),
+		eeiCase(ElrondAPICostSection, "StorageLoad", storeOnce,
+			I32Const(keyOffset), I32Const(chunkLength), I32Const(resultOffset), Call("storageLoad"), Drop()),
+		eeiCase(ManagedBufferAPICostSection, "MBufferStorageStore", managedBufferSetup(),
+			LocalGet(firstHandle), LocalGet(secondHandle), Call("mBufferStorageStore"), Drop()),
+		eeiCase(ManagedBufferAPICostSection, "MBufferStorageLoad",
+			append(managedBufferSetup(), LocalGet(firstHandle), LocalGet(secondHandle), Call("mBufferStorageStore"), Drop()),
+			LocalGet(firstHandle), LocalGet(secondHandle), Call("mBufferStorageLoad"), Drop()),
+	}
+}
+
+func opcodeCase(class string, entries []string, body ...Instruction) *Case {
+	return &Case{
+		Name:           WASMOpcodeCostSection + "." + class,
+		Section:        WASMOpcodeCostSection,
+		GasEntries:     entries,
+		PerInstruction: true,
+		Body:           body,
+	}
+}
+
+// OpcodeCases returns one calibration case for each class of WASM opcodes.
+// Each case executes a representative opcode of its class, and the
+// resulting cost per instruction is proposed for all the opcodes of the class.
+func OpcodeCases() []*Case {
+	return []*Case{
+		opcodeCase("Locals",
+			[]string{"LocalGet", "LocalSet", "LocalTee", "GlobalGet", "GlobalSet", "I32Const", "I64Const", "Drop", "Select", "Nop"},
+			LocalGet(firstHandle), LocalSet(secondHandle)),
+		opcodeCase("I32Arithmetic",
+			[]string{"I32Add", "I32Sub", "I32And", "I32Or", "I32Xor", "I32Shl", "I32ShrS", "I32ShrU", "I32Rotl", "I32Rotr",
+				"I32Eqz", "I32Eq", "I32Ne", "I32LtS", "I32LtU", "I32GtS", "I32GtU", "I32LeS", "I32LeU", "I32GeS", "I32GeU"},
+			LocalGet(firstHandle), I32Const(3), Op(opI32Add), LocalSet(firstHandle)),
+		opcodeCase("I32Multiplication",
+			[]string{"I32Mul"},
+			LocalGet(firstHandle), I32Const(3), Op(opI32Mul), LocalSet(firstHandle)),
+		opcodeCase("I32Division",
+			[]string{"I32DivS", "I32DivU", "I32RemS", "I32RemU"},
+			I32Const(1000003), I32Const(7), Op(opI32DivU), LocalSet(firstHandle)),
+		opcodeCase("I64Arithmetic",
+			[]string{"I64Add", "I64Sub", "I64And", "I64Or", "I64Xor", "I64Shl", "I64ShrS", "I64ShrU", "I64Rotl", "I64Rotr",
+				"I64Eqz", "I64Eq", "I64Ne", "I64LtS", "I64LtU", "I64GtS", "I64GtU", "I64LeS", "I64LeU", "I64GeS", "I64GeU"},
+			LocalGet(i64Local), I64Const(3), Op(opI64Add), LocalSet(i64Local)),
+		opcodeCase("I64Multiplication",
+			[]string{"I64Mul"},
+			LocalGet(i64Local), I64Const(3), Op(opI64Mul), LocalSet(i64Local)),
+		opcodeCase("I64Division",
+			[]string{"I64DivS", "I64DivU", "I64RemS", "I64RemU"},
+			I64Const(1000000000039), I64Const(7), Op(opI64DivU), LocalSet(i64Local)),
+		opcodeCase("MemoryLoad",
+			[]string{"I32Load", "I64Load", "I32Load8S", "I32Load8U", "I32Load16S", "I32Load16U",
+				"I64Load8S", "I64Load8U", "I64Load16S", "I64Load16U", "I64Load32S", "I64Load32U"},
+			I32Const(resultOffset), Load(opI64Load), LocalSet(i64Local)),
+		opcodeCase("MemoryStore",
+			[]string{"I32Store", "I64Store", "I32Store8", "I32Store16", "I64Store8", "I64Store16", "I64Store32"},
+			I32Const(resultOffset), LocalGet(i64Local), Store(opI64Store)),
+		opcodeCase("Call",
+			[]string{"Call"},
+			Call(noopFunctionName)),
+	}
+}
+
+// AllCases returns the calibration cases of all the EEI families and opcode classes
+func AllCases() []*Case {
+	cases := make([]*Case, 0)
+	cases = append(cases, BigIntCases()...)
+	cases = append(cases, ManagedBufferCases()...)
+	cases = append(cases, CryptoCases()...)
+	cases = append(cases, StorageCases()...)
+	cases = append(cases, OpcodeCases()...)
+	return cases
+}
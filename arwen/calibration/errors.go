@@ -0,0 +1,24 @@
+package calibration
+
+import "errors"
+
+// ErrDuplicateImport signals that a micro-contract imports the same function twice
+var ErrDuplicateImport = errors.New("duplicate import")
+
+// ErrUnknownImport signals that a micro-contract calls a function which it does not import
+var ErrUnknownImport = errors.New("unknown import")
+
+// ErrUnknownEEIFunction signals that a calibration case uses an EEI function without a known signature
+var ErrUnknownEEIFunction = errors.New("unknown EEI function")
+
+// ErrInvalidGasPerNanosecond signals that the target gas per nanosecond is not strictly positive
+var ErrInvalidGasPerNanosecond = errors.New("invalid gas per nanosecond")
+
+// ErrInvalidIterations signals that the number of iterations of a measurement is not strictly positive
+var ErrInvalidIterations = errors.New("invalid number of iterations")
+
+// ErrExecutionFailed signals that a micro-contract did not execute successfully
+var ErrExecutionFailed = errors.New("micro-contract execution failed")
+
+// ErrUnknownGasScheduleEntry signals that a calibration case refers to an entry missing from the gas schedule
+var ErrUnknownGasScheduleEntry = errors.New("unknown gas schedule entry")
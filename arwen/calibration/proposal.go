@@ -0,0 +1,141 @@
+package calibration
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+)
+
+// Outlier is a gas schedule entry whose current cost is far from the cost proposed by its measurement
+type Outlier struct {
+	Section             string
+	Entry               string
+	CurrentCost         uint64
+	ProposedCost        uint64
+	MeasuredNanoseconds float64
+}
+
+// Ratio returns the current cost divided by the proposed cost
+func (outlier *Outlier) Ratio() float64 {
+	return float64(outlier.CurrentCost) / float64(outlier.ProposedCost)
+}
+
+// deviation is symmetrical for overpriced and underpriced entries
+func (outlier *Outlier) deviation() float64 {
+	return math.Abs(math.Log(outlier.Ratio()))
+}
+
+// Proposal holds a gas schedule computed from measurements, along with the outliers of the current schedule
+type Proposal struct {
+	GasSchedule config.GasScheduleMap
+	Outliers    []*Outlier
+}
+
+// ProposeGasSchedule returns a copy of the current gas schedule, where every
+// entry calibrated by a measurement is replaced by its measured time, minus
+// the baseline, scaled by gasPerNanosecond. Entries whose current cost
+// differs from the proposed cost by more than a factor of outlierRatio, in
+// either direction, are reported as outliers, most deviating first.
+func ProposeGasSchedule(
+	current config.GasScheduleMap,
+	baseline *Measurement,
+	measurements []*Measurement,
+	gasPerNanosecond float64,
+	outlierRatio float64,
+) (*Proposal, error) {
+	if gasPerNanosecond <= 0 || math.IsNaN(gasPerNanosecond) || math.IsInf(gasPerNanosecond, 0) {
+		return nil, ErrInvalidGasPerNanosecond
+	}
+
+	proposal := &Proposal{
+		GasSchedule: copyGasSchedule(current),
+		Outliers:    make([]*Outlier, 0),
+	}
+
+	for _, measurement := range measurements {
+		nanoseconds := measurement.NanosecondsPerEntry(baseline)
+		proposedCost := costFromNanoseconds(nanoseconds, gasPerNanosecond)
+
+		section, ok := proposal.GasSchedule[measurement.Case.Section]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownGasScheduleEntry, measurement.Case.Section)
+		}
+
+		for _, entry := range measurement.Case.GasEntries {
+			currentCost, ok := current[measurement.Case.Section][entry]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s.%s", ErrUnknownGasScheduleEntry, measurement.Case.Section, entry)
+			}
+			section[entry] = proposedCost
+
+			outlier := &Outlier{
+				Section:             measurement.Case.Section,
+				Entry:               entry,
+				CurrentCost:         currentCost,
+				ProposedCost:        proposedCost,
+				MeasuredNanoseconds: nanoseconds,
+			}
+			if isOutlier(outlier, outlierRatio) {
+				proposal.Outliers = append(proposal.Outliers, outlier)
+			}
+		}
+	}
+
+	sort.SliceStable(proposal.Outliers, func(i, j int) bool {
+		return proposal.Outliers[i].deviation() > proposal.Outliers[j].deviation()
+	})
+
+	return proposal, nil
+}
+
+// costFromNanoseconds never returns 0, because zero costs are rejected by
+// config.CreateGasConfig, and stays within the uint32 range of the opcode costs
+func costFromNanoseconds(nanoseconds float64, gasPerNanosecond float64) uint64 {
+	cost := math.Round(nanoseconds * gasPerNanosecond)
+	if cost < 1 {
+		return 1
+	}
+	if cost >= math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint64(cost)
+}
+
+func isOutlier(outlier *Outlier, outlierRatio float64) bool {
+	if outlier.CurrentCost == 0 {
+		return true
+	}
+	ratio := outlier.Ratio()
+	return ratio > outlierRatio || ratio < 1/outlierRatio
+}
+
+func copyGasSchedule(gasSchedule config.GasScheduleMap) config.GasScheduleMap {
+	result := make(config.GasScheduleMap, len(gasSchedule))
+	for sectionName, section := range gasSchedule {
+		sectionCopy := make(map[string]uint64, len(section))
+		for entry, cost := range section {
+			sectionCopy[entry] = cost
+		}
+		result[sectionName] = sectionCopy
+	}
+	return result
+}
+
+// FormatOutliers renders the outliers as a human-readable table
+func FormatOutliers(outliers []*Outlier) string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("%-50s %12s %12s %10s %12s\n", "entry", "current", "proposed", "ratio", "ns"))
+	for _, outlier := range outliers {
+		sb.WriteString(fmt.Sprintf("%-50s %12d %12d %10.2f %12.2f\n",
+			outlier.Section+"."+outlier.Entry,
+			outlier.CurrentCost,
+			outlier.ProposedCost,
+			outlier.Ratio(),
+			outlier.MeasuredNanoseconds,
+		))
+	}
+	return sb.String()
+}
@@ -0,0 +1,124 @@
+package calibration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gasSchedules "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwenmandos/gasSchedules"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGasSchedule() config.GasScheduleMap {
+	return config.GasScheduleMap{
+		BigIntAPICostSection: {
+			"BigIntAdd": 2000,
+			"BigIntMul": 6000,
+			"BigIntNew": 10,
+		},
+		WASMOpcodeCostSection: {
+			"I32Add": 1,
+			"I32Sub": 1,
+		},
+	}
+}
+
+func TestProposeGasSchedule(t *testing.T) {
+	current := createTestGasSchedule()
+	baseline := &Measurement{Case: BaselineCase, Iterations: 1000, Elapsed: 100 * time.Microsecond}
+	measurements := []*Measurement{
+		{
+			Case:       &Case{Section: BigIntAPICostSection, GasEntries: []string{"BigIntAdd"}},
+			Iterations: 1000,
+			Elapsed:    300 * time.Microsecond,
+		},
+		{
+			Case:       &Case{Section: BigIntAPICostSection, GasEntries: []string{"BigIntMul"}},
+			Iterations: 1000,
+			Elapsed:    50 * time.Microsecond,
+		},
+		{
+			Case: &Case{
+				Section:        WASMOpcodeCostSection,
+				GasEntries:     []string{"I32Add", "I32Sub"},
+				PerInstruction: true,
+				Body:           []Instruction{I32Const(1), I32Const(2), Op(opI32Add), Drop()},
+			},
+			Iterations: 1000,
+			Elapsed:    180 * time.Microsecond,
+		},
+	}
+
+	proposal, err := ProposeGasSchedule(current, baseline, measurements, 10, 4)
+	require.Nil(t, err)
+
+	// (300us - 100us) / 1000 iterations = 200ns, at 10 gas/ns
+	require.Equal(t, uint64(2000), proposal.GasSchedule[BigIntAPICostSection]["BigIntAdd"])
+	// faster than the baseline, clamped to the minimum cost
+	require.Equal(t, uint64(1), proposal.GasSchedule[BigIntAPICostSection]["BigIntMul"])
+	// not measured, left unchanged
+	require.Equal(t, uint64(10), proposal.GasSchedule[BigIntAPICostSection]["BigIntNew"])
+	// (180us - 100us) / 1000 iterations / 4 instructions = 20ns, at 10 gas/ns
+	require.Equal(t, uint64(200), proposal.GasSchedule[WASMOpcodeCostSection]["I32Add"])
+	require.Equal(t, uint64(200), proposal.GasSchedule[WASMOpcodeCostSection]["I32Sub"])
+
+	// the current schedule is not modified
+	require.Equal(t, createTestGasSchedule(), current)
+
+	require.Len(t, proposal.Outliers, 3)
+	require.Equal(t, "BigIntMul", proposal.Outliers[0].Entry)
+	require.Equal(t, 6000.0, proposal.Outliers[0].Ratio())
+	require.Equal(t, "I32Add", proposal.Outliers[1].Entry)
+	require.Equal(t, "I32Sub", proposal.Outliers[2].Entry)
+	require.Equal(t, 20.0, proposal.Outliers[2].MeasuredNanoseconds)
+
+	report := FormatOutliers(proposal.Outliers)
+	require.Contains(t, report, "BigIntAPICost.BigIntMul")
+	require.Contains(t, report, "WASMOpcodeCost.I32Sub")
+	require.NotContains(t, report, "BigIntAdd")
+}
+
+func TestProposeGasSchedule_InvalidGasPerNanosecond(t *testing.T) {
+	proposal, err := ProposeGasSchedule(createTestGasSchedule(), nil, nil, 0, 2)
+	require.Nil(t, proposal)
+	require.Equal(t, ErrInvalidGasPerNanosecond, err)
+
+	proposal, err = ProposeGasSchedule(createTestGasSchedule(), nil, nil, -1, 2)
+	require.Nil(t, proposal)
+	require.Equal(t, ErrInvalidGasPerNanosecond, err)
+}
+
+func TestProposeGasSchedule_UnknownEntry(t *testing.T) {
+	measurements := []*Measurement{
+		{
+			Case:       &Case{Section: BigIntAPICostSection, GasEntries: []string{"BigIntFoo"}},
+			Iterations: 1,
+			Elapsed:    time.Microsecond,
+		},
+	}
+	proposal, err := ProposeGasSchedule(createTestGasSchedule(), nil, measurements, 1, 2)
+	require.Nil(t, proposal)
+	require.True(t, errors.Is(err, ErrUnknownGasScheduleEntry))
+
+	measurements[0].Case.Section = "FooAPICost"
+	proposal, err = ProposeGasSchedule(createTestGasSchedule(), nil, measurements, 1, 2)
+	require.Nil(t, proposal)
+	require.True(t, errors.Is(err, ErrUnknownGasScheduleEntry))
+}
+
+func TestProposeGasSchedule_AllCasesMatchGasSchedule(t *testing.T) {
+	gasMap, err := gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
+	require.Nil(t, err)
+
+	measurements := make([]*Measurement, 0)
+	for _, c := range AllCases() {
+		measurements = append(measurements, &Measurement{Case: c, Iterations: 1, Elapsed: time.Microsecond})
+	}
+
+	proposal, err := ProposeGasSchedule(gasMap, nil, measurements, 0.5, 2)
+	require.Nil(t, err)
+
+	_, err = config.CreateGasConfig(proposal.GasSchedule)
+	require.Nil(t, err)
+}
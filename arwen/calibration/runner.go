@@ -0,0 +1,172 @@
+package calibration
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const calibrationGasProvided = uint64(1 << 60)
+
+var calibrationCaller = []byte("calibrationCaller_______________")
+
+// Measurement is the time taken by the micro-contract of a case
+type Measurement struct {
+	Case       *Case
+	Iterations int32
+	Elapsed    time.Duration
+	GasUsed    uint64
+}
+
+// NanosecondsPerIteration returns the time of a single iteration of the
+// measured loop, after subtracting the time of the baseline loop.
+func (measurement *Measurement) NanosecondsPerIteration(baseline *Measurement) float64 {
+	elapsed := measurement.Elapsed
+	if baseline != nil {
+		elapsed -= baseline.Elapsed
+	}
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return float64(elapsed.Nanoseconds()) / float64(measurement.Iterations)
+}
+
+// NanosecondsPerEntry returns the time attributed to each of the gas
+// schedule entries calibrated by the case.
+func (measurement *Measurement) NanosecondsPerEntry(baseline *Measurement) float64 {
+	perIteration := measurement.NanosecondsPerIteration(baseline)
+	if measurement.Case.PerInstruction && len(measurement.Case.Body) > 0 {
+		return perIteration / float64(len(measurement.Case.Body))
+	}
+	return perIteration
+}
+
+// Runner executes calibration cases as smart contract calls on a VM backed by a MockWorld
+type Runner struct {
+	vm          vmcommon.VMExecutionHandler
+	world       *worldmock.MockWorld
+	iterations  int32
+	repetitions int
+	nextAccount int
+}
+
+// NewRunner creates a Runner which measures each case by executing its
+// micro-contract for the given number of iterations, keeping the fastest
+// of the given number of repetitions.
+func NewRunner(vm vmcommon.VMExecutionHandler, world *worldmock.MockWorld, iterations int32, repetitions int) (*Runner, error) {
+	if iterations <= 0 || repetitions <= 0 {
+		return nil, ErrInvalidIterations
+	}
+
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address: calibrationCaller,
+		Nonce:   0,
+		Balance: big.NewInt(0),
+	})
+
+	return &Runner{
+		vm:          vm,
+		world:       world,
+		iterations:  iterations,
+		repetitions: repetitions,
+	}, nil
+}
+
+// MeasureBaseline measures the empty loop
+func (runner *Runner) MeasureBaseline() (*Measurement, error) {
+	return runner.Measure(BaselineCase)
+}
+
+// MeasureAll measures all the provided cases, in order
+func (runner *Runner) MeasureAll(cases []*Case) ([]*Measurement, error) {
+	measurements := make([]*Measurement, 0, len(cases))
+	for _, c := range cases {
+		measurement, err := runner.Measure(c)
+		if err != nil {
+			return nil, err
+		}
+		measurements = append(measurements, measurement)
+	}
+	return measurements, nil
+}
+
+// Measure deploys the micro-contract of the case into a fresh account and
+// times the execution of its bench function. The first execution, which also
+// compiles the contract, is not timed.
+func (runner *Runner) Measure(c *Case) (*Measurement, error) {
+	module, err := c.Module(runner.iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := module.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	address := runner.newContractAddress()
+	runner.world.AcctMap.CreateSmartContractAccount(calibrationCaller, address, code, runner.world)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  calibrationCaller,
+			Arguments:   [][]byte{},
+			CallValue:   big.NewInt(0),
+			CallType:    vm.DirectCall,
+			GasPrice:    0,
+			GasProvided: calibrationGasProvided,
+		},
+		RecipientAddr: address,
+		Function:      BenchFunctionName,
+	}
+
+	_, _, err = runner.execute(c, input)
+	if err != nil {
+		return nil, err
+	}
+
+	measurement := &Measurement{
+		Case:       c,
+		Iterations: runner.iterations,
+	}
+	for r := 0; r < runner.repetitions; r++ {
+		elapsed, gasUsed, err := runner.execute(c, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if r == 0 || elapsed < measurement.Elapsed {
+			measurement.Elapsed = elapsed
+			measurement.GasUsed = gasUsed
+		}
+	}
+
+	return measurement, nil
+}
+
+func (runner *Runner) execute(c *Case, input *vmcommon.ContractCallInput) (time.Duration, uint64, error) {
+	input.GasProvided = calibrationGasProvided
+
+	start := time.Now()
+	vmOutput, err := runner.vm.RunSmartContractCall(input)
+	elapsed := time.Since(start)
+	if err != nil {
+		return 0, 0, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return 0, 0, fmt.Errorf("%w: %s: %s (%s)", ErrExecutionFailed, c.Name, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return elapsed, calibrationGasProvided - vmOutput.GasRemaining, nil
+}
+
+func (runner *Runner) newContractAddress() []byte {
+	address := make([]byte, 32)
+	copy(address, fmt.Sprintf("calibration_%d", runner.nextAccount))
+	runner.nextAccount++
+	return address
+}
@@ -0,0 +1,350 @@
+package calibration
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+)
+
+// ValueType is the encoding of a WASM value type
+type ValueType byte
+
+const (
+	// I32 is the WASM i32 value type
+	I32 ValueType = 0x7f
+
+	// I64 is the WASM i64 value type
+	I64 ValueType = 0x7e
+)
+
+const (
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionMemory   = 5
+	sectionExport   = 7
+	sectionCode     = 10
+	sectionData     = 11
+
+	externalFunction = 0x00
+	externalMemory   = 0x02
+
+	funcTypeForm  = 0x60
+	blockTypeVoid = 0x40
+)
+
+const (
+	opBlock    = 0x02
+	opLoop     = 0x03
+	opBr       = 0x0c
+	opBrIf     = 0x0d
+	opEnd      = 0x0b
+	opCall     = 0x10
+	opDrop     = 0x1a
+	opLocalGet = 0x20
+	opLocalSet = 0x21
+	opI32Load  = 0x28
+	opI64Load  = 0x29
+	opI32Store = 0x36
+	opI64Store = 0x37
+	opI32Const = 0x41
+	opI64Const = 0x42
+	opI32Eqz   = 0x45
+	opI32Add   = 0x6a
+	opI32Sub   = 0x6b
+	opI32Mul   = 0x6c
+	opI32DivU  = 0x6e
+	opI64Add   = 0x7c
+	opI64Mul   = 0x7e
+	opI64DivU  = 0x80
+)
+
+// InitFunctionName is the name of the empty deployment function of a micro-contract
+const InitFunctionName = "init"
+
+// BenchFunctionName is the name of the function which runs the measured loop
+const BenchFunctionName = "bench"
+
+// noopFunctionName names the empty function defined in every micro-contract,
+// which is the target of the calls in the Call opcode class
+const noopFunctionName = "$noop"
+
+// memoryPages is the number of memory pages declared by a micro-contract
+const memoryPages = 2
+
+// The bench function has a loop counter at local 0, followed by
+// numI32Locals scratch i32 locals and a single i64 scratch local.
+const (
+	counterLocal = 0
+	numI32Locals = 4
+	i64Local     = numI32Locals + 1
+)
+
+// Signature describes the WASM signature of an imported EEI function,
+// excluding the implicit context pointer.
+type Signature struct {
+	Params  []ValueType
+	Results []ValueType
+}
+
+// Import is an EEI function imported from the "env" namespace
+type Import struct {
+	Name      string
+	Signature Signature
+}
+
+// Instruction is a single WASM instruction, encoded except for the function
+// index of calls, which is resolved when the module is generated.
+type Instruction struct {
+	code   []byte
+	callee string
+}
+
+// Op creates an instruction without immediate arguments
+func Op(opcode byte) Instruction {
+	return Instruction{code: []byte{opcode}}
+}
+
+// I32Const creates an i32.const instruction
+func I32Const(value int32) Instruction {
+	return Instruction{code: append([]byte{opI32Const}, signedLEB128(int64(value))...)}
+}
+
+// I64Const creates an i64.const instruction
+func I64Const(value int64) Instruction {
+	return Instruction{code: append([]byte{opI64Const}, signedLEB128(value)...)}
+}
+
+// LocalGet creates a local.get instruction
+func LocalGet(index uint32) Instruction {
+	return Instruction{code: append([]byte{opLocalGet}, arwen.U64ToLEB128(uint64(index))...)}
+}
+
+// LocalSet creates a local.set instruction
+func LocalSet(index uint32) Instruction {
+	return Instruction{code: append([]byte{opLocalSet}, arwen.U64ToLEB128(uint64(index))...)}
+}
+
+// Load creates a memory load instruction with zero alignment and offset
+func Load(opcode byte) Instruction {
+	return Instruction{code: []byte{opcode, 0, 0}}
+}
+
+// Store creates a memory store instruction with zero alignment and offset
+func Store(opcode byte) Instruction {
+	return Instruction{code: []byte{opcode, 0, 0}}
+}
+
+// Call creates a call to the imported function with the given name
+func Call(importName string) Instruction {
+	return Instruction{code: []byte{opCall}, callee: importName}
+}
+
+// Drop creates a drop instruction
+func Drop() Instruction {
+	return Op(opDrop)
+}
+
+// Module describes a generated micro-contract. The exported bench function
+// executes Setup once and then Body for the given number of iterations.
+type Module struct {
+	Imports    []Import
+	Data       []byte
+	Setup      []Instruction
+	Body       []Instruction
+	Iterations int32
+}
+
+// Generate encodes the module as WASM bytecode
+func (module *Module) Generate() ([]byte, error) {
+	functionIndices := make(map[string]uint32)
+	signatures := make([]Signature, 0)
+	signatureIndices := make(map[string]uint32)
+	importTypeIndices := make([]uint32, len(module.Imports))
+
+	typeIndexOf := func(signature Signature) uint32 {
+		key := signature.key()
+		index, ok := signatureIndices[key]
+		if !ok {
+			index = uint32(len(signatures))
+			signatures = append(signatures, signature)
+			signatureIndices[key] = index
+		}
+		return index
+	}
+
+	for i, imported := range module.Imports {
+		_, duplicate := functionIndices[imported.Name]
+		if duplicate {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateImport, imported.Name)
+		}
+		functionIndices[imported.Name] = uint32(i)
+		importTypeIndices[i] = typeIndexOf(imported.Signature)
+	}
+
+	voidType := typeIndexOf(Signature{})
+	numImports := uint32(len(module.Imports))
+	initIndex := numImports
+	benchIndex := numImports + 1
+	functionIndices[noopFunctionName] = numImports + 2
+
+	benchCode, err := module.benchFunctionCode(functionIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+	typeSection := vector(len(signatures))
+	for _, signature := range signatures {
+		typeSection = append(typeSection, signature.encode()...)
+	}
+	out = appendSection(out, sectionType, typeSection)
+
+	importSection := vector(len(module.Imports))
+	for i, imported := range module.Imports {
+		importSection = append(importSection, name("env")...)
+		importSection = append(importSection, name(imported.Name)...)
+		importSection = append(importSection, externalFunction)
+		importSection = append(importSection, arwen.U64ToLEB128(uint64(importTypeIndices[i]))...)
+	}
+	out = appendSection(out, sectionImport, importSection)
+
+	functionSection := vector(3)
+	for i := 0; i < 3; i++ {
+		functionSection = append(functionSection, arwen.U64ToLEB128(uint64(voidType))...)
+	}
+	out = appendSection(out, sectionFunction, functionSection)
+
+	memorySection := append(vector(1), 0x00)
+	memorySection = append(memorySection, arwen.U64ToLEB128(memoryPages)...)
+	out = appendSection(out, sectionMemory, memorySection)
+
+	exportSection := vector(3)
+	exportSection = append(exportSection, name("memory")...)
+	exportSection = append(exportSection, externalMemory, 0x00)
+	exportSection = append(exportSection, name(InitFunctionName)...)
+	exportSection = append(exportSection, externalFunction)
+	exportSection = append(exportSection, arwen.U64ToLEB128(uint64(initIndex))...)
+	exportSection = append(exportSection, name(BenchFunctionName)...)
+	exportSection = append(exportSection, externalFunction)
+	exportSection = append(exportSection, arwen.U64ToLEB128(uint64(benchIndex))...)
+	out = appendSection(out, sectionExport, exportSection)
+
+	emptyFunction := []byte{0x00, opEnd}
+	codeSection := vector(3)
+	codeSection = append(codeSection, sizePrefixed(emptyFunction)...)
+	codeSection = append(codeSection, sizePrefixed(benchCode)...)
+	codeSection = append(codeSection, sizePrefixed(emptyFunction)...)
+	out = appendSection(out, sectionCode, codeSection)
+
+	if len(module.Data) > 0 {
+		dataSection := append(vector(1), 0x00)
+		dataSection = append(dataSection, I32Const(0).code...)
+		dataSection = append(dataSection, opEnd)
+		dataSection = append(dataSection, arwen.U64ToLEB128(uint64(len(module.Data)))...)
+		dataSection = append(dataSection, module.Data...)
+		out = appendSection(out, sectionData, dataSection)
+	}
+
+	return out, nil
+}
+
+// BodyLength returns the number of instructions executed in each iteration,
+// excluding the loop control instructions.
+func (module *Module) BodyLength() int {
+	return len(module.Body)
+}
+
+func (module *Module) benchFunctionCode(functionIndices map[string]uint32) ([]byte, error) {
+	code := []byte{0x02}
+	code = append(code, arwen.U64ToLEB128(numI32Locals+1)...)
+	code = append(code, byte(I32))
+	code = append(code, 0x01, byte(I64))
+
+	instructions := make([]Instruction, 0, len(module.Setup)+len(module.Body)+16)
+	instructions = append(instructions, module.Setup...)
+	instructions = append(instructions,
+		I32Const(module.Iterations),
+		LocalSet(counterLocal),
+		Instruction{code: []byte{opBlock, blockTypeVoid}},
+		Instruction{code: []byte{opLoop, blockTypeVoid}},
+		LocalGet(counterLocal),
+		Op(opI32Eqz),
+		Instruction{code: []byte{opBrIf, 0x01}},
+	)
+	instructions = append(instructions, module.Body...)
+	instructions = append(instructions,
+		LocalGet(counterLocal),
+		I32Const(1),
+		Op(opI32Sub),
+		LocalSet(counterLocal),
+		Instruction{code: []byte{opBr, 0x00}},
+		Op(opEnd),
+		Op(opEnd),
+		Op(opEnd),
+	)
+
+	for _, instruction := range instructions {
+		code = append(code, instruction.code...)
+		if len(instruction.callee) == 0 {
+			continue
+		}
+
+		index, ok := functionIndices[instruction.callee]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownImport, instruction.callee)
+		}
+		code = append(code, arwen.U64ToLEB128(uint64(index))...)
+	}
+
+	return code, nil
+}
+
+func (signature Signature) key() string {
+	return fmt.Sprintf("%v->%v", signature.Params, signature.Results)
+}
+
+func (signature Signature) encode() []byte {
+	out := []byte{funcTypeForm}
+	out = append(out, arwen.U64ToLEB128(uint64(len(signature.Params)))...)
+	for _, param := range signature.Params {
+		out = append(out, byte(param))
+	}
+	out = append(out, arwen.U64ToLEB128(uint64(len(signature.Results)))...)
+	for _, result := range signature.Results {
+		out = append(out, byte(result))
+	}
+	return out
+}
+
+func appendSection(out []byte, id byte, content []byte) []byte {
+	out = append(out, id)
+	return append(out, sizePrefixed(content)...)
+}
+
+func sizePrefixed(content []byte) []byte {
+	return append(arwen.U64ToLEB128(uint64(len(content))), content...)
+}
+
+func vector(length int) []byte {
+	return arwen.U64ToLEB128(uint64(length))
+}
+
+func name(value string) []byte {
+	return sizePrefixed([]byte(value))
+}
+
+// signedLEB128 encodes an int64 using signed LEB128, as required by the
+// immediate arguments of the WASM const instructions.
+func signedLEB128(n int64) (out []byte) {
+	for {
+		b := byte(n & 0x7F)
+		n >>= 7
+		signBitSet := b&0x40 != 0
+		if (n == 0 && !signBitSet) || (n == -1 && signBitSet) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
@@ -0,0 +1,89 @@
+package calibration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedLEB128(t *testing.T) {
+	require.Equal(t, []byte{0x00}, signedLEB128(0))
+	require.Equal(t, []byte{0x01}, signedLEB128(1))
+	require.Equal(t, []byte{0x7f}, signedLEB128(-1))
+	require.Equal(t, []byte{0x3f}, signedLEB128(63))
+	require.Equal(t, []byte{0xc0, 0x00}, signedLEB128(64))
+	require.Equal(t, []byte{0x40}, signedLEB128(-64))
+	require.Equal(t, []byte{0xbf, 0x7f}, signedLEB128(-65))
+	require.Equal(t, []byte{0xe5, 0x8e, 0x26}, signedLEB128(624485))
+	require.Equal(t, []byte{0xc0, 0xbb, 0x78}, signedLEB128(-123456))
+}
+
+func TestModule_Generate(t *testing.T) {
+	module := &Module{
+		Imports: []Import{
+			{Name: "bigIntNew", Signature: eeiSignatures["bigIntNew"]},
+		},
+		Body:       []Instruction{I64Const(5), Call("bigIntNew"), Drop()},
+		Iterations: 10,
+	}
+
+	code, err := module.Generate()
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}, code[:8])
+	require.Contains(t, string(code), "bigIntNew")
+	require.Contains(t, string(code), InitFunctionName)
+	require.Contains(t, string(code), BenchFunctionName)
+	require.Contains(t, string(code), "memory")
+}
+
+func TestModule_Generate_UnknownImport(t *testing.T) {
+	module := &Module{
+		Body:       []Instruction{Call("bigIntNew"), Drop()},
+		Iterations: 10,
+	}
+
+	code, err := module.Generate()
+	require.Nil(t, code)
+	require.True(t, errors.Is(err, ErrUnknownImport))
+}
+
+func TestModule_Generate_DuplicateImport(t *testing.T) {
+	imported := Import{Name: "mBufferNew", Signature: eeiSignatures["mBufferNew"]}
+	module := &Module{
+		Imports:    []Import{imported, imported},
+		Iterations: 10,
+	}
+
+	code, err := module.Generate()
+	require.Nil(t, code)
+	require.True(t, errors.Is(err, ErrDuplicateImport))
+}
+
+func TestCase_Module_UnknownEEIFunction(t *testing.T) {
+	c := &Case{
+		Name: "unknown",
+		Body: []Instruction{Call("notAnEEIFunction")},
+	}
+
+	module, err := c.Module(10)
+	require.Nil(t, module)
+	require.True(t, errors.Is(err, ErrUnknownEEIFunction))
+}
+
+func TestAllCases_Generate(t *testing.T) {
+	names := make(map[string]bool)
+	for _, c := range AllCases() {
+		require.False(t, names[c.Name], c.Name)
+		names[c.Name] = true
+		require.NotEmpty(t, c.GasEntries, c.Name)
+		require.NotEmpty(t, c.Body, c.Name)
+
+		module, err := c.Module(100)
+		require.Nil(t, err, c.Name)
+
+		code, err := module.Generate()
+		require.Nil(t, err, c.Name)
+		require.NotEmpty(t, code, c.Name)
+	}
+}
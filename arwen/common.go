@@ -2,6 +2,7 @@ package arwen
 
 import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -71,6 +72,29 @@ const ProtectedStoragePrefix = "ARWEN@"
 // TimeLockKeyPrefix is the storage key prefix used for timelock-related storage.
 const TimeLockKeyPrefix = ProtectedStoragePrefix + "TIMELOCK"
 
+// NonceLockKeyPrefix is the storage key prefix used for locks expiring at a block nonce.
+const NonceLockKeyPrefix = ProtectedStoragePrefix + "NONCELOCK"
+
+// RoundLockKeyPrefix is the storage key prefix used for locks expiring at a round.
+const RoundLockKeyPrefix = ProtectedStoragePrefix + "ROUNDLOCK"
+
+// StorageLocksKey is the storage key under which the locks held by a contract are registered.
+const StorageLocksKey = ProtectedStoragePrefix + "LOCKS"
+
+// StorageLockType encodes what the deadline of a storage lock refers to
+type StorageLockType int32
+
+const (
+	// TimestampLock is a lock expiring at a block timestamp
+	TimestampLock StorageLockType = iota
+
+	// NonceLock is a lock expiring at a block nonce
+	NonceLock
+
+	// RoundLock is a lock expiring at a round
+	RoundLock
+)
+
 // AsyncDataPrefix is the storage key prefix used for AsyncContext-related storage.
 const AsyncDataPrefix = ProtectedStoragePrefix + "ASYNC"
 
@@ -117,6 +141,39 @@ type CodeDeployInput struct {
 	CodeDeployerAddress  []byte
 }
 
+// StorageAccessType distinguishes the reads from the writes recorded by the StorageContext
+type StorageAccessType uint8
+
+const (
+	// StorageRead is a read of a storage key, metered or not
+	StorageRead StorageAccessType = iota
+
+	// StorageWrite is an attempt to write a storage key
+	StorageWrite
+)
+
+// StorageAccess describes a single access of the StorageContext to a storage key
+type StorageAccess struct {
+	Type        StorageAccessType
+	Address     []byte
+	Key         []byte
+	ValueLength int
+	UsedCache   bool
+	Status      StorageStatus
+	GasUsed     uint64
+	GasFreed    uint64
+}
+
+const (
+	// DefaultMaxManagedHandles is the number of handles the managed types of a
+	// transaction can hold at once, unless the host parameters set another one
+	DefaultMaxManagedHandles = 100000
+
+	// DefaultMaxManagedBytes is the number of bytes the managed types of a
+	// transaction can hold at once, unless the host parameters set another one
+	DefaultMaxManagedBytes = 32 * 1024 * 1024
+)
+
 // VMHostParameters represents the parameters to be passed to VMHost
 type VMHostParameters struct {
 	VMType                                          []byte
@@ -135,6 +192,35 @@ type VMHostParameters struct {
 	FixFailExecutionOnErrorEnableEpoch              uint32
 	TimeOutForSCExecutionInMilliseconds             uint32
 	ManagedCryptoAPIEnableEpoch                     uint32
+	SecureRandomnessEnableEpoch                     uint32
+	AsyncCallESDTPaymentEnableEpoch                 uint32
+	ExtendedStorageLocksEnableEpoch                 uint32
+	ManagedMapEnableEpoch                           uint32
+	ManagedVecEnableEpoch                           uint32
+	BigFloatEnableEpoch                             uint32
+	BigIntModularEnableEpoch                        uint32
+	ManagedBufferStringsEnableEpoch                 uint32
+	ManagedMemoryFreeEnableEpoch                    uint32
+	ExtendedHashingEnableEpoch                      uint32
+	Secp256ExtensionsEnableEpoch                    uint32
+	AggregatedBLSEnableEpoch                        uint32
+	BN254EnableEpoch                                uint32
+	Ed25519BatchEnableEpoch                         uint32
+	MerkleProofEnableEpoch                          uint32
+	AccountInfoEnableEpoch                          uint32
+	TxContextEnableEpoch                            uint32
+	FixAsyncCallbackResolutionEnableEpoch           uint32
+	NestedCallsGasPriceEnableEpoch                  uint32
+	ManagedMemoryLimitsEnableEpoch                  uint32
+	// MaxManagedHandles and MaxManagedBytes cap the resident managed memory of
+	// a transaction; zero selects DefaultMaxManagedHandles and DefaultMaxManagedBytes
+	MaxManagedHandles uint64
+	MaxManagedBytes   uint64
+	// VMCrypto replaces the default crypto implementation when not nil
+	VMCrypto crypto.VMCrypto
+	// ShardCoordinator provides the shard information to contracts, when the
+	// BlockchainHook is not a shard coordinator itself
+	ShardCoordinator vmcommon.Coordinator
 }
 
 // AsyncCallInfo contains the information required to handle the asynchronous call of another SmartContract
@@ -171,13 +257,17 @@ func (aci *AsyncCallInfo) GetValueBytes() []byte {
 	return aci.ValueBytes
 }
 
-// AsyncGeneratedCall holds the information abount an async call
+// AsyncGeneratedCall holds the information abount an async call; the fields
+// set only by createAsyncCallWithESDT are omitted when empty, so that the
+// stored async contexts of the other calls keep their serialization
 type AsyncGeneratedCall struct {
 	Status          AsyncCallStatus
 	Destination     []byte
 	Data            []byte
 	GasLimit        uint64
+	GasLocked       uint64 `json:",omitempty"`
 	ValueBytes      []byte
+	ESDTTransfers   []*vmcommon.ESDTTransfer `json:",omitempty"`
 	SuccessCallback string
 	ErrorCallback   string
 	ProvidedGas     uint64
@@ -215,7 +305,7 @@ func (ac *AsyncGeneratedCall) GetGasLimit() uint64 {
 
 // GetGasLocked returns the gas locked for the async callback
 func (ac *AsyncGeneratedCall) GetGasLocked() uint64 {
-	return 0
+	return ac.GasLocked
 }
 
 // GetValueBytes returns the byte representation of the value of the async call
@@ -223,6 +313,11 @@ func (ac *AsyncGeneratedCall) GetValueBytes() []byte {
 	return ac.ValueBytes
 }
 
+// HasESDTTransfers returns true if the async call carries an ESDT payment
+func (ac *AsyncGeneratedCall) HasESDTTransfers() bool {
+	return len(ac.ESDTTransfers) > 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ac *AsyncGeneratedCall) IsInterfaceNil() bool {
 	return ac == nil
@@ -12,9 +12,10 @@ import (
 var log = logger.GetOrCreate("arwen/blockchainContext")
 
 type blockchainContext struct {
-	host           arwen.VMHost
-	blockChainHook vmcommon.BlockchainHook
-	stateStack     []int
+	host             arwen.VMHost
+	blockChainHook   vmcommon.BlockchainHook
+	shardCoordinator vmcommon.Coordinator
+	stateStack       []int
 }
 
 // NewBlockchainContext creates a new blockchainContext
@@ -28,9 +29,20 @@ func NewBlockchainContext(
 		host:           host,
 	}
 
+	shardCoordinator, isCoordinator := blockChainHook.(vmcommon.Coordinator)
+	if isCoordinator {
+		context.shardCoordinator = shardCoordinator
+	}
+
 	return context, nil
 }
 
+// SetShardCoordinator sets the source of NumberOfShards and SelfShardID, which
+// otherwise is the BlockchainHook itself, if it also acts as a shard coordinator
+func (context *blockchainContext) SetShardCoordinator(shardCoordinator vmcommon.Coordinator) {
+	context.shardCoordinator = shardCoordinator
+}
+
 // NewAddress yields the address of a new SC account, when one such account is created.
 // The result should only depend on the creator address and nonce.
 // Returning an empty address lets the VM decide what the new address should be.
@@ -141,6 +153,17 @@ func (context *blockchainContext) GetCodeHash(address []byte) []byte {
 	return codeHash
 }
 
+// GetCodeMetadata returns the code metadata of the account mapped to the given
+// address, or nil if there is no such account
+func (context *blockchainContext) GetCodeMetadata(address []byte) []byte {
+	account := context.getExistingUserAccount(address)
+	if account == nil {
+		return nil
+	}
+
+	return account.GetCodeMetadata()
+}
+
 // GetCode returns the code that is set tho the given account
 func (context *blockchainContext) GetCode(address []byte) ([]byte, error) {
 	outputAccount, isNew := context.host.Output().GetOutputAccount(address)
@@ -259,11 +282,62 @@ func (context *blockchainContext) GetOwnerAddress() ([]byte, error) {
 	return scAccount.GetOwnerAddress(), nil
 }
 
+// GetAccountOwner returns the owner of the account mapped to the given address,
+// or nil if there is no such account
+func (context *blockchainContext) GetAccountOwner(address []byte) []byte {
+	account := context.getExistingUserAccount(address)
+	if account == nil {
+		return nil
+	}
+
+	return account.GetOwnerAddress()
+}
+
+// GetUsername returns the username of the account mapped to the given address,
+// or nil if there is no such account
+func (context *blockchainContext) GetUsername(address []byte) []byte {
+	account := context.getExistingUserAccount(address)
+	if account == nil {
+		return nil
+	}
+
+	return account.GetUserName()
+}
+
+func (context *blockchainContext) getExistingUserAccount(address []byte) vmcommon.UserAccountHandler {
+	account, err := context.blockChainHook.GetUserAccount(address)
+	if err != nil || arwen.IfNil(account) {
+		return nil
+	}
+
+	return account
+}
+
 // GetShardOfAddress returns the shard in which the address is present.
 func (context *blockchainContext) GetShardOfAddress(addr []byte) uint32 {
 	return context.blockChainHook.GetShardOfAddress(addr)
 }
 
+// NumberOfShards returns the number of shards known to the shard coordinator,
+// or 1 if there is no shard coordinator
+func (context *blockchainContext) NumberOfShards() uint32 {
+	if arwen.IfNil(context.shardCoordinator) {
+		return 1
+	}
+
+	return context.shardCoordinator.NumberOfShards()
+}
+
+// SelfShardID returns the shard in which the VM runs; without a shard
+// coordinator, this is the shard of the executing contract
+func (context *blockchainContext) SelfShardID() uint32 {
+	if arwen.IfNil(context.shardCoordinator) {
+		return context.GetShardOfAddress(context.host.Runtime().GetSCAddress())
+	}
+
+	return context.shardCoordinator.SelfId()
+}
+
 // IsSmartContract returns true if the current address is the address of a SC.
 func (context *blockchainContext) IsSmartContract(addr []byte) bool {
 	return context.blockChainHook.IsSmartContract(addr)
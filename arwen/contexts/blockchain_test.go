@@ -440,3 +440,62 @@ func TestBlockchainContext_Getters(t *testing.T) {
 	require.Equal(t, randomSeed1[:], blockchainContext.LastRandomSeed())
 	require.Equal(t, randomSeed2[:], blockchainContext.CurrentRandomSeed())
 }
+
+func TestBlockchainContext_AccountInfo(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostStub{}
+	mockWorld := worldmock.NewMockWorld()
+	mockWorld.AcctMap.PutAccount(&worldmock.Account{
+		Address:      []byte("account_sc"),
+		Balance:      big.NewInt(0),
+		CodeHash:     []byte("code hash"),
+		CodeMetadata: []byte{1, 2},
+		OwnerAddress: []byte("account_owner"),
+		Username:     []byte("alice.elrond"),
+	})
+
+	blockchainContext, _ := NewBlockchainContext(host, mockWorld)
+
+	require.Equal(t, []byte{1, 2}, blockchainContext.GetCodeMetadata([]byte("account_sc")))
+	require.Equal(t, []byte("account_owner"), blockchainContext.GetAccountOwner([]byte("account_sc")))
+	require.Equal(t, []byte("alice.elrond"), blockchainContext.GetUsername([]byte("account_sc")))
+
+	require.Nil(t, blockchainContext.GetCodeMetadata([]byte("account_missing")))
+	require.Nil(t, blockchainContext.GetAccountOwner([]byte("account_missing")))
+	require.Nil(t, blockchainContext.GetUsername([]byte("account_missing")))
+}
+
+func TestBlockchainContext_ShardInfo(t *testing.T) {
+	t.Parallel()
+
+	mockWorld := worldmock.NewMockWorld()
+	mockWorld.AcctMap.PutAccounts([]*worldmock.Account{
+		{Address: []byte("account_shard0"), ShardID: 0},
+		{Address: []byte("account_shard2"), ShardID: 2},
+	})
+	mockWorld.SelfShardID = 2
+
+	// the MockWorld acts as a shard coordinator as well
+	blockchainContext, _ := NewBlockchainContext(&contextmock.VMHostStub{}, mockWorld)
+	require.Equal(t, uint32(3), blockchainContext.NumberOfShards())
+	require.Equal(t, uint32(2), blockchainContext.SelfShardID())
+
+	// without a shard coordinator, the shard of the executing contract is used
+	host := &contextmock.VMHostMock{
+		RuntimeContext: &contextmock.RuntimeContextMock{SCAddress: []byte("account_sc")},
+	}
+	stubBlockchainHook := &contextmock.BlockchainHookStub{
+		GetShardOfAddressCalled: func(address []byte) uint32 {
+			require.Equal(t, []byte("account_sc"), address)
+			return 1
+		},
+	}
+	blockchainContext, _ = NewBlockchainContext(host, stubBlockchainHook)
+	require.Equal(t, uint32(1), blockchainContext.NumberOfShards())
+	require.Equal(t, uint32(1), blockchainContext.SelfShardID())
+
+	blockchainContext.SetShardCoordinator(mockWorld)
+	require.Equal(t, uint32(3), blockchainContext.NumberOfShards())
+	require.Equal(t, uint32(2), blockchainContext.SelfShardID())
+}
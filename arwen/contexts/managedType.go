@@ -7,6 +7,7 @@ import (
 	"io"
 	basicMath "math"
 	"math/big"
+	"sort"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
@@ -33,74 +34,157 @@ const handleLen = 4
 
 type managedBufferMap map[int32][]byte
 type bigIntMap map[int32]*big.Int
+type bigFloatMap map[int32]*big.Float
 type ellipticCurveMap map[int32]*elliptic.CurveParams
+type managedMapMap map[int32]managedMap
+type managedMap map[string][]byte
 
 type managedTypesContext struct {
 	host                arwen.VMHost
 	managedTypesValues  managedTypesState
 	managedTypesStack   []managedTypesState
 	randomnessGenerator math.RandomnessGenerator
-}
 
-type managedTypesState struct {
-	bigIntValues  bigIntMap
-	ecValues      ellipticCurveMap
-	mBufferValues managedBufferMap
+	// the number of call frames of the current transaction which derived their own random seed
+	numRandomFrames uint32
+
+	// the resident managed memory of the whole state stack, to which the
+	// states sharing their values with the state below them do not add
+	numHandles uint64
+	numBytes   uint64
+
+	// the big ints handed out for modification since their length was last
+	// counted towards the resident memory
+	changedBigInts []int32
+
+	// the highest resident managed memory reached in the current transaction,
+	// which has already been paid for
+	peakNumHandles uint64
+	peakNumBytes   uint64
+
+	maxManagedHandles uint64
+	maxManagedBytes   uint64
 }
 
-// NewManagedTypesContext creates a new managedTypesContext
-func NewManagedTypesContext(host arwen.VMHost) (*managedTypesContext, error) {
+type managedTypesState struct {
+	bigIntValues   bigIntMap
+	bigFloatValues bigFloatMap
+	ecValues       ellipticCurveMap
+	mBufferValues  managedBufferMap
+	mMapValues     managedMapMap
+
+	// the length in bytes of each big int, as last counted
+	bigIntLengths map[int32]uint64
+
+	// the number of bytes held by the big ints, managed buffers and managed maps
+	numBytes uint64
+
+	// the randomness of the call frame, derived from the transaction on first use
+	frameRandomSeed          []byte
+	frameRandomnessGenerator math.RandomnessGenerator
+
+	// shared is set when the maps of this state are also referenced by the
+	// state right below it on the stack; such maps are copied on the first write
+	shared bool
+}
+
+// NewManagedTypesContext creates a new managedTypesContext, which caps the
+// resident managed memory of a transaction to the given number of handles and bytes
+func NewManagedTypesContext(
+	host arwen.VMHost,
+	maxManagedHandles uint64,
+	maxManagedBytes uint64,
+) (*managedTypesContext, error) {
 	context := &managedTypesContext{
 		host: host,
 		managedTypesValues: managedTypesState{
-			bigIntValues:  make(bigIntMap),
-			ecValues:      make(ellipticCurveMap),
-			mBufferValues: make(managedBufferMap),
+			bigIntValues:   make(bigIntMap),
+			bigFloatValues: make(bigFloatMap),
+			ecValues:       make(ellipticCurveMap),
+			mBufferValues:  make(managedBufferMap),
+			mMapValues:     make(managedMapMap),
+			bigIntLengths:  make(map[int32]uint64),
 		},
 		managedTypesStack:   make([]managedTypesState, 0),
 		randomnessGenerator: nil,
+		changedBigInts:      make([]int32, 0),
+		maxManagedHandles:   maxManagedHandles,
+		maxManagedBytes:     maxManagedBytes,
 	}
 
 	return context, nil
 }
 
-func (context *managedTypesContext) initRandomizer() {
+func (context *managedTypesContext) txRandomSeed() []byte {
 	blockchainContext := context.host.Blockchain()
 	previousRandomSeed := blockchainContext.LastRandomSeed()
 	currentRandomSeed := blockchainContext.CurrentRandomSeed()
 	txHash := context.host.Runtime().GetCurrentTxHash()
 
-	blocksRandomSeed := append(previousRandomSeed, currentRandomSeed...)
-	randomSeed := append(blocksRandomSeed, txHash...)
+	randomSeed := make([]byte, 0, len(previousRandomSeed)+len(currentRandomSeed)+len(txHash))
+	randomSeed = append(randomSeed, previousRandomSeed...)
+	randomSeed = append(randomSeed, currentRandomSeed...)
+	return append(randomSeed, txHash...)
+}
 
-	randomizer := math.NewSeedRandReader(randomSeed)
+func (context *managedTypesContext) initRandomizer() {
+	randomizer := math.NewSeedRandReader(context.txRandomSeed())
 	context.randomnessGenerator = randomizer
 }
 
-// GetRandReader returns pseudo-randomness generator that implements io.Reader interface
+// GetRandReader returns pseudo-randomness generator that implements io.Reader interface.
+// Once secure randomness is enabled, each call frame reads its own ChaCha20
+// stream, keyed by the random seed of the frame.
 func (context *managedTypesContext) GetRandReader() io.Reader {
-	if check.IfNil(context.randomnessGenerator) {
-		context.initRandomizer()
+	if !context.host.SecureRandomnessEnabled() {
+		if check.IfNil(context.randomnessGenerator) {
+			context.initRandomizer()
+		}
+		return context.randomnessGenerator
 	}
-	return context.randomnessGenerator
+
+	if check.IfNil(context.managedTypesValues.frameRandomnessGenerator) {
+		frameRandomSeed := context.GetFrameRandomSeed()
+		context.managedTypesValues.frameRandomnessGenerator = math.NewChaCha20RandReader(frameRandomSeed)
+	}
+	return context.managedTypesValues.frameRandomnessGenerator
+}
+
+// GetFrameRandomSeed returns the random seed of the current call frame. It is
+// derived from the random seed of the transaction and the index of the frame
+// among the frames which asked for randomness, so no two frames share it.
+func (context *managedTypesContext) GetFrameRandomSeed() []byte {
+	if len(context.managedTypesValues.frameRandomSeed) == 0 {
+		scAddress := context.host.Runtime().GetSCAddress()
+		context.managedTypesValues.frameRandomSeed = math.DeriveFrameRandomSeed(context.txRandomSeed(), context.numRandomFrames, scAddress)
+		context.numRandomFrames++
+	}
+
+	frameRandomSeed := make([]byte, len(context.managedTypesValues.frameRandomSeed))
+	copy(frameRandomSeed, context.managedTypesValues.frameRandomSeed)
+	return frameRandomSeed
 }
 
 // InitState initializes the underlying values map
 func (context *managedTypesContext) InitState() {
+	context.countChangedBigInts()
+	context.releaseResidentMemory(&context.managedTypesValues)
 	context.managedTypesValues = managedTypesState{
-		bigIntValues:  make(bigIntMap),
-		ecValues:      make(ellipticCurveMap),
-		mBufferValues: make(managedBufferMap)}
+		bigIntValues:   make(bigIntMap),
+		bigFloatValues: make(bigFloatMap),
+		ecValues:       make(ellipticCurveMap),
+		mBufferValues:  make(managedBufferMap),
+		mMapValues:     make(managedMapMap),
+		bigIntLengths:  make(map[int32]uint64)}
 }
 
-// PushState appends the values map to the state stack
+// PushState appends the values map to the state stack. The values are not
+// copied: the active state shares them with the stack until it is either
+// reinitialized or first modified.
 func (context *managedTypesContext) PushState() {
-	newBigIntState, newEcState, newmBufferState := context.clone()
-	context.managedTypesStack = append(context.managedTypesStack, managedTypesState{
-		bigIntValues:  newBigIntState,
-		ecValues:      newEcState,
-		mBufferValues: newmBufferState,
-	})
+	context.countChangedBigInts()
+	context.managedTypesStack = append(context.managedTypesStack, context.managedTypesValues)
+	context.managedTypesValues.shared = true
 }
 
 // PopSetActiveState removes the latest entry from the state stack and sets it as the current values map
@@ -109,13 +193,9 @@ func (context *managedTypesContext) PopSetActiveState() {
 	if managedTypesStackLen == 0 {
 		return
 	}
-	prevState := context.managedTypesStack[managedTypesStackLen-1]
-	prevBigIntValues := prevState.bigIntValues
-	prevEcValues := prevState.ecValues
-	prevmBufferValues := prevState.mBufferValues
-	context.managedTypesValues.bigIntValues = prevBigIntValues
-	context.managedTypesValues.ecValues = prevEcValues
-	context.managedTypesValues.mBufferValues = prevmBufferValues
+	context.countChangedBigInts()
+	context.releaseResidentMemory(&context.managedTypesValues)
+	context.managedTypesValues = context.managedTypesStack[managedTypesStackLen-1]
 	context.managedTypesStack = context.managedTypesStack[:managedTypesStackLen-1]
 }
 
@@ -125,29 +205,80 @@ func (context *managedTypesContext) PopDiscard() {
 	if managedTypesStackLen == 0 {
 		return
 	}
+	context.countChangedBigInts()
+	prevState := context.managedTypesStack[managedTypesStackLen-1]
+	context.releaseResidentMemory(&prevState)
+	context.releaseResidentMemory(&context.managedTypesValues)
+	context.managedTypesValues.shared = context.managedTypesValues.shared && prevState.shared
+	context.acquireResidentMemory(&context.managedTypesValues)
 	context.managedTypesStack = context.managedTypesStack[:managedTypesStackLen-1]
 }
 
 // ClearStateStack initializes the state stack
 func (context *managedTypesContext) ClearStateStack() {
+	context.countChangedBigInts()
 	context.managedTypesStack = make([]managedTypesState, 0)
+	context.managedTypesValues.shared = false
+	context.numHandles = 0
+	context.numBytes = 0
+	context.acquireResidentMemory(&context.managedTypesValues)
 	context.randomnessGenerator = nil
+	context.numRandomFrames = 0
+	context.peakNumHandles = 0
+	context.peakNumBytes = 0
 }
 
-func (context *managedTypesContext) clone() (bigIntMap, ellipticCurveMap, managedBufferMap) {
+// materialize gives the active state its own copy of the values it shares
+// with the state stack, before they are modified or handed out for modification
+func (context *managedTypesContext) materialize() {
+	if !context.managedTypesValues.shared {
+		return
+	}
+	newBigIntState, newBigFloatState, newEcState, newmBufferState, newmMapState := context.clone()
+	context.managedTypesValues.bigIntValues = newBigIntState
+	context.managedTypesValues.bigFloatValues = newBigFloatState
+	context.managedTypesValues.ecValues = newEcState
+	context.managedTypesValues.mBufferValues = newmBufferState
+	context.managedTypesValues.mMapValues = newmMapState
+	context.managedTypesValues.bigIntLengths = context.cloneBigIntLengths()
+	context.managedTypesValues.shared = false
+	context.acquireResidentMemory(&context.managedTypesValues)
+}
+
+func (context *managedTypesContext) cloneBigIntLengths() map[int32]uint64 {
+	newBigIntLengths := make(map[int32]uint64, len(context.managedTypesValues.bigIntLengths))
+	for bigIntHandle, length := range context.managedTypesValues.bigIntLengths {
+		newBigIntLengths[bigIntHandle] = length
+	}
+	return newBigIntLengths
+}
+
+func (context *managedTypesContext) clone() (bigIntMap, bigFloatMap, ellipticCurveMap, managedBufferMap, managedMapMap) {
 	newBigIntState := make(bigIntMap, len(context.managedTypesValues.bigIntValues))
 	newEcState := make(ellipticCurveMap, len(context.managedTypesValues.ecValues))
 	newmBufferState := make(managedBufferMap, len(context.managedTypesValues.mBufferValues))
 	for bigIntHandle, bigInt := range context.managedTypesValues.bigIntValues {
 		newBigIntState[bigIntHandle] = big.NewInt(0).Set(bigInt)
 	}
+	newBigFloatState := make(bigFloatMap, len(context.managedTypesValues.bigFloatValues))
+	for bigFloatHandle, bigFloat := range context.managedTypesValues.bigFloatValues {
+		newBigFloatState[bigFloatHandle] = new(big.Float).Copy(bigFloat)
+	}
 	for ecHandle, ec := range context.managedTypesValues.ecValues {
 		newEcState[ecHandle] = ec
 	}
 	for mBufferHandle, mBuffer := range context.managedTypesValues.mBufferValues {
 		newmBufferState[mBufferHandle] = mBuffer
 	}
-	return newBigIntState, newEcState, newmBufferState
+	newmMapState := make(managedMapMap, len(context.managedTypesValues.mMapValues))
+	for mMapHandle, mMap := range context.managedTypesValues.mMapValues {
+		newmMap := make(managedMap, len(mMap))
+		for key, value := range mMap {
+			newmMap[key] = value
+		}
+		newmMapState[mMapHandle] = newmMap
+	}
+	return newBigIntState, newBigFloatState, newEcState, newmBufferState, newmMapState
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -197,25 +328,34 @@ func (context *managedTypesContext) ConsumeGasForThisBigIntNumberOfBytes(byteLen
 
 // GetBigIntOrCreate returns the value at the given handle. If there is no value under that value, it will set a new one with value 0
 func (context *managedTypesContext) GetBigIntOrCreate(handle int32) *big.Int {
+	context.materialize()
+	context.checkManagedMemory()
 	value, ok := context.managedTypesValues.bigIntValues[handle]
 	if !ok {
 		value = big.NewInt(0)
 		context.managedTypesValues.bigIntValues[handle] = value
+		context.addHandle()
 	}
+	context.markBigIntsChanged(handle)
 	return value
 }
 
 // GetBigInt returns the value at the given handle. If there is no value under that handle, it will return error
 func (context *managedTypesContext) GetBigInt(handle int32) (*big.Int, error) {
+	context.materialize()
+	context.checkManagedMemory()
 	value, ok := context.managedTypesValues.bigIntValues[handle]
 	if !ok {
 		return nil, arwen.ErrNoBigIntUnderThisHandle
 	}
+	context.markBigIntsChanged(handle)
 	return value, nil
 }
 
 // GetTwoBigInt returns the values at the two given handles. If there is at least one missing value, it will return error
 func (context *managedTypesContext) GetTwoBigInt(handle1 int32, handle2 int32) (*big.Int, *big.Int, error) {
+	context.materialize()
+	context.checkManagedMemory()
 	bigIntValues := context.managedTypesValues.bigIntValues
 	value1, ok := bigIntValues[handle1]
 	if !ok {
@@ -225,10 +365,12 @@ func (context *managedTypesContext) GetTwoBigInt(handle1 int32, handle2 int32) (
 	if !ok {
 		return nil, nil, arwen.ErrNoBigIntUnderThisHandle
 	}
+	context.markBigIntsChanged(handle1, handle2)
 	return value1, value2, nil
 }
 
 func (context *managedTypesContext) newBigIntNoCopy(value *big.Int) int32 {
+	context.materialize()
 	newHandle := int32(len(context.managedTypesValues.bigIntValues))
 	for {
 		if _, ok := context.managedTypesValues.bigIntValues[newHandle]; !ok {
@@ -237,6 +379,9 @@ func (context *managedTypesContext) newBigIntNoCopy(value *big.Int) int32 {
 		newHandle++
 	}
 	context.managedTypesValues.bigIntValues[newHandle] = value
+	context.managedTypesValues.bigIntLengths[newHandle] = 0
+	context.markBigIntsChanged(newHandle)
+	context.addHandle()
 	return newHandle
 }
 
@@ -250,6 +395,64 @@ func (context *managedTypesContext) NewBigIntFromInt64(int64Value int64) int32 {
 	return context.newBigIntNoCopy(big.NewInt(int64Value))
 }
 
+// BIGFLOAT
+
+// GetBigFloat returns the value at the given handle. If there is no value under that handle, it will return error
+func (context *managedTypesContext) GetBigFloat(handle int32) (*big.Float, error) {
+	context.materialize()
+	value, ok := context.managedTypesValues.bigFloatValues[handle]
+	if !ok {
+		return nil, arwen.ErrNoBigFloatUnderThisHandle
+	}
+	return value, nil
+}
+
+// GetTwoBigFloats returns the values at the two given handles. If there is at least one missing value, it will return error
+func (context *managedTypesContext) GetTwoBigFloats(handle1 int32, handle2 int32) (*big.Float, *big.Float, error) {
+	value1, err := context.GetBigFloat(handle1)
+	if err != nil {
+		return nil, nil, err
+	}
+	value2, err := context.GetBigFloat(handle2)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value1, value2, nil
+}
+
+// PutBigFloat sets a copy of the given value under the given handle. The value
+// must have the precision, rounding mode and range of the managed big floats.
+func (context *managedTypesContext) PutBigFloat(handle int32, value *big.Float) error {
+	context.materialize()
+	err := math.CheckBigFloat(value)
+	if err != nil {
+		return err
+	}
+	_, exists := context.managedTypesValues.bigFloatValues[handle]
+	context.managedTypesValues.bigFloatValues[handle] = new(big.Float).Copy(value)
+	if !exists {
+		context.addHandle()
+	}
+	return nil
+}
+
+// NewBigFloat adds a copy of the given value to the current values map and returns the handle
+func (context *managedTypesContext) NewBigFloat(value *big.Float) (int32, error) {
+	context.materialize()
+	newHandle := int32(len(context.managedTypesValues.bigFloatValues))
+	for {
+		if _, ok := context.managedTypesValues.bigFloatValues[newHandle]; !ok {
+			break
+		}
+		newHandle++
+	}
+	err := context.PutBigFloat(newHandle, value)
+	if err != nil {
+		return -1, err
+	}
+	return newHandle, nil
+}
+
 // ELLIPTIC CURVES
 
 // GetEllipticCurve returns the elliptic curve under the given handle. If there is no value under that handle, it will return error
@@ -263,6 +466,7 @@ func (context *managedTypesContext) GetEllipticCurve(handle int32) (*elliptic.Cu
 
 // PutEllipticCurve adds the given elliptic curve to the current ecValues map and returns the handle
 func (context *managedTypesContext) PutEllipticCurve(curve *elliptic.CurveParams) int32 {
+	context.materialize()
 	newHandle := int32(len(context.managedTypesValues.ecValues))
 	for {
 		if _, ok := context.managedTypesValues.ecValues[newHandle]; !ok {
@@ -271,6 +475,7 @@ func (context *managedTypesContext) PutEllipticCurve(curve *elliptic.CurveParams
 		newHandle++
 	}
 	context.managedTypesValues.ecValues[newHandle] = &elliptic.CurveParams{P: curve.P, N: curve.N, B: curve.B, Gx: curve.Gx, Gy: curve.Gy, BitSize: curve.BitSize, Name: curve.Name}
+	context.addHandle()
 	return newHandle
 }
 
@@ -353,6 +558,7 @@ func (context *managedTypesContext) GetPrivateKeyByteLengthEC(ecHandle int32) in
 
 // NewManagedBuffer creates a new empty buffer in the managed buffers map and returns the handle
 func (context *managedTypesContext) NewManagedBuffer() int32 {
+	context.materialize()
 	newHandle := int32(len(context.managedTypesValues.mBufferValues))
 	for {
 		if _, ok := context.managedTypesValues.mBufferValues[newHandle]; !ok {
@@ -362,6 +568,7 @@ func (context *managedTypesContext) NewManagedBuffer() int32 {
 	}
 	newmBuffer := make([]byte, 0)
 	context.managedTypesValues.mBufferValues[newHandle] = newmBuffer
+	context.addHandle()
 	return newHandle
 }
 
@@ -374,7 +581,8 @@ func (context *managedTypesContext) NewManagedBufferFromBytes(bytes []byte) int3
 
 // SetBytes sets the bytes given as value for the managed buffer
 func (context *managedTypesContext) SetBytes(mBufferHandle int32, bytes []byte) {
-	_, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
+	context.materialize()
+	oldBytes, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
 	if !ok {
 		context.managedTypesValues.mBufferValues[mBufferHandle] = make([]byte, 0)
 	}
@@ -385,6 +593,10 @@ func (context *managedTypesContext) SetBytes(mBufferHandle int32, bytes []byte)
 	copy(bytesCopy, bytes)
 
 	context.managedTypesValues.mBufferValues[mBufferHandle] = bytesCopy
+	if !ok {
+		context.addHandle()
+	}
+	context.updateNumBytes(len(oldBytes), len(bytesCopy))
 }
 
 // GetBytes returns the bytes for the managed buffer. Returns nil as value and error if buffer is non-existent
@@ -398,11 +610,13 @@ func (context *managedTypesContext) GetBytes(mBufferHandle int32) ([]byte, error
 
 // AppendBytes appends the given bytes to the buffer at the end
 func (context *managedTypesContext) AppendBytes(mBufferHandle int32, bytes []byte) bool {
-	_, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
+	context.materialize()
+	mBuffer, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
 	if !ok {
 		return false
 	}
-	context.managedTypesValues.mBufferValues[mBufferHandle] = append(context.managedTypesValues.mBufferValues[mBufferHandle], bytes...)
+	context.managedTypesValues.mBufferValues[mBufferHandle] = append(mBuffer, bytes...)
+	context.updateNumBytes(len(mBuffer), len(mBuffer)+len(bytes))
 	return true
 }
 
@@ -429,6 +643,7 @@ func (context *managedTypesContext) GetSlice(mBufferHandle int32, startPosition
 
 // DeleteSlice deletes a slice from the managed buffer. Returns (new buffer, nil) if success, (nil, error) otherwise
 func (context *managedTypesContext) DeleteSlice(mBufferHandle int32, startPosition int32, lengthOfSlice int32) ([]byte, error) {
+	context.materialize()
 	mBuffer, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
 	if !ok {
 		return nil, arwen.ErrNoManagedBufferUnderThisHandle
@@ -436,17 +651,20 @@ func (context *managedTypesContext) DeleteSlice(mBufferHandle int32, startPositi
 	if lengthOfSlice < 0 || startPosition < 0 {
 		return nil, arwen.ErrBadBounds
 	}
+	oldLength := len(mBuffer)
 	if int(lengthOfSlice) > len(mBuffer)-int(startPosition) {
 		mBuffer = mBuffer[:startPosition]
 	} else {
 		mBuffer = append(mBuffer[:startPosition], mBuffer[startPosition+lengthOfSlice:]...)
 	}
 	context.managedTypesValues.mBufferValues[mBufferHandle] = mBuffer
+	context.updateNumBytes(oldLength, len(mBuffer))
 	return context.managedTypesValues.mBufferValues[mBufferHandle], nil
 }
 
 // InsertSlice inserts a slice in the managed buffer at the given startPosition. Returns (new buffer, nil) if success, (nil, error) otherwise
 func (context *managedTypesContext) InsertSlice(mBufferHandle int32, startPosition int32, slice []byte) ([]byte, error) {
+	context.materialize()
 	mBuffer, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
 	if !ok {
 		return nil, arwen.ErrNoManagedBufferUnderThisHandle
@@ -454,8 +672,10 @@ func (context *managedTypesContext) InsertSlice(mBufferHandle int32, startPositi
 	if startPosition < 0 || startPosition > int32(len(mBuffer))-1 {
 		return nil, arwen.ErrBadBounds
 	}
+	oldLength := len(mBuffer)
 	mBuffer = append(mBuffer[:startPosition], append(slice, mBuffer[startPosition:]...)...)
 	context.managedTypesValues.mBufferValues[mBufferHandle] = mBuffer
+	context.updateNumBytes(oldLength, len(mBuffer))
 	return context.managedTypesValues.mBufferValues[mBufferHandle], nil
 }
 
@@ -511,3 +731,291 @@ func (context *managedTypesContext) WriteManagedVecOfManagedBuffers(
 	metering := context.host.Metering()
 	metering.UseAndTraceGas(sumOfItemByteLengths * metering.GasSchedule().BaseOperationCost.DataCopyPerByte)
 }
+
+// MANAGED MAPS
+
+// NewManagedMap creates a new empty map in the managed maps map and returns the handle
+func (context *managedTypesContext) NewManagedMap() int32 {
+	context.materialize()
+	newHandle := int32(len(context.managedTypesValues.mMapValues))
+	for {
+		if _, ok := context.managedTypesValues.mMapValues[newHandle]; !ok {
+			break
+		}
+		newHandle++
+	}
+	context.managedTypesValues.mMapValues[newHandle] = make(managedMap)
+	context.addHandle()
+	return newHandle
+}
+
+// ManagedMapPut sets the value under the given key of the managed map, replacing any previous value
+func (context *managedTypesContext) ManagedMapPut(mMapHandle int32, key []byte, value []byte) error {
+	context.materialize()
+	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
+	if !ok {
+		return arwen.ErrNoManagedMapUnderThisHandle
+	}
+
+	// always performing a copy, as for the managed buffers
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+
+	oldLength := 0
+	oldValue, exists := mMap[string(key)]
+	if exists {
+		oldLength = len(key) + len(oldValue)
+	}
+	mMap[string(key)] = valueCopy
+	context.updateNumBytes(oldLength, len(key)+len(valueCopy))
+	return nil
+}
+
+// ManagedMapGet returns the value under the given key of the managed map, or an empty value if the key is missing
+func (context *managedTypesContext) ManagedMapGet(mMapHandle int32, key []byte) ([]byte, error) {
+	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
+	if !ok {
+		return nil, arwen.ErrNoManagedMapUnderThisHandle
+	}
+	value, ok := mMap[string(key)]
+	if !ok {
+		return make([]byte, 0), nil
+	}
+	return value, nil
+}
+
+// ManagedMapContains returns true if the managed map has a value under the given key
+func (context *managedTypesContext) ManagedMapContains(mMapHandle int32, key []byte) (bool, error) {
+	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
+	if !ok {
+		return false, arwen.ErrNoManagedMapUnderThisHandle
+	}
+	_, ok = mMap[string(key)]
+	return ok, nil
+}
+
+// ManagedMapRemove removes the given key from the managed map and returns its former value, or an empty value if the key was missing
+func (context *managedTypesContext) ManagedMapRemove(mMapHandle int32, key []byte) ([]byte, error) {
+	context.materialize()
+	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
+	if !ok {
+		return nil, arwen.ErrNoManagedMapUnderThisHandle
+	}
+	value, ok := mMap[string(key)]
+	if !ok {
+		return make([]byte, 0), nil
+	}
+	delete(mMap, string(key))
+	context.updateNumBytes(len(key)+len(value), 0)
+	return value, nil
+}
+
+// ManagedMapLen returns the number of keys of the managed map, or -1 if the map is non-existent
+func (context *managedTypesContext) ManagedMapLen(mMapHandle int32) int32 {
+	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
+	if !ok {
+		return -1
+	}
+	return int32(len(mMap))
+}
+
+// ManagedMapKeys returns the keys of the managed map in ascending byte order, so that iterating them is deterministic
+func (context *managedTypesContext) ManagedMapKeys(mMapHandle int32) ([][]byte, error) {
+	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
+	if !ok {
+		return nil, arwen.ErrNoManagedMapUnderThisHandle
+	}
+
+	sortedKeys := make([]string, 0, len(mMap))
+	for key := range mMap {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	keys := make([][]byte, len(sortedKeys))
+	for i, key := range sortedKeys {
+		keys[i] = []byte(key)
+	}
+	return keys, nil
+}
+
+// RESIDENT MEMORY
+
+// FreeBigInt removes the big int under the given handle
+func (context *managedTypesContext) FreeBigInt(handle int32) error {
+	context.materialize()
+	context.countChangedBigInts()
+	_, ok := context.managedTypesValues.bigIntValues[handle]
+	if !ok {
+		return arwen.ErrNoBigIntUnderThisHandle
+	}
+	context.updateNumBytes(int(context.managedTypesValues.bigIntLengths[handle]), 0)
+	delete(context.managedTypesValues.bigIntValues, handle)
+	delete(context.managedTypesValues.bigIntLengths, handle)
+	context.removeHandle()
+	return nil
+}
+
+// FreeBigFloat removes the big float under the given handle
+func (context *managedTypesContext) FreeBigFloat(handle int32) error {
+	context.materialize()
+	_, ok := context.managedTypesValues.bigFloatValues[handle]
+	if !ok {
+		return arwen.ErrNoBigFloatUnderThisHandle
+	}
+	delete(context.managedTypesValues.bigFloatValues, handle)
+	context.removeHandle()
+	return nil
+}
+
+// FreeEllipticCurve removes the elliptic curve under the given handle
+func (context *managedTypesContext) FreeEllipticCurve(handle int32) error {
+	context.materialize()
+	_, ok := context.managedTypesValues.ecValues[handle]
+	if !ok {
+		return arwen.ErrNoEllipticCurveUnderThisHandle
+	}
+	delete(context.managedTypesValues.ecValues, handle)
+	context.removeHandle()
+	return nil
+}
+
+// FreeManagedBuffer removes the managed buffer under the given handle
+func (context *managedTypesContext) FreeManagedBuffer(mBufferHandle int32) error {
+	context.materialize()
+	mBuffer, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
+	if !ok {
+		return arwen.ErrNoManagedBufferUnderThisHandle
+	}
+	context.updateNumBytes(len(mBuffer), 0)
+	delete(context.managedTypesValues.mBufferValues, mBufferHandle)
+	context.removeHandle()
+	return nil
+}
+
+// FreeManagedMap removes the managed map under the given handle
+func (context *managedTypesContext) FreeManagedMap(mMapHandle int32) error {
+	context.materialize()
+	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
+	if !ok {
+		return arwen.ErrNoManagedMapUnderThisHandle
+	}
+	for key, value := range mMap {
+		context.updateNumBytes(len(key)+len(value), 0)
+	}
+	delete(context.managedTypesValues.mMapValues, mMapHandle)
+	context.removeHandle()
+	return nil
+}
+
+// GetResidentMemory returns the number of live handles and the number of bytes
+// held by the big ints, managed buffers and managed maps, over the whole state
+// stack. The states which still share their values with the state below them
+// are not counted again.
+func (context *managedTypesContext) GetResidentMemory() (uint64, uint64) {
+	context.countChangedBigInts()
+	return context.numHandles, context.numBytes
+}
+
+func (state *managedTypesState) numHandles() uint64 {
+	return uint64(len(state.bigIntValues) + len(state.bigFloatValues) + len(state.ecValues) + len(state.mBufferValues) + len(state.mMapValues))
+}
+
+// acquireResidentMemory adds the memory of a state to the resident memory,
+// once the state no longer shares its values with the state below it
+func (context *managedTypesContext) acquireResidentMemory(state *managedTypesState) {
+	if state.shared {
+		return
+	}
+	context.numHandles += state.numHandles()
+	context.numBytes += state.numBytes
+}
+
+// releaseResidentMemory removes the memory of a state from the resident memory
+func (context *managedTypesContext) releaseResidentMemory(state *managedTypesState) {
+	if state.shared {
+		return
+	}
+	context.numHandles -= state.numHandles()
+	context.numBytes -= state.numBytes
+}
+
+func (context *managedTypesContext) addHandle() {
+	context.numHandles++
+	context.checkManagedMemory()
+}
+
+func (context *managedTypesContext) removeHandle() {
+	context.numHandles--
+}
+
+func (context *managedTypesContext) updateNumBytes(oldLength int, newLength int) {
+	context.changeNumBytes(uint64(oldLength), uint64(newLength))
+	if newLength > oldLength {
+		context.checkManagedMemory()
+	}
+}
+
+func (context *managedTypesContext) changeNumBytes(oldLength uint64, newLength uint64) {
+	context.managedTypesValues.numBytes += newLength
+	context.managedTypesValues.numBytes -= oldLength
+	context.numBytes += newLength
+	context.numBytes -= oldLength
+}
+
+// markBigIntsChanged records big ints which are about to be modified in
+// place by the caller, so that their new length is counted on the next
+// operation on the managed types
+func (context *managedTypesContext) markBigIntsChanged(handles ...int32) {
+	context.changedBigInts = append(context.changedBigInts, handles...)
+}
+
+// countChangedBigInts updates the resident memory with the lengths of the big
+// ints handed out for modification since they were last counted
+func (context *managedTypesContext) countChangedBigInts() {
+	state := &context.managedTypesValues
+	for _, handle := range context.changedBigInts {
+		value, ok := state.bigIntValues[handle]
+		if !ok || state.shared {
+			continue
+		}
+		oldLength := state.bigIntLengths[handle]
+		newLength := uint64((value.BitLen() + 7) / 8)
+		state.bigIntLengths[handle] = newLength
+		context.changeNumBytes(oldLength, newLength)
+	}
+	context.changedBigInts = context.changedBigInts[:0]
+}
+
+// checkManagedMemory charges the growth of the resident managed memory above
+// the highest level already reached in the transaction, and fails the
+// execution if the memory exceeds the caps of the host
+func (context *managedTypesContext) checkManagedMemory() {
+	context.countChangedBigInts()
+	if !context.host.ManagedMemoryLimitsEnabled() {
+		return
+	}
+	metering := context.host.Metering()
+	if metering == nil {
+		return
+	}
+
+	costs := metering.GasSchedule().ManagedMemoryAPICost
+	numHandles, numBytes := context.numHandles, context.numBytes
+	if numHandles > context.peakNumHandles {
+		metering.UseAndTraceGas(math.MulUint64(numHandles-context.peakNumHandles, costs.ManagedHandleAllocation))
+		context.peakNumHandles = numHandles
+	}
+	if numBytes > context.peakNumBytes {
+		metering.UseAndTraceGas(math.MulUint64(numBytes-context.peakNumBytes, costs.ManagedByteAllocation))
+		context.peakNumBytes = numBytes
+	}
+
+	if numHandles > context.maxManagedHandles {
+		_ = arwen.WithFaultAndHost(context.host, arwen.ErrTooManyManagedHandles, true)
+		return
+	}
+	if numBytes > context.maxManagedBytes {
+		_ = arwen.WithFaultAndHost(context.host, arwen.ErrManagedMemoryExhausted, true)
+	}
+}
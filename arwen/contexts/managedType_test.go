@@ -8,6 +8,7 @@ import (
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/mock"
+	arwenMath "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
 	contextmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/stretchr/testify/assert"
@@ -19,13 +20,15 @@ func TestNewManagedTypes(t *testing.T) {
 
 	host := &contextmock.VMHostStub{}
 
-	managedTypesContext, err := NewManagedTypesContext(host)
+	managedTypesContext, err := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 
 	require.Nil(t, err)
 	require.False(t, managedTypesContext.IsInterfaceNil())
 	require.NotNil(t, managedTypesContext.managedTypesValues.bigIntValues)
+	require.NotNil(t, managedTypesContext.managedTypesValues.bigFloatValues)
 	require.NotNil(t, managedTypesContext.managedTypesValues.ecValues)
 	require.NotNil(t, managedTypesContext.managedTypesValues.mBufferValues)
+	require.NotNil(t, managedTypesContext.managedTypesValues.mMapValues)
 	require.NotNil(t, managedTypesContext.managedTypesStack)
 	require.Equal(t, 0, len(managedTypesContext.managedTypesValues.bigIntValues))
 	require.Equal(t, 0, len(managedTypesContext.managedTypesValues.ecValues))
@@ -51,12 +54,12 @@ func TestManagedTypesContext_Randomness(t *testing.T) {
 	host.BlockchainContext = blockchainContext
 	copyHost := host
 
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 	require.Nil(t, managedTypesContext.randomnessGenerator)
 	managedTypesContext.initRandomizer()
 	firstRandomizer := managedTypesContext.randomnessGenerator
 
-	managedTypesContextCopy, _ := NewManagedTypesContext(copyHost)
+	managedTypesContextCopy, _ := NewManagedTypesContext(copyHost, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 	require.Nil(t, managedTypesContextCopy.randomnessGenerator)
 	managedTypesContextCopy.initRandomizer()
 	secondRandomizer := managedTypesContextCopy.randomnessGenerator
@@ -74,6 +77,91 @@ func TestManagedTypesContext_Randomness(t *testing.T) {
 	}
 }
 
+func TestManagedTypesContext_FrameRandomness(t *testing.T) {
+	t.Parallel()
+
+	mockRuntime := &contextmock.RuntimeContextMock{
+		SCAddress:     []byte("parent"),
+		CurrentTxHash: []byte{0xf, 0xf, 0xf, 0xf, 0xf, 0xf},
+	}
+	host := &contextmock.VMHostMock{
+		RuntimeContext: mockRuntime,
+	}
+	mockBlockchain := &contextmock.BlockchainHookStub{
+		CurrentRandomSeedCalled: func() []byte {
+			return []byte{0xf, 0xf, 0xf, 0xf, 0xa, 0xb}
+		},
+	}
+	blockchainContext, _ := NewBlockchainContext(host, mockBlockchain)
+	host.BlockchainContext = blockchainContext
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
+
+	txRandomSeed := managedTypesContext.txRandomSeed()
+	parentSeed := managedTypesContext.GetFrameRandomSeed()
+	require.Equal(t, arwenMath.DeriveFrameRandomSeed(txRandomSeed, 0, []byte("parent")), parentSeed)
+	require.Equal(t, parentSeed, managedTypesContext.GetFrameRandomSeed())
+
+	parentRandom := make([]byte, 32)
+	_, _ = managedTypesContext.GetRandReader().Read(parentRandom)
+	expectedRandom := make([]byte, 64)
+	_, _ = arwenMath.NewChaCha20RandReader(parentSeed).Read(expectedRandom)
+	require.Equal(t, expectedRandom[:32], parentRandom)
+
+	// a nested call with the same contract gets its own seed and stream
+	managedTypesContext.PushState()
+	managedTypesContext.InitState()
+	childSeed := managedTypesContext.GetFrameRandomSeed()
+	require.Equal(t, arwenMath.DeriveFrameRandomSeed(txRandomSeed, 1, []byte("parent")), childSeed)
+	childRandom := make([]byte, 32)
+	_, _ = managedTypesContext.GetRandReader().Read(childRandom)
+	require.NotEqual(t, expectedRandom[32:], childRandom)
+
+	// the parent frame resumes its own stream
+	managedTypesContext.PopSetActiveState()
+	_, _ = managedTypesContext.GetRandReader().Read(parentRandom)
+	require.Equal(t, expectedRandom[32:], parentRandom)
+
+	managedTypesContext.ClearStateStack()
+	managedTypesContext.InitState()
+	require.Equal(t, parentSeed, managedTypesContext.GetFrameRandomSeed())
+}
+
+func TestManagedTypesContext_RandomnessBeforeSecureRandomness(t *testing.T) {
+	t.Parallel()
+
+	mockRuntime := &contextmock.RuntimeContextMock{
+		CurrentTxHash: []byte{0xf, 0xf, 0xf, 0xf, 0xf, 0xf},
+	}
+	host := &contextmock.VMHostStub{
+		RuntimeCalled: func() arwen.RuntimeContext {
+			return mockRuntime
+		},
+		SecureRandomnessEnabledCalled: func() bool {
+			return false
+		},
+	}
+	blockchainContext, _ := NewBlockchainContext(host, &contextmock.BlockchainHookStub{})
+	host.BlockchainCalled = func() arwen.BlockchainContext {
+		return blockchainContext
+	}
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
+
+	// all the frames share the legacy stream of the transaction
+	first := make([]byte, 32)
+	_, _ = managedTypesContext.GetRandReader().Read(first)
+	managedTypesContext.PushState()
+	managedTypesContext.InitState()
+	second := make([]byte, 32)
+	_, _ = managedTypesContext.GetRandReader().Read(second)
+
+	expected := make([]byte, 32)
+	legacyReader := arwenMath.NewSeedRandReader(managedTypesContext.txRandomSeed())
+	_, _ = legacyReader.Read(expected)
+	require.Equal(t, expected, first)
+	_, _ = legacyReader.Read(expected)
+	require.Equal(t, expected, second)
+}
+
 func TestManagedTypesContext_ClearStateStack(t *testing.T) {
 	t.Parallel()
 	host := &contextmock.VMHostStub{
@@ -83,10 +171,13 @@ func TestManagedTypesContext_ClearStateStack(t *testing.T) {
 		RuntimeCalled: func() arwen.RuntimeContext {
 			return &contextmock.RuntimeContextMock{CurrentTxHash: bytes.Repeat([]byte{1}, 32)}
 		},
+		SecureRandomnessEnabledCalled: func() bool {
+			return false
+		},
 	}
 	value1, value2 := int64(100), int64(200)
 	p224ec, p256ec := elliptic.P224().Params(), elliptic.P256().Params()
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 	managedTypesContext.InitState()
 
 	index1 := managedTypesContext.NewBigIntFromInt64(value1)
@@ -136,7 +227,7 @@ func TestManagedTypesContext_InitPushPopState(t *testing.T) {
 	value1, value2, value3 := int64(100), int64(200), int64(-42)
 	p224ec, p256ec, p384ec, p521ec := elliptic.P224().Params(), elliptic.P256().Params(), elliptic.P384().Params(), elliptic.P521().Params()
 	mBytes := []byte{2, 234, 64, 255}
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 	managedTypesContext.InitState()
 
 	// Create 2 bigInt,2 EC, 2 managedBuffers on the active state
@@ -332,7 +423,7 @@ func TestManagedTypesContext_PutGetBigInt(t *testing.T) {
 	host := &contextmock.VMHostStub{}
 
 	value1, value2, value3, value4 := int64(100), int64(200), int64(-42), int64(-80)
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 
 	index1 := managedTypesContext.NewBigIntFromInt64(value1)
 	require.Equal(t, int32(0), index1)
@@ -382,7 +473,7 @@ func TestManagedTypesContext_PutGetBigInt(t *testing.T) {
 func TestManagedTypesContext_NewBigIntCopied(t *testing.T) {
 	t.Parallel()
 	host := &contextmock.VMHostStub{}
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 
 	originalBigInt := big.NewInt(3)
 	index1 := managedTypesContext.NewBigInt(originalBigInt)
@@ -399,7 +490,7 @@ func TestManagedTypesContext_PutGetEllipticCurves(t *testing.T) {
 	host := &contextmock.VMHostStub{}
 
 	p224ec, p256ec, p384ec, p521ec := elliptic.P224().Params(), elliptic.P256().Params(), elliptic.P384().Params(), elliptic.P521().Params()
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 
 	ecIndex1 := managedTypesContext.PutEllipticCurve(p224ec)
 	require.Equal(t, int32(0), ecIndex1)
@@ -437,7 +528,7 @@ func TestManagedTypesContext_PutGetEllipticCurves(t *testing.T) {
 func TestManagedTypesContext_ManagedBuffersFunctionalities(t *testing.T) {
 	t.Parallel()
 	host := &contextmock.VMHostStub{}
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 	mBytes := []byte{2, 234, 64, 255}
 	emptyBuffer := make([]byte, 0)
 
@@ -543,11 +634,216 @@ func TestManagedTypesContext_ManagedBuffersFunctionalities(t *testing.T) {
 	require.Equal(t, bytesWithNewSlice, mBufferBytes)
 }
 
+func TestManagedTypesContext_ManagedMapsFunctionalities(t *testing.T) {
+	t.Parallel()
+	host := &contextmock.VMHostStub{}
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
+	key1 := []byte("beta")
+	key2 := []byte("alpha")
+	value := []byte{2, 234, 64, 255}
+
+	// Calls for non-existent maps
+	noMapHandle := int32(379)
+	err := managedTypesContext.ManagedMapPut(noMapHandle, key1, value)
+	require.Equal(t, arwen.ErrNoManagedMapUnderThisHandle, err)
+	mapValue, err := managedTypesContext.ManagedMapGet(noMapHandle, key1)
+	require.Nil(t, mapValue)
+	require.Equal(t, arwen.ErrNoManagedMapUnderThisHandle, err)
+	found, err := managedTypesContext.ManagedMapContains(noMapHandle, key1)
+	require.False(t, found)
+	require.Equal(t, arwen.ErrNoManagedMapUnderThisHandle, err)
+	mapValue, err = managedTypesContext.ManagedMapRemove(noMapHandle, key1)
+	require.Nil(t, mapValue)
+	require.Equal(t, arwen.ErrNoManagedMapUnderThisHandle, err)
+	require.Equal(t, int32(-1), managedTypesContext.ManagedMapLen(noMapHandle))
+	keys, err := managedTypesContext.ManagedMapKeys(noMapHandle)
+	require.Nil(t, keys)
+	require.Equal(t, arwen.ErrNoManagedMapUnderThisHandle, err)
+
+	// New/Put/Get
+	mMapHandle := managedTypesContext.NewManagedMap()
+	require.Equal(t, int32(0), mMapHandle)
+	require.Equal(t, int32(0), managedTypesContext.ManagedMapLen(mMapHandle))
+	mapValue, err = managedTypesContext.ManagedMapGet(mMapHandle, key1)
+	require.Nil(t, err)
+	require.Equal(t, []byte{}, mapValue)
+
+	err = managedTypesContext.ManagedMapPut(mMapHandle, key1, value)
+	require.Nil(t, err)
+	value[0] = 0
+	mapValue, _ = managedTypesContext.ManagedMapGet(mMapHandle, key1)
+	require.Equal(t, []byte{2, 234, 64, 255}, mapValue)
+	found, _ = managedTypesContext.ManagedMapContains(mMapHandle, key1)
+	require.True(t, found)
+	found, _ = managedTypesContext.ManagedMapContains(mMapHandle, key2)
+	require.False(t, found)
+
+	// Keys are sorted
+	_ = managedTypesContext.ManagedMapPut(mMapHandle, key2, []byte{})
+	require.Equal(t, int32(2), managedTypesContext.ManagedMapLen(mMapHandle))
+	keys, err = managedTypesContext.ManagedMapKeys(mMapHandle)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{key2, key1}, keys)
+	found, _ = managedTypesContext.ManagedMapContains(mMapHandle, key2)
+	require.True(t, found)
+
+	// Remove
+	mapValue, err = managedTypesContext.ManagedMapRemove(mMapHandle, key1)
+	require.Nil(t, err)
+	require.Equal(t, []byte{2, 234, 64, 255}, mapValue)
+	mapValue, err = managedTypesContext.ManagedMapRemove(mMapHandle, key1)
+	require.Nil(t, err)
+	require.Equal(t, []byte{}, mapValue)
+	require.Equal(t, int32(1), managedTypesContext.ManagedMapLen(mMapHandle))
+
+	// Push/PopSetActiveState
+	managedTypesContext.PushState()
+	_ = managedTypesContext.ManagedMapPut(mMapHandle, key1, value)
+	newMapHandle := managedTypesContext.NewManagedMap()
+	require.Equal(t, int32(1), newMapHandle)
+	managedTypesContext.PopSetActiveState()
+	require.Equal(t, int32(1), managedTypesContext.ManagedMapLen(mMapHandle))
+	require.Equal(t, int32(-1), managedTypesContext.ManagedMapLen(newMapHandle))
+
+	managedTypesContext.InitState()
+	require.Equal(t, int32(-1), managedTypesContext.ManagedMapLen(mMapHandle))
+}
+
+func TestManagedTypesContext_FreeAndResidentMemory(t *testing.T) {
+	t.Parallel()
+	host := &contextmock.VMHostStub{}
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
+
+	bigIntHandle := managedTypesContext.NewBigIntFromInt64(7)
+	mBufferHandle := managedTypesContext.NewManagedBufferFromBytes([]byte("abcd"))
+	mMapHandle := managedTypesContext.NewManagedMap()
+	_ = managedTypesContext.ManagedMapPut(mMapHandle, []byte("key"), []byte("value"))
+	numHandles, numBytes := managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(3), numHandles)
+	require.Equal(t, uint64(13), numBytes)
+
+	_ = managedTypesContext.AppendBytes(mBufferHandle, []byte("ef"))
+	_, numBytes = managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(15), numBytes)
+
+	// big ints modified in place are counted with their new length
+	bigInt, _ := managedTypesContext.GetBigInt(bigIntHandle)
+	bigInt.Lsh(bigInt, 64)
+	_, numBytes = managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(23), numBytes)
+
+	// the values shared with the state stack are counted once
+	managedTypesContext.PushState()
+	managedTypesContext.PushState()
+	numHandles, numBytes = managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(3), numHandles)
+	require.Equal(t, uint64(23), numBytes)
+
+	// and counted again once copied by a modification
+	_ = managedTypesContext.NewBigIntFromInt64(1)
+	numHandles, numBytes = managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(7), numHandles)
+	require.Equal(t, uint64(47), numBytes)
+	managedTypesContext.PopDiscard()
+	numHandles, numBytes = managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(7), numHandles)
+	require.Equal(t, uint64(47), numBytes)
+	managedTypesContext.PopSetActiveState()
+	numHandles, numBytes = managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(3), numHandles)
+	require.Equal(t, uint64(23), numBytes)
+
+	// the resident memory of the parent states is included
+	managedTypesContext.PushState()
+	managedTypesContext.InitState()
+	_ = managedTypesContext.NewManagedBufferFromBytes([]byte("xy"))
+	numHandles, numBytes = managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(4), numHandles)
+	require.Equal(t, uint64(25), numBytes)
+	managedTypesContext.PopSetActiveState()
+
+	require.Nil(t, managedTypesContext.FreeBigInt(bigIntHandle))
+	require.Nil(t, managedTypesContext.FreeManagedBuffer(mBufferHandle))
+	require.Nil(t, managedTypesContext.FreeManagedMap(mMapHandle))
+	numHandles, numBytes = managedTypesContext.GetResidentMemory()
+	require.Equal(t, uint64(0), numHandles)
+	require.Equal(t, uint64(0), numBytes)
+
+	require.Equal(t, arwen.ErrNoBigIntUnderThisHandle, managedTypesContext.FreeBigInt(bigIntHandle))
+	require.Equal(t, arwen.ErrNoBigFloatUnderThisHandle, managedTypesContext.FreeBigFloat(bigIntHandle))
+	require.Equal(t, arwen.ErrNoEllipticCurveUnderThisHandle, managedTypesContext.FreeEllipticCurve(bigIntHandle))
+	require.Equal(t, arwen.ErrNoManagedBufferUnderThisHandle, managedTypesContext.FreeManagedBuffer(mBufferHandle))
+	require.Equal(t, arwen.ErrNoManagedMapUnderThisHandle, managedTypesContext.FreeManagedMap(mMapHandle))
+}
+
+func TestManagedTypesContext_PushStateCopiesOnWrite(t *testing.T) {
+	t.Parallel()
+	host := &contextmock.VMHostStub{}
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
+
+	bigIntHandle := managedTypesContext.NewBigIntFromInt64(10)
+	mMapHandle := managedTypesContext.NewManagedMap()
+	_ = managedTypesContext.ManagedMapPut(mMapHandle, []byte("key"), []byte("value"))
+
+	// nothing is copied until the active state is modified
+	managedTypesContext.PushState()
+	require.True(t, managedTypesContext.managedTypesValues.shared)
+	managedTypesContext.PushState()
+	value, _ := managedTypesContext.ManagedMapGet(mMapHandle, []byte("key"))
+	require.Equal(t, []byte("value"), value)
+	require.True(t, managedTypesContext.managedTypesValues.shared)
+
+	bigInt, _ := managedTypesContext.GetBigInt(bigIntHandle)
+	bigInt.SetInt64(20)
+	_ = managedTypesContext.ManagedMapPut(mMapHandle, []byte("key"), []byte("other"))
+	require.False(t, managedTypesContext.managedTypesValues.shared)
+
+	// the stacked states share their values and are both left untouched
+	managedTypesContext.PopSetActiveState()
+	require.True(t, managedTypesContext.managedTypesValues.shared)
+	require.Equal(t, int64(10), managedTypesContext.GetBigIntOrCreate(bigIntHandle).Int64())
+	require.False(t, managedTypesContext.managedTypesValues.shared)
+	managedTypesContext.GetBigIntOrCreate(bigIntHandle).SetInt64(30)
+
+	managedTypesContext.PopSetActiveState()
+	require.False(t, managedTypesContext.managedTypesValues.shared)
+	require.Equal(t, int64(10), managedTypesContext.GetBigIntOrCreate(bigIntHandle).Int64())
+	value, _ = managedTypesContext.ManagedMapGet(mMapHandle, []byte("key"))
+	require.Equal(t, []byte("value"), value)
+
+	// a reinitialized state shares nothing with the stack
+	managedTypesContext.PushState()
+	managedTypesContext.InitState()
+	require.False(t, managedTypesContext.managedTypesValues.shared)
+	managedTypesContext.PopDiscard()
+	require.False(t, managedTypesContext.managedTypesValues.shared)
+}
+
+func TestManagedTypesContext_PushInitStateCopiesNothing(t *testing.T) {
+	t.Parallel()
+	host := &contextmock.VMHostStub{}
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
+
+	bigIntHandle := managedTypesContext.NewBigIntFromInt64(10)
+	parentBigInts := managedTypesContext.managedTypesValues.bigIntValues
+
+	// the nested calls of the host push the state and then reinitialize it,
+	// so the values of the caller are stacked without being copied
+	managedTypesContext.PushState()
+	managedTypesContext.InitState()
+	managedTypesContext.NewBigIntFromInt64(20)
+	managedTypesContext.PopSetActiveState()
+
+	require.Equal(t, 1, len(managedTypesContext.managedTypesValues.bigIntValues))
+	managedTypesContext.managedTypesValues.bigIntValues[bigIntHandle].SetInt64(11)
+	require.Equal(t, int64(11), parentBigInts[bigIntHandle].Int64())
+}
+
 func TestManagedTypesContext_PopSetActiveStateIfStackIsEmptyShouldNotPanic(t *testing.T) {
 	t.Parallel()
 	host := &contextmock.VMHostStub{}
 
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 	managedTypesContext.PopSetActiveState()
 
 	require.Equal(t, 0, len(managedTypesContext.managedTypesStack))
@@ -557,8 +853,56 @@ func TestManagedTypesContext_PopDiscardIfStackIsEmptyShouldNotPanic(t *testing.T
 	t.Parallel()
 	host := &contextmock.VMHostStub{}
 
-	managedTypesContext, _ := NewManagedTypesContext(host)
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
 	managedTypesContext.PopDiscard()
 
 	require.Equal(t, 0, len(managedTypesContext.managedTypesStack))
 }
+
+// benchmarkCallDepth and benchmarkNumValues shape the state stack benchmarks as
+// a chain of nested calls made by a contract holding some values
+const benchmarkCallDepth = 10
+const benchmarkNumValues = 100
+
+func BenchmarkManagedTypesContext_PushPopState(b *testing.B) {
+	host := &contextmock.VMHostStub{}
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
+
+	for value := 0; value < benchmarkNumValues; value++ {
+		managedTypesContext.NewBigIntFromInt64(int64(value))
+		managedTypesContext.NewManagedBufferFromBytes([]byte("value"))
+		mMapHandle := managedTypesContext.NewManagedMap()
+		_ = managedTypesContext.ManagedMapPut(mMapHandle, []byte("key"), []byte("value"))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for depth := 0; depth < benchmarkCallDepth; depth++ {
+			managedTypesContext.PushState()
+			managedTypesContext.InitState()
+			managedTypesContext.NewBigIntFromInt64(int64(depth))
+		}
+		for depth := 0; depth < benchmarkCallDepth; depth++ {
+			managedTypesContext.PopSetActiveState()
+		}
+	}
+}
+
+func BenchmarkManagedTypesContext_PushInitPopState(b *testing.B) {
+	host := &contextmock.VMHostStub{}
+	managedTypesContext, _ := NewManagedTypesContext(host, arwen.DefaultMaxManagedHandles, arwen.DefaultMaxManagedBytes)
+	for i := 0; i < 100; i++ {
+		managedTypesContext.NewBigIntFromInt64(int64(i))
+		managedTypesContext.NewManagedBufferFromBytes(make([]byte, 32))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		managedTypesContext.PushState()
+		managedTypesContext.InitState()
+		managedTypesContext.NewBigIntFromInt64(1)
+		managedTypesContext.PopSetActiveState()
+	}
+}
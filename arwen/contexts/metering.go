@@ -23,6 +23,10 @@ type meteringContext struct {
 	gasForExecution    uint64
 	gasUsedByAccounts  map[string]uint64
 
+	// gasUsedByAccountsShared is set while gasUsedByAccounts is also
+	// referenced by the top of the state stack, which must not see later writes
+	gasUsedByAccountsShared bool
+
 	gasTracer       arwen.GasTracing
 	traceGasEnabled bool
 }
@@ -54,11 +58,11 @@ func NewMeteringContext(
 
 // InitState resets the internal state of the MeteringContext
 func (context *meteringContext) InitState() {
-	context.gasUsedByAccounts = make(map[string]uint64)
 	context.initialGasProvided = 0
 	context.initialCost = 0
 	context.gasForExecution = 0
 	context.gasUsedByAccounts = make(map[string]uint64)
+	context.gasUsedByAccountsShared = false
 
 	var newGasTracer arwen.GasTracing
 	if context.traceGasEnabled {
@@ -78,16 +82,19 @@ func (context *meteringContext) InitStateFromContractCallInput(input *vmcommon.V
 	context.gasForExecution = input.GasProvided
 }
 
-// PushState pushes the current state of the MeteringContext on its internal state stack
+// PushState pushes the current state of the MeteringContext on its internal
+// state stack; the gas used by accounts is only copied when it is next modified
 func (context *meteringContext) PushState() {
 	newState := &meteringContext{
-		initialGasProvided: context.initialGasProvided,
-		initialCost:        context.initialCost,
-		gasForExecution:    context.gasForExecution,
-		gasUsedByAccounts:  context.cloneGasUsedByAccounts(),
+		initialGasProvided:      context.initialGasProvided,
+		initialCost:             context.initialCost,
+		gasForExecution:         context.gasForExecution,
+		gasUsedByAccounts:       context.gasUsedByAccounts,
+		gasUsedByAccountsShared: context.gasUsedByAccountsShared,
 	}
 
 	context.stateStack = append(context.stateStack, newState)
+	context.gasUsedByAccountsShared = true
 }
 
 // PopSetActiveState pops the state at the top of the internal state stack, and
@@ -105,6 +112,7 @@ func (context *meteringContext) PopSetActiveState() {
 	context.initialCost = prevState.initialCost
 	context.gasForExecution = prevState.gasForExecution
 	context.gasUsedByAccounts = prevState.gasUsedByAccounts
+	context.gasUsedByAccountsShared = prevState.gasUsedByAccountsShared
 }
 
 // PopDiscard pops the state at the top of the internal state stack, and discards it
@@ -114,7 +122,9 @@ func (context *meteringContext) PopDiscard() {
 		return
 	}
 
+	prevState := context.stateStack[stateStackLen-1]
 	context.stateStack = context.stateStack[:stateStackLen-1]
+	context.gasUsedByAccountsShared = context.gasUsedByAccountsShared && prevState.gasUsedByAccountsShared
 }
 
 // PopMergeActiveState pops the state at the top of the internal stack and
@@ -132,6 +142,7 @@ func (context *meteringContext) PopMergeActiveState() {
 	context.initialCost = prevState.initialCost
 	context.gasForExecution = prevState.gasForExecution
 
+	context.gasUsedByAccountsShared = context.gasUsedByAccountsShared && prevState.gasUsedByAccountsShared
 	context.addToGasUsedByAccounts(prevState.gasUsedByAccounts)
 }
 
@@ -145,7 +156,18 @@ func (context *meteringContext) cloneGasUsedByAccounts() map[string]uint64 {
 	return clone
 }
 
+// materializeGasUsedByAccounts gives the active state its own copy of the gas
+// used by accounts, if it still shares it with the state stack
+func (context *meteringContext) materializeGasUsedByAccounts() {
+	if !context.gasUsedByAccountsShared {
+		return
+	}
+	context.gasUsedByAccounts = context.cloneGasUsedByAccounts()
+	context.gasUsedByAccountsShared = false
+}
+
 func (context *meteringContext) addToGasUsedByAccounts(gasUsed map[string]uint64) {
+	context.materializeGasUsedByAccounts()
 	for address, gas := range gasUsed {
 		context.gasUsedByAccounts[address] += gas
 	}
@@ -196,6 +218,7 @@ func (context *meteringContext) updateSCGasUsed() {
 	gasUsed = math.SubUint64(gasUsed, gasTransferredByCurrentAccount)
 	gasUsed = math.SubUint64(gasUsed, gasUsedByOthers)
 
+	context.materializeGasUsedByAccounts()
 	context.gasUsedByAccounts[string(currentAccountAddress)] = gasUsed
 }
 
@@ -292,6 +315,7 @@ func (context *meteringContext) setGasUsedToOutputAccounts(vmOutput *vmcommon.VM
 // ClearStateStack reinitializes the internal state stack to an empty stack
 func (context *meteringContext) ClearStateStack() {
 	context.stateStack = make([]*meteringContext, 0)
+	context.gasUsedByAccountsShared = false
 	context.gasTracer = nil
 }
 
@@ -418,6 +418,32 @@ func TestMeteringContext_GasUsed_StackOneLevel(t *testing.T) {
 	require.Equal(t, gasRemaining, metering.GasLeft())
 }
 
+func TestMeteringContext_PushStateCopiesGasUsedByAccountsOnWrite(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{}
+	meteringContext, _ := NewMeteringContext(host, config.MakeGasMapForTests(), uint64(15000))
+
+	meteringContext.addToGasUsedByAccounts(map[string]uint64{"alpha": 100})
+
+	meteringContext.PushState()
+	require.True(t, meteringContext.gasUsedByAccountsShared)
+
+	meteringContext.addToGasUsedByAccounts(map[string]uint64{"alpha": 50, "beta": 10})
+	require.False(t, meteringContext.gasUsedByAccountsShared)
+	require.Equal(t, uint64(150), meteringContext.gasUsedByAccounts["alpha"])
+
+	meteringContext.PopSetActiveState()
+	require.Equal(t, map[string]uint64{"alpha": 100}, meteringContext.gasUsedByAccounts)
+
+	meteringContext.PushState()
+	meteringContext.InitState()
+	require.False(t, meteringContext.gasUsedByAccountsShared)
+	meteringContext.addToGasUsedByAccounts(map[string]uint64{"beta": 10})
+	meteringContext.PopMergeActiveState()
+	require.Equal(t, map[string]uint64{"alpha": 100, "beta": 10}, meteringContext.gasUsedByAccounts)
+}
+
 func TestMeteringContext_UpdateGasStateOnFailure_StackOneLevel(t *testing.T) {
 
 	parentExecutionGas := uint64(1000) // this is the contract size, but I chose to keep the convention used on child
@@ -568,3 +594,25 @@ func TestMeteringContext_GasTracer(t *testing.T) {
 	require.Equal(t, gasUsed2, gasTrace["scAddress2"]["function2"][0])
 
 }
+
+func BenchmarkMeteringContext_PushPopState(b *testing.B) {
+	host := &contextmock.VMHostMock{}
+	meteringContext, _ := NewMeteringContext(host, config.MakeGasMapForTests(), uint64(15000))
+
+	for i := 0; i < benchmarkNumValues; i++ {
+		meteringContext.gasUsedByAccounts[string(big.NewInt(int64(i)).Bytes())] = uint64(i)
+	}
+	input := &vmcommon.VMInput{GasProvided: 1000}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for depth := 0; depth < benchmarkCallDepth; depth++ {
+			meteringContext.PushState()
+			meteringContext.InitStateFromContractCallInput(input)
+		}
+		for depth := 0; depth < benchmarkCallDepth; depth++ {
+			meteringContext.PopSetActiveState()
+		}
+	}
+}
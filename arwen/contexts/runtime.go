@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go-core/storage"
 	"github.com/ElrondNetwork/elrond-go-core/storage/lrucache"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -40,9 +41,13 @@ type runtimeContext struct {
 
 	warmInstanceCache storage.Cacher
 
-	stateStack    []*runtimeContext
+	stateStack    []*runtimeState
 	instanceStack []wasmer.InstanceHandler
 
+	// vmInputShared is set while vmInput is also referenced by the top of the
+	// state stack; it is copied before being handed out for modification
+	vmInputShared bool
+
 	asyncCallInfo    *arwen.AsyncCallInfo
 	asyncContextInfo *arwen.AsyncContextInfo
 
@@ -57,6 +62,18 @@ type runtimeContext struct {
 	flagEnableManagedCryptoAPI  atomic.Flag
 }
 
+// runtimeState is the part of the runtimeContext saved on the state stack
+type runtimeState struct {
+	vmInput          *vmcommon.VMInput
+	vmInputShared    bool
+	scAddress        []byte
+	codeHash         []byte
+	callFunction     string
+	readOnly         bool
+	asyncCallInfo    *arwen.AsyncCallInfo
+	asyncContextInfo *arwen.AsyncContextInfo
+}
+
 type instanceAndMemory struct {
 	instance wasmer.InstanceHandler
 	memory   []byte
@@ -76,7 +93,7 @@ func NewRuntimeContext(
 	context := &runtimeContext{
 		host:                        host,
 		vmType:                      vmType,
-		stateStack:                  make([]*runtimeContext, 0),
+		stateStack:                  make([]*runtimeState, 0),
 		instanceStack:               make([]wasmer.InstanceHandler, 0),
 		validator:                   newWASMValidator(scAPINames, builtInFuncContainer),
 		errors:                      nil,
@@ -378,7 +395,9 @@ func (context *runtimeContext) SetCustomCallFunction(callFunction string) {
 // PushState appends the current runtime state to the state stack; this
 // includes the currently running Wasmer instance.
 func (context *runtimeContext) PushState() {
-	newState := &runtimeContext{
+	newState := &runtimeState{
+		vmInput:          context.vmInput,
+		vmInputShared:    context.vmInputShared,
 		scAddress:        context.scAddress,
 		codeHash:         context.codeHash,
 		callFunction:     context.callFunction,
@@ -386,9 +405,9 @@ func (context *runtimeContext) PushState() {
 		asyncCallInfo:    context.asyncCallInfo,
 		asyncContextInfo: context.asyncContextInfo,
 	}
-	newState.SetVMInput(context.vmInput)
 
 	context.stateStack = append(context.stateStack, newState)
+	context.vmInputShared = true
 
 	// Also preserve the currently running Wasmer instance at the top of the
 	// instance stack; when the corresponding call to popInstance() is made, a
@@ -411,7 +430,8 @@ func (context *runtimeContext) PopSetActiveState() {
 	prevState := context.stateStack[stateStackLen-1]
 	context.stateStack = context.stateStack[:stateStackLen-1]
 
-	context.SetVMInput(prevState.vmInput)
+	context.vmInput = prevState.vmInput
+	context.vmInputShared = prevState.vmInputShared
 	context.scAddress = prevState.scAddress
 	context.codeHash = prevState.codeHash
 	context.callFunction = prevState.callFunction
@@ -431,13 +451,16 @@ func (context *runtimeContext) PopDiscard() {
 	lastCodeHash := make([]byte, len(context.codeHash))
 	copy(lastCodeHash, context.codeHash)
 
+	prevState := context.stateStack[stateStackLen-1]
 	context.stateStack = context.stateStack[:stateStackLen-1]
+	context.vmInputShared = context.vmInputShared && prevState.vmInputShared
 	context.popInstance(lastCodeHash)
 }
 
 // ClearStateStack reinitializes the state stack.
 func (context *runtimeContext) ClearStateStack() {
-	context.stateStack = make([]*runtimeContext, 0)
+	context.stateStack = make([]*runtimeState, 0)
+	context.vmInputShared = false
 }
 
 // pushInstance appends the current wasmer instance to the instance stack.
@@ -486,9 +509,19 @@ func (context *runtimeContext) GetVMType() []byte {
 
 // GetVMInput returns the vm input for the current context.
 func (context *runtimeContext) GetVMInput() *vmcommon.VMInput {
+	context.materializeVMInput()
 	return context.vmInput
 }
 
+// materializeVMInput gives the active state its own copy of the VMInput, if it
+// still shares it with the state stack
+func (context *runtimeContext) materializeVMInput() {
+	if !context.vmInputShared {
+		return
+	}
+	context.SetVMInput(context.vmInput)
+}
+
 func copyESDTTransfer(esdtTransfer *vmcommon.ESDTTransfer) *vmcommon.ESDTTransfer {
 	newESDTTransfer := &vmcommon.ESDTTransfer{
 		ESDTValue:      big.NewInt(0).Set(esdtTransfer.ESDTValue),
@@ -502,6 +535,7 @@ func copyESDTTransfer(esdtTransfer *vmcommon.ESDTTransfer) *vmcommon.ESDTTransfe
 
 // SetVMInput sets the given vm input as the current context vm input.
 func (context *runtimeContext) SetVMInput(vmInput *vmcommon.VMInput) {
+	context.vmInputShared = false
 	if vmInput == nil {
 		context.vmInput = vmInput
 		return
@@ -572,6 +606,28 @@ func (context *runtimeContext) GetOriginalTxHash() []byte {
 	return context.vmInput.OriginalTxHash
 }
 
+// GetOriginalCallerAddr returns the sender of the original transaction, which
+// stays the same in the nested execution contexts. The VM only knows it when
+// the outermost execution context was called directly by a user account, which
+// is also the case for the inner transaction of a relayed transaction. The
+// cross-shard steps of a transaction, i.e. asynchronous calls, callbacks and
+// calls from contracts in other shards, only carry their immediate caller.
+func (context *runtimeContext) GetOriginalCallerAddr() ([]byte, error) {
+	outermostInput := context.vmInput
+	if len(context.stateStack) > 0 {
+		outermostInput = context.stateStack[0].vmInput
+	}
+
+	if outermostInput.CallType != vm.DirectCall {
+		return nil, arwen.ErrOriginalCallerNotAvailable
+	}
+	if vmcommon.IsSmartContractAddress(outermostInput.CallerAddr) {
+		return nil, arwen.ErrOriginalCallerNotAvailable
+	}
+
+	return outermostInput.CallerAddr, nil
+}
+
 // Function returns the callFunction for the current context.
 func (context *runtimeContext) Function() string {
 	return context.callFunction
@@ -586,6 +642,7 @@ func (context *runtimeContext) Arguments() [][]byte {
 func (context *runtimeContext) ExtractCodeUpgradeFromArgs() ([]byte, []byte, error) {
 	const numMinUpgradeArguments = 2
 
+	context.materializeVMInput()
 	arguments := context.vmInput.Arguments
 	if len(arguments) < numMinUpgradeArguments {
 		return nil, nil, arwen.ErrInvalidUpgradeArguments
@@ -681,6 +738,134 @@ func (context *runtimeContext) VerifyContractCode() error {
 		}
 	}
 
+	if !context.host.AsyncCallESDTPaymentEnabled() {
+		err = context.checkIfContainsNewAsyncCallESDTPaymentAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.ExtendedStorageLocksEnabled() {
+		err = context.checkIfContainsNewExtendedStorageLocksAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.ManagedMapEnabled() {
+		err = context.checkIfContainsNewManagedMapAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.ManagedVecEnabled() {
+		err = context.checkIfContainsNewManagedVecAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.BigFloatEnabled() {
+		err = context.checkIfContainsNewBigFloatAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.BigIntModularEnabled() {
+		err = context.checkIfContainsNewBigIntModularAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.ManagedBufferStringsEnabled() {
+		err = context.checkIfContainsNewManagedBufferStringsAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.ManagedMemoryFreeEnabled() {
+		err = context.checkIfContainsNewManagedMemoryFreeAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.ExtendedHashingEnabled() {
+		err = context.checkIfContainsNewExtendedHashingAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.Secp256ExtensionsEnabled() {
+		err = context.checkIfContainsNewSecp256ExtensionsAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.AggregatedBLSEnabled() {
+		err = context.checkIfContainsNewAggregatedBLSAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.BN254Enabled() {
+		err = context.checkIfContainsNewBN254API()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.Ed25519BatchEnabled() {
+		err = context.checkIfContainsNewEd25519BatchAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.MerkleProofEnabled() {
+		err = context.checkIfContainsNewMerkleProofAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.AccountInfoEnabled() {
+		err = context.checkIfContainsNewAccountInfoAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !context.host.TxContextEnabled() {
+		err = context.checkIfContainsNewTxContextAPI()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
 	logRuntime.Trace("verified contract code")
 
 	return nil
@@ -780,6 +965,470 @@ func (context *runtimeContext) checkIfContainsNewManagedCryptoAPI() error {
 	return nil
 }
 
+func (context *runtimeContext) checkIfContainsNewAsyncCallESDTPaymentAPI() error {
+	if context.instance.IsFunctionImported("createAsyncCallWithESDT") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedCreateAsyncCall") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewExtendedStorageLocksAPI() error {
+	if context.instance.IsFunctionImported("setStorageLockByNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("getStorageLockByNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("isStorageLockedByNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("clearStorageLockByNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("setStorageLockByRound") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("getStorageLockByRound") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("isStorageLockedByRound") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("clearStorageLockByRound") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedSetStorageLock") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetStorageLock") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedIsStorageLocked") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedClearStorageLock") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedSetStorageLockByNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetStorageLockByNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedIsStorageLockedByNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedClearStorageLockByNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedSetStorageLockByRound") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetStorageLockByRound") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedIsStorageLockedByRound") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedClearStorageLockByRound") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetStorageLocks") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewManagedMapAPI() error {
+	if context.instance.IsFunctionImported("mMapNew") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mMapPut") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mMapGet") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mMapContains") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mMapRemove") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mMapLen") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mMapKeys") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewManagedVecAPI() error {
+	if context.instance.IsFunctionImported("mVecNew") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecPush") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecGet") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecSet") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecRemove") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecLen") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecSlice") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecConcat") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecPushBigInt") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecGetBigInt") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecSetBigInt") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecPushU64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecGetU64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecSetU64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecRemoveU64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecLenU64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mVecSliceU64") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewBigFloatAPI() error {
+	if context.instance.IsFunctionImported("bigFloatNewFromParts") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatAdd") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatSub") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatMul") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatDiv") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatNeg") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatAbs") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatCmp") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatSqrt") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatPow") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatTruncate") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatFloor") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatCeil") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatSetBigInt") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferToBigFloat") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferFromBigFloat") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewBigIntModularAPI() error {
+	if context.instance.IsFunctionImported("bigIntModPow") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigIntModInverse") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigIntGCD") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigIntExtendedGCD") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedModPow") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedModInverse") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGCD") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewManagedBufferStringsAPI() error {
+	if context.instance.IsFunctionImported("mBufferCompare") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferFind") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferFindByte") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferSplit") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferParseDecimal") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferFormatDecimal") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferToBase64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferFromBase64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferFromHex") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferTopEncodeU64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferTopDecodeU64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferNestedEncodeU64") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferNestedEncodeBigUint") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferNestedEncodeBigInt") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferNestedDecodeBigUint") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferNestedDecodeBigInt") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewManagedMemoryFreeAPI() error {
+	if context.instance.IsFunctionImported("bigIntFree") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mBufferFree") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bigFloatFree") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("mMapFree") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("freeEC") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewExtendedHashingAPI() error {
+	if context.instance.IsFunctionImported("sha512") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedSha512") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("sha3256") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedSha3256") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("blake2b256") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedBlake2b256") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("blake2b512") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedBlake2b512") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("blake2s256") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedBlake2s256") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewSecp256ExtensionsAPI() error {
+	if context.instance.IsFunctionImported("recoverSecp256k1") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedRecoverSecp256k1") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("verifySecp256r1") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedVerifySecp256r1") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewAggregatedBLSAPI() error {
+	if context.instance.IsFunctionImported("managedVerifyAggregatedBLS") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedVerifyAggregatedDistinctMessagesBLS") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewBN254API() error {
+	if context.instance.IsFunctionImported("bn254G1Add") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedBn254G1Add") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bn254G1ScalarMul") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedBn254G1ScalarMul") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("bn254PairingCheck") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedBn254PairingCheck") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedVerifyGroth16") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewEd25519BatchAPI() error {
+	if context.instance.IsFunctionImported("managedVerifyEd25519Batch") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewMerkleProofAPI() error {
+	if context.instance.IsFunctionImported("managedVerifyMerkleProof") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewAccountInfoAPI() error {
+	if context.instance.IsFunctionImported("getNumberOfShards") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("getSelfShardID") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetCodeHash") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetCodeMetadata") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetAccountNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetAccountOwner") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetAccountUsername") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsNewTxContextAPI() error {
+	if context.instance.IsFunctionImported("getGasPrice") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("getGasProvided") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("getCallerNonce") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("getOriginalCaller") {
+		return arwen.ErrContractInvalid
+	}
+	if context.instance.IsFunctionImported("managedGetOriginalCaller") {
+		return arwen.ErrContractInvalid
+	}
+
+	return nil
+}
+
 // ElrondAPIErrorShouldFailExecution returns true
 func (context *runtimeContext) ElrondAPIErrorShouldFailExecution() bool {
 	return true
@@ -55,7 +55,7 @@ func InitializeArwenAndWasmer() *contextmock.VMHostMock {
 	return host
 }
 
-func makeDefaultRuntimeContext(t *testing.T, host arwen.VMHost) *runtimeContext {
+func makeDefaultRuntimeContext(tb testing.TB, host arwen.VMHost) *runtimeContext {
 	runtimeContext, err := NewRuntimeContext(
 		host,
 		vmType,
@@ -64,8 +64,8 @@ func makeDefaultRuntimeContext(t *testing.T, host arwen.VMHost) *runtimeContext
 		0,
 		0,
 	)
-	require.Nil(t, err)
-	require.NotNil(t, runtimeContext)
+	require.Nil(tb, err)
+	require.NotNil(tb, runtimeContext)
 
 	return runtimeContext
 }
@@ -297,6 +297,47 @@ func TestRuntimeContext_PushPopState(t *testing.T) {
 	require.Equal(t, 0, len(runtimeContext.stateStack))
 }
 
+func TestRuntimeContext_PushStateCopiesVMInputOnWrite(t *testing.T) {
+	host := &contextmock.VMHostMock{}
+	host.SCAPIMethods = MakeAPIImports()
+	runtimeContext := makeDefaultRuntimeContext(t, host)
+	defer runtimeContext.ClearWarmInstanceCache()
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("caller"),
+			Arguments:   [][]byte{[]byte("code"), []byte("metadata"), []byte("arg")},
+			GasProvided: 1000,
+			CallValue:   big.NewInt(0),
+		},
+		RecipientAddr: []byte("smartcontract"),
+		Function:      "test_func",
+	}
+	runtimeContext.InitStateFromContractCallInput(input)
+	vmInput := runtimeContext.vmInput
+
+	runtimeContext.PushState()
+	require.True(t, runtimeContext.vmInputShared)
+	require.True(t, vmInput == runtimeContext.stateStack[0].vmInput)
+
+	_, _, err := runtimeContext.ExtractCodeUpgradeFromArgs()
+	require.Nil(t, err)
+	runtimeContext.GetVMInput().GasProvided = 0
+	require.False(t, runtimeContext.vmInputShared)
+	require.Equal(t, [][]byte{[]byte("arg")}, runtimeContext.Arguments())
+
+	runtimeContext.PopSetActiveState()
+	require.True(t, vmInput == runtimeContext.vmInput)
+	require.Equal(t, uint64(1000), runtimeContext.GetVMInput().GasProvided)
+	require.Len(t, runtimeContext.Arguments(), 3)
+
+	runtimeContext.PushState()
+	runtimeContext.InitStateFromContractCallInput(input)
+	require.False(t, runtimeContext.vmInputShared)
+	runtimeContext.PopDiscard()
+	require.False(t, runtimeContext.vmInputShared)
+}
+
 func TestRuntimeContext_Instance(t *testing.T) {
 	host := InitializeArwenAndWasmer()
 	runtimeContext := makeDefaultRuntimeContext(t, host)
@@ -688,3 +729,37 @@ func TestRuntimeContext_PopInstanceIfStackIsEmptyShouldNotPanic(t *testing.T) {
 
 	require.Equal(t, 0, len(runtimeContext.stateStack))
 }
+
+func BenchmarkRuntimeContext_PushPopState(b *testing.B) {
+	host := &contextmock.VMHostMock{}
+	host.SCAPIMethods = MakeAPIImports()
+	runtimeContext := makeDefaultRuntimeContext(b, host)
+	defer runtimeContext.ClearWarmInstanceCache()
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  make([]byte, 32),
+			Arguments:   [][]byte{make([]byte, 32), make([]byte, 32), make([]byte, 32)},
+			GasProvided: 1000000,
+			CallValue:   big.NewInt(0),
+			ESDTTransfers: []*vmcommon.ESDTTransfer{
+				{ESDTValue: big.NewInt(10), ESDTTokenName: []byte("TOKEN-abcdef")},
+			},
+		},
+		RecipientAddr: make([]byte, 32),
+		Function:      "test_func",
+	}
+	runtimeContext.InitStateFromContractCallInput(input)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for depth := 0; depth < benchmarkCallDepth; depth++ {
+			runtimeContext.PushState()
+			runtimeContext.InitStateFromContractCallInput(input)
+		}
+		for depth := 0; depth < benchmarkCallDepth; depth++ {
+			runtimeContext.PopSetActiveState()
+		}
+	}
+}
@@ -22,6 +22,10 @@ type storageContext struct {
 	elrondProtectedKeyPrefix      []byte
 	arwenStorageProtectionEnabled bool
 
+	accesses       []arwen.StorageAccess
+	accessGasUsed  uint64
+	accessGasFreed uint64
+
 	useDifferentGasCostForReadingCachedStorageEpoch uint32
 	flagUseDifferentGasCostForReadingCachedStorage  atomic.Flag
 }
@@ -43,6 +47,7 @@ func NewStorageContext(
 		stateStack:                    make([][]byte, 0),
 		elrondProtectedKeyPrefix:      elrondProtectedKeyPrefix,
 		arwenStorageProtectionEnabled: true,
+		accesses:                      make([]arwen.StorageAccess, 0),
 		useDifferentGasCostForReadingCachedStorageEpoch: useDifferentGasCostForReadingCachedStorageEpoch,
 	}
 
@@ -54,8 +59,10 @@ func NewStorageContext(
 	return context, nil
 }
 
-// InitState does nothing
+// InitState clears the storage accesses recorded during the previous transaction
 func (context *storageContext) InitState() {
+	context.accesses = make([]arwen.StorageAccess, 0)
+	context.resetAccessGas()
 }
 
 // PushState appends the current address to the state stack.
@@ -105,9 +112,11 @@ func (context *storageContext) GetStorageUpdates(address []byte) map[string]*vmc
 
 // GetStorage returns the storage data mapped to the given key.
 func (context *storageContext) GetStorage(key []byte) ([]byte, bool) {
-	value, usedCache := context.GetStorageUnmetered(key)
+	context.resetAccessGas()
+	value, usedCache := context.getStorageFromAddressUnmetered(context.address, key)
 	context.useExtraGasForKeyIfNeeded(key, usedCache)
 	context.useGasForValueIfNeeded(value, usedCache)
+	context.recordAccess(arwen.StorageRead, context.address, key, len(value), usedCache, arwen.StorageUnchanged)
 	logStorage.Trace("get", "key", key, "value", value)
 
 	return value, usedCache
@@ -119,7 +128,7 @@ func (context *storageContext) useGasForValueIfNeeded(value []byte, usedCache bo
 	if !usedCache || !gasFlagSet {
 		costPerByte := metering.GasSchedule().BaseOperationCost.DataCopyPerByte
 		gasToUse := math.MulUint64(costPerByte, uint64(len(value)))
-		metering.UseGas(gasToUse)
+		context.useGas(gasToUse)
 	}
 }
 
@@ -132,22 +141,25 @@ func (context *storageContext) useExtraGasForKeyIfNeeded(key []byte, usedCache b
 	gasFlagSet := context.flagUseDifferentGasCostForReadingCachedStorage.IsSet()
 	if !gasFlagSet || !usedCache {
 		gasToUse := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(extraBytes))
-		metering.UseGas(gasToUse)
+		context.useGas(gasToUse)
 	}
 }
 
 // GetStorageFromAddress returns the data under the given key from the account mapped to the given address.
 func (context *storageContext) GetStorageFromAddress(address []byte, key []byte) ([]byte, bool) {
+	context.resetAccessGas()
 	if !bytes.Equal(address, context.address) {
 		userAcc, err := context.blockChainHook.GetUserAccount(address)
 		if err != nil || check.IfNil(userAcc) {
 			context.useExtraGasForKeyIfNeeded(key, false)
+			context.recordAccess(arwen.StorageRead, address, key, 0, false, arwen.StorageUnchanged)
 			return nil, false
 		}
 
 		metadata := vmcommon.CodeMetadataFromBytes(userAcc.GetCodeMetadata())
 		if !metadata.Readable {
 			context.useExtraGasForKeyIfNeeded(key, false)
+			context.recordAccess(arwen.StorageRead, address, key, 0, false, arwen.StorageUnchanged)
 			return nil, false
 		}
 	}
@@ -161,6 +173,7 @@ func (context *storageContext) GetStorageFromAddress(address []byte, key []byte)
 
 	context.useExtraGasForKeyIfNeeded(key, usedCache)
 	context.useGasForValueIfNeeded(value, usedCache)
+	context.recordAccess(arwen.StorageRead, address, key, len(value), usedCache, arwen.StorageUnchanged)
 
 	logStorage.Trace("get from address", "address", address, "key", key, "value", value)
 	return value, usedCache
@@ -192,7 +205,10 @@ func (context *storageContext) getStorageFromAddressUnmetered(address []byte, ke
 
 // GetStorageUnmetered returns the data under the given key.
 func (context *storageContext) GetStorageUnmetered(key []byte) ([]byte, bool) {
-	return context.getStorageFromAddressUnmetered(context.address, key)
+	context.resetAccessGas()
+	value, usedCache := context.getStorageFromAddressUnmetered(context.address, key)
+	context.recordAccess(arwen.StorageRead, context.address, key, len(value), usedCache, arwen.StorageUnchanged)
+	return value, usedCache
 }
 
 // enableStorageProtection will prevent writing to protected keys
@@ -223,42 +239,50 @@ func (context *storageContext) SetProtectedStorage(key []byte, value []byte) (ar
 
 // SetStorage sets the given value at the given key.
 func (context *storageContext) SetStorage(key []byte, value []byte) (arwen.StorageStatus, error) {
+	context.resetAccessGas()
+	status, usedCache, err := context.setStorage(key, value)
+	context.recordAccess(arwen.StorageWrite, context.address, key, len(value), usedCache, status)
+	return status, err
+}
+
+func (context *storageContext) setStorage(key []byte, value []byte) (arwen.StorageStatus, bool, error) {
 	if context.host.Runtime().ReadOnly() {
 		logStorage.Trace("storage set", "error", "cannot set storage in readonly mode")
-		return arwen.StorageUnchanged, nil
+		return arwen.StorageUnchanged, false, nil
 	}
 	if context.isElrondReservedKey(key) {
 		logStorage.Trace("storage set", "error", arwen.ErrStoreElrondReservedKey, "key", key)
-		return arwen.StorageUnchanged, arwen.ErrStoreElrondReservedKey
+		return arwen.StorageUnchanged, false, arwen.ErrStoreElrondReservedKey
 	}
 	if context.isArwenProtectedKey(key) && context.arwenStorageProtectionEnabled {
 		logStorage.Trace("storage set", "error", arwen.ErrCannotWriteProtectedKey, "key", key)
-		return arwen.StorageUnchanged, arwen.ErrCannotWriteProtectedKey
+		return arwen.StorageUnchanged, false, arwen.ErrCannotWriteProtectedKey
 	}
 
-	metering := context.host.Metering()
-
 	length := len(value)
 
 	storageUpdates := context.GetStorageUpdates(context.address)
 	oldValue, usedCache := context.getOldValue(storageUpdates, key)
 
 	gasForKey := context.computeGasForKey(key, usedCache)
-	metering.UseGas(gasForKey)
+	context.useGas(gasForKey)
 
 	if bytes.Equal(oldValue, value) {
-		return context.storageUnchanged(length, usedCache)
+		status, err := context.storageUnchanged(length, usedCache)
+		return status, usedCache, err
 	}
 
 	context.changeStorageUpdate(key, value, storageUpdates)
 
 	if len(oldValue) == 0 {
-		return context.storageAdded(length, key, value)
+		status, err := context.storageAdded(length, key, value)
+		return status, usedCache, err
 	}
 
 	lengthOldValue := len(oldValue)
 	if len(value) == 0 {
-		return context.storageDeleted(lengthOldValue, key)
+		status, err := context.storageDeleted(lengthOldValue, key)
+		return status, usedCache, err
 	}
 
 	newValueExtraLength := math.SubInt(length, lengthOldValue)
@@ -273,11 +297,11 @@ func (context *storageContext) SetStorage(key []byte, value []byte) (arwen.Stora
 		gasToUseForValue, gasToFreeForValue = 0, 0
 	}
 
-	metering.UseGas(gasToUseForValue)
-	metering.FreeGas(gasToFreeForValue)
+	context.useGas(gasToUseForValue)
+	context.freeGas(gasToFreeForValue)
 
 	logStorage.Trace("storage modified", "key", key, "value", value, "lengthDelta", newValueExtraLength)
-	return arwen.StorageModified, nil
+	return arwen.StorageModified, usedCache, nil
 }
 
 func (context *storageContext) changeStorageUpdate(key []byte, value []byte, storageUpdates map[string]*vmcommon.StorageUpdate) {
@@ -310,7 +334,7 @@ func (context *storageContext) computeGasForBiggerValues(lengthOldValue int, new
 func (context *storageContext) storageAdded(length int, key []byte, value []byte) (arwen.StorageStatus, error) {
 	metering := context.host.Metering()
 	useGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.StorePerByte, uint64(length))
-	metering.UseGas(useGas)
+	context.useGas(useGas)
 	logStorage.Trace("storage added", "key", key, "value", value)
 	return arwen.StorageAdded, nil
 }
@@ -318,14 +342,14 @@ func (context *storageContext) storageAdded(length int, key []byte, value []byte
 func (context *storageContext) storageDeleted(lengthOldValue int, key []byte) (arwen.StorageStatus, error) {
 	metering := context.host.Metering()
 	freeGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.ReleasePerByte, uint64(lengthOldValue))
-	metering.FreeGas(freeGas)
+	context.freeGas(freeGas)
 	logStorage.Trace("storage deleted", "key", key)
 	return arwen.StorageDeleted, nil
 }
 
 func (context *storageContext) storageUnchanged(length int, usedCache bool) (arwen.StorageStatus, error) {
 	useGas := context.computeGasForUnchangedValue(length, usedCache)
-	context.host.Metering().UseGas(useGas)
+	context.useGas(useGas)
 	logStorage.Trace("storage set to identical value")
 	return arwen.StorageUnchanged, nil
 }
@@ -344,8 +368,8 @@ func (context *storageContext) getOldValue(storageUpdates map[string]*vmcommon.S
 	usedCache := true
 	strKey := string(key)
 	if update, ok := storageUpdates[strKey]; !ok {
-		// if it's not in storageUpdates, GetStorageUnmetered() will use blockchain hook for sure
-		oldValue, _ = context.GetStorageUnmetered(key)
+		// if it's not in storageUpdates, getStorageFromAddressUnmetered() will use blockchain hook for sure
+		oldValue, _ = context.getStorageFromAddressUnmetered(context.address, key)
 		storageUpdates[strKey] = &vmcommon.StorageUpdate{
 			Offset: key,
 			Data:   oldValue,
@@ -376,6 +400,91 @@ func (context *storageContext) UseGasForStorageLoad(tracedFunctionName string, l
 	}
 
 	metering.UseGasAndAddTracedGas(tracedFunctionName, loadCost)
+	context.addLoadCostToLastRead(loadCost)
+}
+
+// GetStorageAccesses returns all the storage reads and writes of the current transaction, in order
+func (context *storageContext) GetStorageAccesses() []arwen.StorageAccess {
+	accesses := make([]arwen.StorageAccess, len(context.accesses))
+	copy(accesses, context.accesses)
+	return accesses
+}
+
+// GetReadSet returns the storage reads of the current transaction, in order
+func (context *storageContext) GetReadSet() []arwen.StorageAccess {
+	return context.getAccessesOfType(arwen.StorageRead)
+}
+
+// GetWriteSet returns the storage writes of the current transaction, in order
+func (context *storageContext) GetWriteSet() []arwen.StorageAccess {
+	return context.getAccessesOfType(arwen.StorageWrite)
+}
+
+func (context *storageContext) getAccessesOfType(accessType arwen.StorageAccessType) []arwen.StorageAccess {
+	accesses := make([]arwen.StorageAccess, 0)
+	for _, access := range context.accesses {
+		if access.Type == accessType {
+			accesses = append(accesses, access)
+		}
+	}
+	return accesses
+}
+
+func (context *storageContext) recordAccess(
+	accessType arwen.StorageAccessType,
+	address []byte,
+	key []byte,
+	valueLength int,
+	usedCache bool,
+	status arwen.StorageStatus,
+) {
+	context.accesses = append(context.accesses, arwen.StorageAccess{
+		Type:        accessType,
+		Address:     cloneBytes(address),
+		Key:         cloneBytes(key),
+		ValueLength: valueLength,
+		UsedCache:   usedCache,
+		Status:      status,
+		GasUsed:     context.accessGasUsed,
+		GasFreed:    context.accessGasFreed,
+	})
+	context.resetAccessGas()
+}
+
+// addLoadCostToLastRead attributes the cost of a storage load EEI function
+// to the read it has just performed
+func (context *storageContext) addLoadCostToLastRead(loadCost uint64) {
+	numAccesses := len(context.accesses)
+	if numAccesses == 0 {
+		return
+	}
+
+	lastAccess := &context.accesses[numAccesses-1]
+	if lastAccess.Type != arwen.StorageRead {
+		return
+	}
+	lastAccess.GasUsed = math.AddUint64(lastAccess.GasUsed, loadCost)
+}
+
+func (context *storageContext) useGas(gas uint64) {
+	context.host.Metering().UseGas(gas)
+	context.accessGasUsed = math.AddUint64(context.accessGasUsed, gas)
+}
+
+func (context *storageContext) freeGas(gas uint64) {
+	context.host.Metering().FreeGas(gas)
+	context.accessGasFreed = math.AddUint64(context.accessGasFreed, gas)
+}
+
+func (context *storageContext) resetAccessGas() {
+	context.accessGasUsed = 0
+	context.accessGasFreed = 0
+}
+
+func cloneBytes(b []byte) []byte {
+	clone := make([]byte, len(b))
+	copy(clone, b)
+	return clone
 }
 
 // IsUseDifferentGasCostFlagSet - getter for flag
@@ -377,6 +377,108 @@ func TestStorageContext_GetStorageFromAddress(t *testing.T) {
 	require.Nil(t, data)
 }
 
+func TestStorageContext_StorageAccesses(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account")
+	mockOutput := &contextmock.OutputContextMock{}
+	account := mockOutput.NewVMOutputAccount(address)
+	mockOutput.OutputAccountMock = account
+	mockOutput.OutputAccountIsNew = false
+
+	mockRuntime := &contextmock.RuntimeContextMock{}
+	mockMetering := &contextmock.MeteringContextMock{}
+	mockMetering.SetGasSchedule(config.MakeGasMapForTests())
+	mockMetering.BlockGasLimitMock = uint64(15000)
+
+	host := &contextmock.VMHostMock{
+		OutputContext:   mockOutput,
+		MeteringContext: mockMetering,
+		RuntimeContext:  mockRuntime,
+	}
+	bcHook := &contextmock.BlockchainHookStub{}
+
+	storageContext, _ := NewStorageContext(host, bcHook, epochNotifier, elrondReservedTestPrefix, 0)
+	storageContext.SetAddress(address)
+
+	key := []byte("key")
+	otherKey := []byte("other_key")
+	value := []byte("value")
+
+	storageStatus, err := storageContext.SetStorage(key, value)
+	require.Nil(t, err)
+	require.Equal(t, arwen.StorageAdded, storageStatus)
+
+	_, usedCache := storageContext.GetStorage(key)
+	require.True(t, usedCache)
+	storageContext.UseGasForStorageLoad("storageLoad", 7, usedCache)
+
+	storageStatus, err = storageContext.SetStorage(key, nil)
+	require.Nil(t, err)
+	require.Equal(t, arwen.StorageDeleted, storageStatus)
+
+	_, usedCache = storageContext.GetStorageUnmetered(otherKey)
+	require.False(t, usedCache)
+
+	_, err = storageContext.SetStorage([]byte("RESERVEDkey"), value)
+	require.Equal(t, arwen.ErrStoreElrondReservedKey, err)
+
+	expectedAccesses := []arwen.StorageAccess{
+		{
+			Type:        arwen.StorageWrite,
+			Address:     address,
+			Key:         key,
+			ValueLength: len(value),
+			UsedCache:   false,
+			Status:      arwen.StorageAdded,
+			GasUsed:     uint64(len(value)),
+		},
+		{
+			Type:        arwen.StorageRead,
+			Address:     address,
+			Key:         key,
+			ValueLength: len(value),
+			UsedCache:   true,
+			Status:      arwen.StorageUnchanged,
+			// cached reads only pay CachedStorageLoad instead of the load cost
+			GasUsed: 1,
+		},
+		{
+			Type:        arwen.StorageWrite,
+			Address:     address,
+			Key:         key,
+			ValueLength: 0,
+			UsedCache:   true,
+			Status:      arwen.StorageDeleted,
+			GasFreed:    uint64(len(value)),
+		},
+		{
+			Type:        arwen.StorageRead,
+			Address:     address,
+			Key:         otherKey,
+			ValueLength: 0,
+			UsedCache:   false,
+			Status:      arwen.StorageUnchanged,
+		},
+		{
+			Type:        arwen.StorageWrite,
+			Address:     address,
+			Key:         []byte("RESERVEDkey"),
+			ValueLength: len(value),
+			UsedCache:   false,
+			Status:      arwen.StorageUnchanged,
+		},
+	}
+	require.Equal(t, expectedAccesses, storageContext.GetStorageAccesses())
+	require.Equal(t, []arwen.StorageAccess{expectedAccesses[1], expectedAccesses[3]}, storageContext.GetReadSet())
+	require.Equal(t, []arwen.StorageAccess{expectedAccesses[0], expectedAccesses[2], expectedAccesses[4]}, storageContext.GetWriteSet())
+
+	storageContext.InitState()
+	require.Empty(t, storageContext.GetStorageAccesses())
+	require.Empty(t, storageContext.GetReadSet())
+	require.Empty(t, storageContext.GetWriteSet())
+}
+
 func TestStorageContext_LoadGasStoreGasPerKey(t *testing.T) {
 	// TODO
 }
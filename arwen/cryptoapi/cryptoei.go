@@ -12,16 +12,41 @@ package cryptoapi
 // extern int32_t v1_4_managedKeccak256(void *context, int32_t inputHanle, int32_t outputHandle);
 // extern int32_t v1_4_ripemd160(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
 // extern int32_t v1_4_managedRipemd160(void *context, int32_t dataHandle, int32_t resultHandle);
+// extern int32_t v1_4_sha512(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedSha512(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_sha3256(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedSha3256(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_blake2b256(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedBlake2b256(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_blake2b512(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedBlake2b512(void *context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t v1_4_blake2s256(void *context, int32_t dataOffset, int32_t length, int32_t resultOffset);
+// extern int32_t v1_4_managedBlake2s256(void *context, int32_t inputHandle, int32_t outputHandle);
 // extern int32_t v1_4_verifyBLS(void *context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
 // extern int32_t v1_4_managedVerifyBLS(void *context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t v1_4_managedVerifyAggregatedBLS(void *context, int32_t keysHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t v1_4_managedVerifyAggregatedDistinctMessagesBLS(void *context, int32_t keysHandle, int32_t messagesHandle, int32_t sigHandle);
 // extern int32_t v1_4_verifyEd25519(void *context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
 // extern int32_t v1_4_managedVerifyEd25519(void *context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t v1_4_managedVerifyEd25519Batch(void *context, int32_t keysHandle, int32_t messagesHandle, int32_t sigsHandle, int32_t invalidIndexHandle);
 // extern int32_t v1_4_verifySecp256k1(void *context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
 // extern int32_t v1_4_managedVerifySecp256k1(void *context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
 // extern int32_t v1_4_verifyCustomSecp256k1(void *context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset, int32_t hashType);
 // extern int32_t v1_4_managedVerifyCustomSecp256k1(void *context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle, int32_t hashType);
 // extern int32_t v1_4_encodeSecp256k1DerSignature(void *context, int32_t rOffset, int32_t rLength, int32_t sOffset, int32_t sLength, int32_t sigOffset);
 // extern int32_t v1_4_managedEncodeSecp256k1DerSignature(void *context, int32_t rHandle, int32_t sHandle, int32_t sigHandle);
+// extern int32_t v1_4_recoverSecp256k1(void *context, int32_t messageHashOffset, int32_t rOffset, int32_t sOffset, int32_t v, int32_t resultOffset);
+// extern int32_t v1_4_managedRecoverSecp256k1(void *context, int32_t messageHashHandle, int32_t rHandle, int32_t sHandle, int32_t v, int32_t resultHandle);
+// extern int32_t v1_4_verifySecp256r1(void *context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
+// extern int32_t v1_4_managedVerifySecp256r1(void *context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t v1_4_bn254G1Add(void *context, int32_t firstPointOffset, int32_t secondPointOffset, int32_t resultOffset);
+// extern int32_t v1_4_managedBn254G1Add(void *context, int32_t firstPointHandle, int32_t secondPointHandle, int32_t resultHandle);
+// extern int32_t v1_4_bn254G1ScalarMul(void *context, int32_t pointOffset, int32_t scalarOffset, int32_t resultOffset);
+// extern int32_t v1_4_managedBn254G1ScalarMul(void *context, int32_t pointHandle, int32_t scalarHandle, int32_t resultHandle);
+// extern int32_t v1_4_bn254PairingCheck(void *context, int32_t dataOffset, int32_t length);
+// extern int32_t v1_4_managedBn254PairingCheck(void *context, int32_t pairsHandle);
+// extern int32_t v1_4_managedVerifyGroth16(void *context, int32_t vkHandle, int32_t proofHandle, int32_t publicInputsHandle);
+// extern int32_t v1_4_managedVerifyMerkleProof(void *context, int32_t rootHandle, int32_t leafHandle, int32_t proofHandle, int32_t indexHandle, int32_t hashAlgo);
 // extern void v1_4_addEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t fstPointXHandle, int32_t fstPointYHandle, int32_t sndPointXHandle, int32_t sndPointYHandle);
 // extern void v1_4_doubleEC(void *context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t pointXHandle, int32_t pointYHandle);
 // extern int32_t v1_4_isOnCurveEC(void *context, int32_t ecHandle, int32_t pointXHandle, int32_t pointYHandle);
@@ -51,6 +76,8 @@ import (
 	"unsafe"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/bn254"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/merkle"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/secp256k1"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
@@ -63,17 +90,35 @@ const ed25519SignatureLength = 64
 const secp256k1CompressedPublicKeyLength = 33
 const secp256k1UncompressedPublicKeyLength = 65
 const secp256k1SignatureLength = 64
+const secp256k1ScalarLength = 32
+const secp256r1CompressedPublicKeyLength = 33
+const secp256r1UncompressedPublicKeyLength = 65
 const curveNameLength = 4
 
 const (
 	sha256Name                      = "sha256"
 	keccak256Name                   = "keccak256"
 	ripemd160Name                   = "ripemd160"
+	sha512Name                      = "sha512"
+	sha3256Name                     = "sha3256"
+	blake2b256Name                  = "blake2b256"
+	blake2b512Name                  = "blake2b512"
+	blake2s256Name                  = "blake2s256"
 	verifyBLSName                   = "verifyBLS"
+	verifyAggregatedBLSName         = "verifyAggregatedBLS"
+	verifyAggregatedBLSDistinctName = "verifyAggregatedDistinctMessagesBLS"
 	verifyEd25519Name               = "verifyEd25519"
+	verifyEd25519BatchName          = "verifyEd25519Batch"
 	verifySecp256k1Name             = "verifySecp256k1"
 	verifyCustomSecp256k1Name       = "verifyCustomSecp256k1"
 	encodeSecp256k1DerSignatureName = "encodeSecp256k1DerSignature"
+	recoverSecp256k1Name            = "recoverSecp256k1"
+	verifySecp256r1Name             = "verifySecp256r1"
+	bn254G1AddName                  = "bn254G1Add"
+	bn254G1ScalarMulName            = "bn254G1ScalarMul"
+	bn254PairingCheckName           = "bn254PairingCheck"
+	verifyGroth16Name               = "verifyGroth16"
+	verifyMerkleProofName           = "verifyMerkleProof"
 	addECName                       = "addEC"
 	doubleECName                    = "doubleEC"
 	isOnCurveECName                 = "isOnCurveEC"
@@ -123,6 +168,56 @@ func CryptoImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("sha512", v1_4_sha512, C.v1_4_sha512)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSha512", v1_4_managedSha512, C.v1_4_managedSha512)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("sha3256", v1_4_sha3256, C.v1_4_sha3256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSha3256", v1_4_managedSha3256, C.v1_4_managedSha3256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("blake2b256", v1_4_blake2b256, C.v1_4_blake2b256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedBlake2b256", v1_4_managedBlake2b256, C.v1_4_managedBlake2b256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("blake2b512", v1_4_blake2b512, C.v1_4_blake2b512)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedBlake2b512", v1_4_managedBlake2b512, C.v1_4_managedBlake2b512)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("blake2s256", v1_4_blake2s256, C.v1_4_blake2s256)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedBlake2s256", v1_4_managedBlake2s256, C.v1_4_managedBlake2s256)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("verifyBLS", v1_4_verifyBLS, C.v1_4_verifyBLS)
 	if err != nil {
 		return nil, err
@@ -133,6 +228,16 @@ func CryptoImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("managedVerifyAggregatedBLS", v1_4_managedVerifyAggregatedBLS, C.v1_4_managedVerifyAggregatedBLS)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedVerifyAggregatedDistinctMessagesBLS", v1_4_managedVerifyAggregatedDistinctMessagesBLS, C.v1_4_managedVerifyAggregatedDistinctMessagesBLS)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("verifyEd25519", v1_4_verifyEd25519, C.v1_4_verifyEd25519)
 	if err != nil {
 		return nil, err
@@ -143,6 +248,11 @@ func CryptoImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("managedVerifyEd25519Batch", v1_4_managedVerifyEd25519Batch, C.v1_4_managedVerifyEd25519Batch)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("verifySecp256k1", v1_4_verifySecp256k1, C.v1_4_verifySecp256k1)
 	if err != nil {
 		return nil, err
@@ -173,6 +283,66 @@ func CryptoImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("recoverSecp256k1", v1_4_recoverSecp256k1, C.v1_4_recoverSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedRecoverSecp256k1", v1_4_managedRecoverSecp256k1, C.v1_4_managedRecoverSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("verifySecp256r1", v1_4_verifySecp256r1, C.v1_4_verifySecp256r1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedVerifySecp256r1", v1_4_managedVerifySecp256r1, C.v1_4_managedVerifySecp256r1)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bn254G1Add", v1_4_bn254G1Add, C.v1_4_bn254G1Add)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedBn254G1Add", v1_4_managedBn254G1Add, C.v1_4_managedBn254G1Add)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bn254G1ScalarMul", v1_4_bn254G1ScalarMul, C.v1_4_bn254G1ScalarMul)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedBn254G1ScalarMul", v1_4_managedBn254G1ScalarMul, C.v1_4_managedBn254G1ScalarMul)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bn254PairingCheck", v1_4_bn254PairingCheck, C.v1_4_bn254PairingCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedBn254PairingCheck", v1_4_managedBn254PairingCheck, C.v1_4_managedBn254PairingCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedVerifyGroth16", v1_4_managedVerifyGroth16, C.v1_4_managedVerifyGroth16)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedVerifyMerkleProof", v1_4_managedVerifyMerkleProof, C.v1_4_managedVerifyMerkleProof)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("addEC", v1_4_addEC, C.v1_4_addEC)
 	if err != nil {
 		return nil, err
@@ -451,6 +621,195 @@ func v1_4_managedRipemd160(context unsafe.Pointer, inputHandle int32, outputHand
 	return 0
 }
 
+//export v1_4_sha512
+func v1_4_sha512(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	host := arwen.GetVMHost(context)
+	return Sha512WithHost(host, dataOffset, length, resultOffset)
+}
+
+// Sha512WithHost - sha512 with host instead of pointer context
+func Sha512WithHost(host arwen.VMHost, dataOffset int32, length int32, resultOffset int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return hashWithHost(host, sha512Name, host.Crypto().Sha512, gasSchedule.CryptoAPICost.SHA512, gasSchedule.CryptoAPICost.SHA512PerByte, dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedSha512
+func v1_4_managedSha512(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedSha512WithHost(host, inputHandle, outputHandle)
+}
+
+// ManagedSha512WithHost - managedSha512 with host instead of pointer context
+func ManagedSha512WithHost(host arwen.VMHost, inputHandle int32, outputHandle int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return managedHashWithHost(host, sha512Name, host.Crypto().Sha512, gasSchedule.CryptoAPICost.SHA512, gasSchedule.CryptoAPICost.SHA512PerByte, inputHandle, outputHandle)
+}
+
+//export v1_4_sha3256
+func v1_4_sha3256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	host := arwen.GetVMHost(context)
+	return Sha3256WithHost(host, dataOffset, length, resultOffset)
+}
+
+// Sha3256WithHost - sha3256 with host instead of pointer context
+func Sha3256WithHost(host arwen.VMHost, dataOffset int32, length int32, resultOffset int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return hashWithHost(host, sha3256Name, host.Crypto().Sha3256, gasSchedule.CryptoAPICost.SHA3256, gasSchedule.CryptoAPICost.SHA3256PerByte, dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedSha3256
+func v1_4_managedSha3256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedSha3256WithHost(host, inputHandle, outputHandle)
+}
+
+// ManagedSha3256WithHost - managedSha3256 with host instead of pointer context
+func ManagedSha3256WithHost(host arwen.VMHost, inputHandle int32, outputHandle int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return managedHashWithHost(host, sha3256Name, host.Crypto().Sha3256, gasSchedule.CryptoAPICost.SHA3256, gasSchedule.CryptoAPICost.SHA3256PerByte, inputHandle, outputHandle)
+}
+
+//export v1_4_blake2b256
+func v1_4_blake2b256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	host := arwen.GetVMHost(context)
+	return Blake2b256WithHost(host, dataOffset, length, resultOffset)
+}
+
+// Blake2b256WithHost - blake2b256 with host instead of pointer context
+func Blake2b256WithHost(host arwen.VMHost, dataOffset int32, length int32, resultOffset int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return hashWithHost(host, blake2b256Name, host.Crypto().Blake2b256, gasSchedule.CryptoAPICost.Blake2b256, gasSchedule.CryptoAPICost.Blake2b256PerByte, dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedBlake2b256
+func v1_4_managedBlake2b256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBlake2b256WithHost(host, inputHandle, outputHandle)
+}
+
+// ManagedBlake2b256WithHost - managedBlake2b256 with host instead of pointer context
+func ManagedBlake2b256WithHost(host arwen.VMHost, inputHandle int32, outputHandle int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return managedHashWithHost(host, blake2b256Name, host.Crypto().Blake2b256, gasSchedule.CryptoAPICost.Blake2b256, gasSchedule.CryptoAPICost.Blake2b256PerByte, inputHandle, outputHandle)
+}
+
+//export v1_4_blake2b512
+func v1_4_blake2b512(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	host := arwen.GetVMHost(context)
+	return Blake2b512WithHost(host, dataOffset, length, resultOffset)
+}
+
+// Blake2b512WithHost - blake2b512 with host instead of pointer context
+func Blake2b512WithHost(host arwen.VMHost, dataOffset int32, length int32, resultOffset int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return hashWithHost(host, blake2b512Name, host.Crypto().Blake2b512, gasSchedule.CryptoAPICost.Blake2b512, gasSchedule.CryptoAPICost.Blake2b512PerByte, dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedBlake2b512
+func v1_4_managedBlake2b512(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBlake2b512WithHost(host, inputHandle, outputHandle)
+}
+
+// ManagedBlake2b512WithHost - managedBlake2b512 with host instead of pointer context
+func ManagedBlake2b512WithHost(host arwen.VMHost, inputHandle int32, outputHandle int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return managedHashWithHost(host, blake2b512Name, host.Crypto().Blake2b512, gasSchedule.CryptoAPICost.Blake2b512, gasSchedule.CryptoAPICost.Blake2b512PerByte, inputHandle, outputHandle)
+}
+
+//export v1_4_blake2s256
+func v1_4_blake2s256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
+	host := arwen.GetVMHost(context)
+	return Blake2s256WithHost(host, dataOffset, length, resultOffset)
+}
+
+// Blake2s256WithHost - blake2s256 with host instead of pointer context
+func Blake2s256WithHost(host arwen.VMHost, dataOffset int32, length int32, resultOffset int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return hashWithHost(host, blake2s256Name, host.Crypto().Blake2s256, gasSchedule.CryptoAPICost.Blake2s256, gasSchedule.CryptoAPICost.Blake2s256PerByte, dataOffset, length, resultOffset)
+}
+
+//export v1_4_managedBlake2s256
+func v1_4_managedBlake2s256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBlake2s256WithHost(host, inputHandle, outputHandle)
+}
+
+// ManagedBlake2s256WithHost - managedBlake2s256 with host instead of pointer context
+func ManagedBlake2s256WithHost(host arwen.VMHost, inputHandle int32, outputHandle int32) int32 {
+	gasSchedule := host.Metering().GasSchedule()
+	return managedHashWithHost(host, blake2s256Name, host.Crypto().Blake2s256, gasSchedule.CryptoAPICost.Blake2s256, gasSchedule.CryptoAPICost.Blake2s256PerByte, inputHandle, outputHandle)
+}
+
+func hashWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	hashFunction func(data []byte) ([]byte, error),
+	baseCost uint64,
+	costPerByte uint64,
+	dataOffset int32,
+	length int32,
+	resultOffset int32,
+) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	costPerByte = math.AddUint64(costPerByte, metering.GasSchedule().BaseOperationCost.DataCopyPerByte)
+	gasToUse := math.AddUint64(baseCost, math.MulUint64(costPerByte, uint64(length)))
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+
+	data, err := runtime.MemLoad(dataOffset, length)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	result, err := hashFunction(data)
+	if err != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(err, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	err = runtime.MemStore(resultOffset, result)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return 0
+}
+
+func managedHashWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	hashFunction func(data []byte) ([]byte, error),
+	baseCost uint64,
+	costPerByte uint64,
+	inputHandle int32,
+	outputHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	metering.UseGasAndAddTracedGas(tracedFunctionName, baseCost)
+
+	inputBytes, err := managedType.GetBytes(inputHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(inputBytes)
+	metering.UseGasAndAddTracedGas(tracedFunctionName, math.MulUint64(costPerByte, uint64(len(inputBytes))))
+
+	result, err := hashFunction(inputBytes)
+	if err != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(err, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	managedType.SetBytes(outputHandle, result)
+
+	return 0
+}
+
 //export v1_4_verifyBLS
 func v1_4_verifyBLS(
 	context unsafe.Pointer,
@@ -537,6 +896,117 @@ func v1_4_managedVerifyBLS(
 	return 0
 }
 
+//export v1_4_managedVerifyAggregatedBLS
+func v1_4_managedVerifyAggregatedBLS(
+	context unsafe.Pointer,
+	keysHandle int32,
+	messageHandle int32,
+	sigHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVerifyAggregatedBLSWithHost(host, keysHandle, messageHandle, sigHandle)
+}
+
+// ManagedVerifyAggregatedBLSWithHost - managedVerifyAggregatedBLS with host instead of pointer context
+func ManagedVerifyAggregatedBLSWithHost(
+	host arwen.VMHost,
+	keysHandle int32,
+	messageHandle int32,
+	sigHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(verifyAggregatedBLSName)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifyAggregatedBLS
+	metering.UseAndTraceGas(gasToUse)
+
+	keys, _, err := managedType.ReadManagedVecOfManagedBuffers(keysHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().CryptoAPICost.AggregatedBLSPerKey, uint64(len(keys)))
+	metering.UseAndTraceGas(gasToUse)
+
+	msgBytes, err := managedType.GetBytes(messageHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(msgBytes)
+
+	sigBytes, err := managedType.GetBytes(sigHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(sigBytes)
+
+	invalidSigErr := crypto.VerifyAggregatedBLS(keys, msgBytes, sigBytes)
+	if invalidSigErr != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(invalidSigErr, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_managedVerifyAggregatedDistinctMessagesBLS
+func v1_4_managedVerifyAggregatedDistinctMessagesBLS(
+	context unsafe.Pointer,
+	keysHandle int32,
+	messagesHandle int32,
+	sigHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVerifyAggregatedDistinctMessagesBLSWithHost(host, keysHandle, messagesHandle, sigHandle)
+}
+
+// ManagedVerifyAggregatedDistinctMessagesBLSWithHost - managedVerifyAggregatedDistinctMessagesBLS with host instead of pointer context
+func ManagedVerifyAggregatedDistinctMessagesBLSWithHost(
+	host arwen.VMHost,
+	keysHandle int32,
+	messagesHandle int32,
+	sigHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(verifyAggregatedBLSDistinctName)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifyAggregatedBLS
+	metering.UseAndTraceGas(gasToUse)
+
+	keys, _, err := managedType.ReadManagedVecOfManagedBuffers(keysHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().CryptoAPICost.AggregatedBLSPerMsg, uint64(len(keys)))
+	metering.UseAndTraceGas(gasToUse)
+
+	msgs, _, err := managedType.ReadManagedVecOfManagedBuffers(messagesHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	sigBytes, err := managedType.GetBytes(sigHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(sigBytes)
+
+	invalidSigErr := crypto.VerifyAggregatedDistinctMessagesBLS(keys, msgs, sigBytes)
+	if invalidSigErr != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(invalidSigErr, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
 //export v1_4_verifyEd25519
 func v1_4_verifyEd25519(
 	context unsafe.Pointer,
@@ -585,36 +1055,45 @@ func v1_4_managedVerifyEd25519(
 	context unsafe.Pointer,
 	keyHandle, messageHandle, sigHandle int32,
 ) int32 {
-	runtime := arwen.GetRuntimeContext(context)
-	crypto := arwen.GetCryptoContext(context)
-	metering := arwen.GetMeteringContext(context)
-	managedType := arwen.GetManagedTypesContext(context)
+	host := arwen.GetVMHost(context)
+	return ManagedVerifyEd25519WithHost(host, keyHandle, messageHandle, sigHandle)
+}
+
+// ManagedVerifyEd25519WithHost - managedVerifyEd25519 with host instead of pointer context
+func ManagedVerifyEd25519WithHost(
+	host arwen.VMHost,
+	keyHandle, messageHandle, sigHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
 	metering.StartGasTracing(verifyEd25519Name)
 
 	gasToUse := metering.GasSchedule().CryptoAPICost.VerifyEd25519
 	metering.UseAndTraceGas(gasToUse)
 
 	keyBytes, err := managedType.GetBytes(keyHandle)
-	if arwen.WithFault(err, context, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	managedType.ConsumeGasForBytes(keyBytes)
 
 	msgBytes, err := managedType.GetBytes(messageHandle)
-	if arwen.WithFault(err, context, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	managedType.ConsumeGasForBytes(msgBytes)
 
 	sigBytes, err := managedType.GetBytes(sigHandle)
-	if arwen.WithFault(err, context, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return 1
 	}
 	managedType.ConsumeGasForBytes(sigBytes)
 
 	invalidSigErr := crypto.VerifyEd25519(keyBytes, msgBytes, sigBytes)
 	if invalidSigErr != nil {
-		arwen.WithFaultIfFailAlwaysActive(invalidSigErr, context, runtime.CryptoAPIErrorShouldFailExecution())
+		arwen.WithFaultAndHostIfFailAlwaysActive(invalidSigErr, host, runtime.CryptoAPIErrorShouldFailExecution())
 		return -1
 	}
 
@@ -722,8 +1201,69 @@ func v1_4_managedVerifyCustomSecp256k1(
 	return 0
 }
 
-//export v1_4_verifySecp256k1
-func v1_4_verifySecp256k1(
+//export v1_4_managedVerifyEd25519Batch
+func v1_4_managedVerifyEd25519Batch(
+	context unsafe.Pointer,
+	keysHandle, messagesHandle, sigsHandle, invalidIndexHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVerifyEd25519BatchWithHost(host, keysHandle, messagesHandle, sigsHandle, invalidIndexHandle)
+}
+
+// ManagedVerifyEd25519BatchWithHost verifies the signatures found in three
+// managed vecs of the same length. It returns 0 if all of them are valid, or
+// -1 if one of them is invalid, in which case the index of the first invalid
+// signature is written in the big int at invalidIndexHandle and the signatures
+// up to it are also charged as single verifications. Errors return -2.
+func ManagedVerifyEd25519BatchWithHost(
+	host arwen.VMHost,
+	keysHandle, messagesHandle, sigsHandle, invalidIndexHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(verifyEd25519BatchName)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifyEd25519Batch
+	metering.UseAndTraceGas(gasToUse)
+
+	keys, _, err := managedType.ReadManagedVecOfManagedBuffers(keysHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -2
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().CryptoAPICost.Ed25519BatchPerSig, uint64(len(keys)))
+	metering.UseAndTraceGas(gasToUse)
+
+	msgs, _, err := managedType.ReadManagedVecOfManagedBuffers(messagesHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -2
+	}
+
+	sigs, _, err := managedType.ReadManagedVecOfManagedBuffers(sigsHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -2
+	}
+
+	index, err := crypto.VerifyEd25519Batch(keys, msgs, sigs)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return -2
+	}
+	if index < 0 {
+		return 0
+	}
+
+	// finding the invalid signature verifies the ones before it one by one
+	gasToUse = math.MulUint64(metering.GasSchedule().CryptoAPICost.VerifyEd25519, uint64(index+1))
+	metering.UseAndTraceGas(gasToUse)
+
+	managedType.GetBigIntOrCreate(invalidIndexHandle).SetInt64(int64(index))
+	return -1
+}
+
+//export v1_4_verifySecp256k1
+func v1_4_verifySecp256k1(
 	context unsafe.Pointer,
 	keyOffset int32,
 	keyLength int32,
@@ -820,6 +1360,643 @@ func v1_4_managedEncodeSecp256k1DerSignature(
 	return 0
 }
 
+//export v1_4_recoverSecp256k1
+func v1_4_recoverSecp256k1(
+	context unsafe.Pointer,
+	messageHashOffset int32,
+	rOffset int32,
+	sOffset int32,
+	v int32,
+	resultOffset int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return RecoverSecp256k1WithHost(host, messageHashOffset, rOffset, sOffset, v, resultOffset)
+}
+
+// RecoverSecp256k1WithHost - recoverSecp256k1 with host instead of pointer context
+func RecoverSecp256k1WithHost(
+	host arwen.VMHost,
+	messageHashOffset int32,
+	rOffset int32,
+	sOffset int32,
+	v int32,
+	resultOffset int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.RecoverSecp256k1
+	metering.UseGasAndAddTracedGas(recoverSecp256k1Name, gasToUse)
+
+	messageHash, err := runtime.MemLoad(messageHashOffset, secp256k1ScalarLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	r, err := runtime.MemLoad(rOffset, secp256k1ScalarLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	s, err := runtime.MemLoad(sOffset, secp256k1ScalarLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	key, err := crypto.RecoverSecp256k1(messageHash, r, s, uint8(v))
+	if err != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(err, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	err = runtime.MemStore(resultOffset, key)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return 0
+}
+
+//export v1_4_managedRecoverSecp256k1
+func v1_4_managedRecoverSecp256k1(
+	context unsafe.Pointer,
+	messageHashHandle, rHandle, sHandle int32,
+	v int32,
+	resultHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedRecoverSecp256k1WithHost(host, messageHashHandle, rHandle, sHandle, v, resultHandle)
+}
+
+// ManagedRecoverSecp256k1WithHost - managedRecoverSecp256k1 with host instead of pointer context
+func ManagedRecoverSecp256k1WithHost(
+	host arwen.VMHost,
+	messageHashHandle, rHandle, sHandle int32,
+	v int32,
+	resultHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.RecoverSecp256k1
+	metering.UseGasAndAddTracedGas(recoverSecp256k1Name, gasToUse)
+
+	messageHash, err := managedType.GetBytes(messageHashHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(messageHash)
+
+	r, err := managedType.GetBytes(rHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(r)
+
+	s, err := managedType.GetBytes(sHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(s)
+
+	key, err := crypto.RecoverSecp256k1(messageHash, r, s, uint8(v))
+	if err != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(err, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	managedType.SetBytes(resultHandle, key)
+
+	return 0
+}
+
+//export v1_4_verifySecp256r1
+func v1_4_verifySecp256r1(
+	context unsafe.Pointer,
+	keyOffset int32,
+	keyLength int32,
+	messageOffset int32,
+	messageLength int32,
+	sigOffset int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return VerifySecp256r1WithHost(host, keyOffset, keyLength, messageOffset, messageLength, sigOffset)
+}
+
+// VerifySecp256r1WithHost - verifySecp256r1 with host instead of pointer context
+func VerifySecp256r1WithHost(
+	host arwen.VMHost,
+	keyOffset int32,
+	keyLength int32,
+	messageOffset int32,
+	messageLength int32,
+	sigOffset int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	metering.StartGasTracing(verifySecp256r1Name)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifySecp256r1
+	metering.UseAndTraceGas(gasToUse)
+
+	if keyLength != secp256r1CompressedPublicKeyLength && keyLength != secp256r1UncompressedPublicKeyLength {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrInvalidPublicKeySize, runtime.ElrondAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	key, err := runtime.MemLoad(keyOffset, keyLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(messageLength))
+	metering.UseAndTraceGas(gasToUse)
+
+	message, err := runtime.MemLoad(messageOffset, messageLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	// read the 2 leading bytes first
+	// byte1: 0x30, header
+	// byte2: the remaining buffer length
+	const sigHeaderLength = 2
+	sigHeader, err := runtime.MemLoad(sigOffset, sigHeaderLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	sigLength := int32(sigHeader[1]) + sigHeaderLength
+	sig, err := runtime.MemLoad(sigOffset, sigLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	invalidSigErr := crypto.VerifySecp256r1(key, message, sig)
+	if invalidSigErr != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(invalidSigErr, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_managedVerifySecp256r1
+func v1_4_managedVerifySecp256r1(
+	context unsafe.Pointer,
+	keyHandle, messageHandle, sigHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVerifySecp256r1WithHost(host, keyHandle, messageHandle, sigHandle)
+}
+
+// ManagedVerifySecp256r1WithHost - managedVerifySecp256r1 with host instead of pointer context
+func ManagedVerifySecp256r1WithHost(
+	host arwen.VMHost,
+	keyHandle, messageHandle, sigHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(verifySecp256r1Name)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifySecp256r1
+	metering.UseAndTraceGas(gasToUse)
+
+	keyBytes, err := managedType.GetBytes(keyHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(keyBytes)
+
+	msgBytes, err := managedType.GetBytes(messageHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(msgBytes)
+
+	sigBytes, err := managedType.GetBytes(sigHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(sigBytes)
+
+	invalidSigErr := crypto.VerifySecp256r1(keyBytes, msgBytes, sigBytes)
+	if invalidSigErr != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(invalidSigErr, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_bn254G1Add
+func v1_4_bn254G1Add(
+	context unsafe.Pointer,
+	firstPointOffset int32,
+	secondPointOffset int32,
+	resultOffset int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return Bn254G1AddWithHost(host, firstPointOffset, secondPointOffset, resultOffset)
+}
+
+// Bn254G1AddWithHost - bn254G1Add with host instead of pointer context
+func Bn254G1AddWithHost(
+	host arwen.VMHost,
+	firstPointOffset int32,
+	secondPointOffset int32,
+	resultOffset int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.Bn254G1Add
+	metering.UseGasAndAddTracedGas(bn254G1AddName, gasToUse)
+
+	firstPoint, err := runtime.MemLoad(firstPointOffset, bn254.G1PointLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	secondPoint, err := runtime.MemLoad(secondPointOffset, bn254.G1PointLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	sum, err := crypto.Bn254G1Add(firstPoint, secondPoint)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	err = runtime.MemStore(resultOffset, sum)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return 0
+}
+
+//export v1_4_managedBn254G1Add
+func v1_4_managedBn254G1Add(
+	context unsafe.Pointer,
+	firstPointHandle, secondPointHandle, resultHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBn254G1AddWithHost(host, firstPointHandle, secondPointHandle, resultHandle)
+}
+
+// ManagedBn254G1AddWithHost - managedBn254G1Add with host instead of pointer context
+func ManagedBn254G1AddWithHost(
+	host arwen.VMHost,
+	firstPointHandle, secondPointHandle, resultHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.Bn254G1Add
+	metering.UseGasAndAddTracedGas(bn254G1AddName, gasToUse)
+
+	firstPoint, err := managedType.GetBytes(firstPointHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(firstPoint)
+
+	secondPoint, err := managedType.GetBytes(secondPointHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(secondPoint)
+
+	sum, err := crypto.Bn254G1Add(firstPoint, secondPoint)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(resultHandle, sum)
+
+	return 0
+}
+
+//export v1_4_bn254G1ScalarMul
+func v1_4_bn254G1ScalarMul(
+	context unsafe.Pointer,
+	pointOffset int32,
+	scalarOffset int32,
+	resultOffset int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return Bn254G1ScalarMulWithHost(host, pointOffset, scalarOffset, resultOffset)
+}
+
+// Bn254G1ScalarMulWithHost - bn254G1ScalarMul with host instead of pointer context
+func Bn254G1ScalarMulWithHost(
+	host arwen.VMHost,
+	pointOffset int32,
+	scalarOffset int32,
+	resultOffset int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.Bn254G1ScalarMul
+	metering.UseGasAndAddTracedGas(bn254G1ScalarMulName, gasToUse)
+
+	point, err := runtime.MemLoad(pointOffset, bn254.G1PointLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	scalar, err := runtime.MemLoad(scalarOffset, bn254.FieldElementLength)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	product, err := crypto.Bn254G1ScalarMul(point, scalar)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	err = runtime.MemStore(resultOffset, product)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return 0
+}
+
+//export v1_4_managedBn254G1ScalarMul
+func v1_4_managedBn254G1ScalarMul(
+	context unsafe.Pointer,
+	pointHandle, scalarHandle, resultHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBn254G1ScalarMulWithHost(host, pointHandle, scalarHandle, resultHandle)
+}
+
+// ManagedBn254G1ScalarMulWithHost - managedBn254G1ScalarMul with host instead of pointer context
+func ManagedBn254G1ScalarMulWithHost(
+	host arwen.VMHost,
+	pointHandle, scalarHandle, resultHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.Bn254G1ScalarMul
+	metering.UseGasAndAddTracedGas(bn254G1ScalarMulName, gasToUse)
+
+	point, err := managedType.GetBytes(pointHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(point)
+
+	scalar, err := managedType.GetBytes(scalarHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(scalar)
+
+	product, err := crypto.Bn254G1ScalarMul(point, scalar)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(resultHandle, product)
+
+	return 0
+}
+
+//export v1_4_bn254PairingCheck
+func v1_4_bn254PairingCheck(
+	context unsafe.Pointer,
+	dataOffset int32,
+	length int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return Bn254PairingCheckWithHost(host, dataOffset, length)
+}
+
+// Bn254PairingCheckWithHost - bn254PairingCheck with host instead of pointer context
+func Bn254PairingCheckWithHost(
+	host arwen.VMHost,
+	dataOffset int32,
+	length int32,
+) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(bn254PairingCheckName)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.Bn254PairingCheck
+	metering.UseAndTraceGas(gasToUse)
+
+	if length < 0 {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrNegativeLength, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(length))
+	metering.UseAndTraceGas(gasToUse)
+
+	pairs, err := runtime.MemLoad(dataOffset, length)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return bn254PairingCheck(host, pairs)
+}
+
+//export v1_4_managedBn254PairingCheck
+func v1_4_managedBn254PairingCheck(
+	context unsafe.Pointer,
+	pairsHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBn254PairingCheckWithHost(host, pairsHandle)
+}
+
+// ManagedBn254PairingCheckWithHost - managedBn254PairingCheck with host instead of pointer context
+func ManagedBn254PairingCheckWithHost(
+	host arwen.VMHost,
+	pairsHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(bn254PairingCheckName)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.Bn254PairingCheck
+	metering.UseAndTraceGas(gasToUse)
+
+	pairs, err := managedType.GetBytes(pairsHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(pairs)
+
+	return bn254PairingCheck(host, pairs)
+}
+
+// bn254PairingCheck charges the cost of every pair and returns 0 if the product
+// of the pairings is the identity, -1 if it is not and 1 on malformed input
+func bn254PairingCheck(host arwen.VMHost, pairs []byte) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+
+	numPairs := uint64(len(pairs) / bn254.PairLength)
+	gasToUse := math.MulUint64(metering.GasSchedule().CryptoAPICost.Bn254PairingPerPair, numPairs)
+	metering.UseAndTraceGas(gasToUse)
+
+	isOne, err := crypto.Bn254PairingCheck(pairs)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	if !isOne {
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_managedVerifyGroth16
+func v1_4_managedVerifyGroth16(
+	context unsafe.Pointer,
+	vkHandle, proofHandle, publicInputsHandle int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVerifyGroth16WithHost(host, vkHandle, proofHandle, publicInputsHandle)
+}
+
+// ManagedVerifyGroth16WithHost - managedVerifyGroth16 with host instead of pointer context
+func ManagedVerifyGroth16WithHost(
+	host arwen.VMHost,
+	vkHandle, proofHandle, publicInputsHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(verifyGroth16Name)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifyGroth16
+	metering.UseAndTraceGas(gasToUse)
+
+	vkBytes, err := managedType.GetBytes(vkHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(vkBytes)
+
+	numInputs, err := bn254.NumGroth16PublicInputs(vkBytes)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().CryptoAPICost.Groth16PerInput, uint64(numInputs))
+	metering.UseAndTraceGas(gasToUse)
+
+	proofBytes, err := managedType.GetBytes(proofHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(proofBytes)
+
+	publicInputs, err := managedType.GetBytes(publicInputsHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(publicInputs)
+
+	invalidProofErr := crypto.VerifyGroth16(vkBytes, proofBytes, publicInputs)
+	if invalidProofErr != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(invalidProofErr, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_managedVerifyMerkleProof
+func v1_4_managedVerifyMerkleProof(
+	context unsafe.Pointer,
+	rootHandle, leafHandle, proofHandle, indexHandle int32,
+	hashAlgo int32,
+) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVerifyMerkleProofWithHost(host, rootHandle, leafHandle, proofHandle, indexHandle, hashAlgo)
+}
+
+// ManagedVerifyMerkleProofWithHost checks that the leaf is included in the tree
+// with the given root. The proof is a managed vec with the siblings from the
+// leaf up, the index buffer holds the big endian leaf index, which is ignored
+// for sorted pairs, and hashAlgo takes the flags of the merkle package.
+// Returns 0 if the proof is valid, -1 if not and 1 on error.
+func ManagedVerifyMerkleProofWithHost(
+	host arwen.VMHost,
+	rootHandle, leafHandle, proofHandle, indexHandle int32,
+	hashAlgo int32,
+) int32 {
+	runtime := host.Runtime()
+	crypto := host.Crypto()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(verifyMerkleProofName)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifyMerkleProof
+	metering.UseAndTraceGas(gasToUse)
+
+	err := merkle.ValidateHashAlgorithm(hashAlgo)
+	if arwen.WithFaultAndHost(host, err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	proof, _, err := managedType.ReadManagedVecOfManagedBuffers(proofHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().CryptoAPICost.MerkleProofPerStep, uint64(len(proof)))
+	metering.UseAndTraceGas(gasToUse)
+
+	root, err := managedType.GetBytes(rootHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(root)
+
+	leaf, err := managedType.GetBytes(leafHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(leaf)
+
+	index, err := managedType.GetBytes(indexHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(index)
+
+	invalidProofErr := merkle.VerifyProof(crypto, hashAlgo, root, leaf, proof, index)
+	if invalidProofErr != nil {
+		arwen.WithFaultAndHostIfFailAlwaysActive(invalidProofErr, host, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
 //export v1_4_addEC
 func v1_4_addEC(
 	context unsafe.Pointer,
@@ -0,0 +1,130 @@
+package elrondapi
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+)
+
+// ManagedGetCodeHashWithHost writes the code hash of the account at the given
+// address into a managed buffer, which is left empty for accounts without code.
+// Like the other account info functions, it fails for addresses which do not
+// belong to the shard of the contract.
+func ManagedGetCodeHashWithHost(host arwen.VMHost, addressHandle int32, resultHandle int32) {
+	blockchain := host.Blockchain()
+	managedAccountInfoWithHost(host, managedGetCodeHashName, addressHandle, resultHandle, blockchain.GetCodeHash)
+}
+
+// ManagedGetCodeMetadataWithHost writes the code metadata flags of the account
+// at the given address into a managed buffer
+func ManagedGetCodeMetadataWithHost(host arwen.VMHost, addressHandle int32, resultHandle int32) {
+	blockchain := host.Blockchain()
+	managedAccountInfoWithHost(host, managedGetCodeMetadataName, addressHandle, resultHandle, blockchain.GetCodeMetadata)
+}
+
+// ManagedGetAccountOwnerWithHost writes the owner of the account at the given
+// address into a managed buffer
+func ManagedGetAccountOwnerWithHost(host arwen.VMHost, addressHandle int32, resultHandle int32) {
+	blockchain := host.Blockchain()
+	managedAccountInfoWithHost(host, managedGetAccountOwnerName, addressHandle, resultHandle, blockchain.GetAccountOwner)
+}
+
+// ManagedGetAccountUsernameWithHost writes the username of the account at the
+// given address into a managed buffer
+func ManagedGetAccountUsernameWithHost(host arwen.VMHost, addressHandle int32, resultHandle int32) {
+	blockchain := host.Blockchain()
+	managedAccountInfoWithHost(host, managedGetAccountUsernameName, addressHandle, resultHandle, blockchain.GetUsername)
+}
+
+func managedAccountInfoWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	addressHandle int32,
+	resultHandle int32,
+	getAccountInfo func(address []byte) []byte,
+) {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(tracedFunctionName)
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetAccountInfo
+	metering.UseAndTraceGas(gasToUse)
+
+	address, err := managedType.GetBytes(addressHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	err = checkAccountInSelfShard(host, address)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	info := getAccountInfo(address)
+	managedType.ConsumeGasForBytes(info)
+	managedType.SetBytes(resultHandle, info)
+}
+
+// checkAccountInSelfShard rejects addresses from other shards, whose accounts
+// are not available to the VM and would otherwise look like missing accounts
+func checkAccountInSelfShard(host arwen.VMHost, address []byte) error {
+	blockchain := host.Blockchain()
+	if blockchain.GetShardOfAddress(address) != blockchain.SelfShardID() {
+		return arwen.ErrAccountNotInSelfShard
+	}
+
+	return nil
+}
+
+// ManagedGetAccountNonceWithHost returns the nonce of the account at the given
+// address, including the increments of the current transaction, 0 if there is
+// no such account in the shard of the contract, or -1 on error
+func ManagedGetAccountNonceWithHost(host arwen.VMHost, addressHandle int32) int64 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	blockchain := host.Blockchain()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetAccountInfo
+	metering.UseGasAndAddTracedGas(managedGetAccountNonceName, gasToUse)
+
+	address, err := managedType.GetBytes(addressHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	err = checkAccountInSelfShard(host, address)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	if !blockchain.AccountExists(address) {
+		return 0
+	}
+
+	nonce, err := blockchain.GetNonce(address)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return int64(nonce)
+}
+
+// GetNumberOfShardsWithHost returns the number of shards of the network
+func GetNumberOfShardsWithHost(host arwen.VMHost) int32 {
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetNumberOfShards
+	metering.UseGasAndAddTracedGas(getNumberOfShardsName, gasToUse)
+
+	return int32(host.Blockchain().NumberOfShards())
+}
+
+// GetSelfShardIDWithHost returns the shard in which the contract runs
+func GetSelfShardIDWithHost(host arwen.VMHost) int32 {
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetSelfShardID
+	metering.UseGasAndAddTracedGas(getSelfShardIDName, gasToUse)
+
+	return int32(host.Blockchain().SelfShardID())
+}
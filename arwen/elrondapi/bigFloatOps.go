@@ -0,0 +1,528 @@
+package elrondapi
+
+// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
+//
+// #include <stdlib.h>
+// typedef unsigned char uint8_t;
+// typedef int int32_t;
+//
+// extern int32_t		v1_4_bigFloatNewFromParts(void* context, long long integralPart, long long fractionalPart, int32_t exponent);
+//
+// extern void			v1_4_bigFloatAdd(void* context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void			v1_4_bigFloatSub(void* context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void			v1_4_bigFloatMul(void* context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern void			v1_4_bigFloatDiv(void* context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+//
+// extern void			v1_4_bigFloatNeg(void* context, int32_t destinationHandle, int32_t opHandle);
+// extern void			v1_4_bigFloatAbs(void* context, int32_t destinationHandle, int32_t opHandle);
+// extern int32_t		v1_4_bigFloatCmp(void* context, int32_t op1Handle, int32_t op2Handle);
+// extern void			v1_4_bigFloatSqrt(void* context, int32_t destinationHandle, int32_t opHandle);
+// extern void			v1_4_bigFloatPow(void* context, int32_t destinationHandle, int32_t opHandle, int32_t exponent);
+//
+// extern void			v1_4_bigFloatTruncate(void* context, int32_t opHandle, int32_t bigIntHandle);
+// extern void			v1_4_bigFloatFloor(void* context, int32_t opHandle, int32_t bigIntHandle);
+// extern void			v1_4_bigFloatCeil(void* context, int32_t opHandle, int32_t bigIntHandle);
+// extern void			v1_4_bigFloatSetBigInt(void* context, int32_t destinationHandle, int32_t bigIntHandle);
+//
+// extern int32_t		v1_4_mBufferToBigFloat(void* context, int32_t mBufferHandle, int32_t bigFloatHandle);
+// extern int32_t		v1_4_mBufferFromBigFloat(void* context, int32_t mBufferHandle, int32_t bigFloatHandle);
+import "C"
+
+import (
+	"math/big"
+	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+)
+
+const (
+	bigFloatNewFromPartsName = "bigFloatNewFromParts"
+	bigFloatAddName          = "bigFloatAdd"
+	bigFloatSubName          = "bigFloatSub"
+	bigFloatMulName          = "bigFloatMul"
+	bigFloatDivName          = "bigFloatDiv"
+	bigFloatNegName          = "bigFloatNeg"
+	bigFloatAbsName          = "bigFloatAbs"
+	bigFloatCmpName          = "bigFloatCmp"
+	bigFloatSqrtName         = "bigFloatSqrt"
+	bigFloatPowName          = "bigFloatPow"
+	bigFloatTruncateName     = "bigFloatTruncate"
+	bigFloatFloorName        = "bigFloatFloor"
+	bigFloatCeilName         = "bigFloatCeil"
+	bigFloatSetBigIntName    = "bigFloatSetBigInt"
+	mBufferToBigFloatName    = "mBufferToBigFloat"
+	mBufferFromBigFloatName  = "mBufferFromBigFloat"
+)
+
+// BigFloatImports creates a new wasmer.Imports populated with the BigFloat API methods
+func BigFloatImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
+	imports = imports.Namespace("env")
+
+	imports, err := imports.Append("bigFloatNewFromParts", v1_4_bigFloatNewFromParts, C.v1_4_bigFloatNewFromParts)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatAdd", v1_4_bigFloatAdd, C.v1_4_bigFloatAdd)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatSub", v1_4_bigFloatSub, C.v1_4_bigFloatSub)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatMul", v1_4_bigFloatMul, C.v1_4_bigFloatMul)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatDiv", v1_4_bigFloatDiv, C.v1_4_bigFloatDiv)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatNeg", v1_4_bigFloatNeg, C.v1_4_bigFloatNeg)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatAbs", v1_4_bigFloatAbs, C.v1_4_bigFloatAbs)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatCmp", v1_4_bigFloatCmp, C.v1_4_bigFloatCmp)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatSqrt", v1_4_bigFloatSqrt, C.v1_4_bigFloatSqrt)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatPow", v1_4_bigFloatPow, C.v1_4_bigFloatPow)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatTruncate", v1_4_bigFloatTruncate, C.v1_4_bigFloatTruncate)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatFloor", v1_4_bigFloatFloor, C.v1_4_bigFloatFloor)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatCeil", v1_4_bigFloatCeil, C.v1_4_bigFloatCeil)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatSetBigInt", v1_4_bigFloatSetBigInt, C.v1_4_bigFloatSetBigInt)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferToBigFloat", v1_4_mBufferToBigFloat, C.v1_4_mBufferToBigFloat)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFromBigFloat", v1_4_mBufferFromBigFloat, C.v1_4_mBufferFromBigFloat)
+	if err != nil {
+		return nil, err
+	}
+
+	return imports, nil
+}
+
+//export v1_4_bigFloatNewFromParts
+func v1_4_bigFloatNewFromParts(context unsafe.Pointer, integralPart int64, fractionalPart int64, exponent int32) int32 {
+	host := arwen.GetVMHost(context)
+	return BigFloatNewFromPartsWithHost(host, integralPart, fractionalPart, exponent)
+}
+
+// BigFloatNewFromPartsWithHost creates a new big float holding
+// integralPart + fractionalPart * 10^exponent and returns its handle
+func BigFloatNewFromPartsWithHost(host arwen.VMHost, integralPart int64, fractionalPart int64, exponent int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().BigFloatAPICost.BigFloatNewFromParts
+	metering.UseGasAndAddTracedGas(bigFloatNewFromPartsName, gasToUse)
+
+	value, err := math.BigFloatFromParts(integralPart, fractionalPart, exponent)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	handle, err := managedType.NewBigFloat(value)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	return handle
+}
+
+//export v1_4_bigFloatAdd
+func v1_4_bigFloatAdd(context unsafe.Pointer, destinationHandle, op1Handle, op2Handle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatAddWithHost(host, destinationHandle, op1Handle, op2Handle)
+}
+
+// BigFloatAddWithHost sets the destination big float to op1 + op2
+func BigFloatAddWithHost(host arwen.VMHost, destinationHandle, op1Handle, op2Handle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatAdd
+	bigFloatBinaryOp(host, bigFloatAddName, gasToUse, destinationHandle, op1Handle, op2Handle, math.BigFloatAdd)
+}
+
+//export v1_4_bigFloatSub
+func v1_4_bigFloatSub(context unsafe.Pointer, destinationHandle, op1Handle, op2Handle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatSubWithHost(host, destinationHandle, op1Handle, op2Handle)
+}
+
+// BigFloatSubWithHost sets the destination big float to op1 - op2
+func BigFloatSubWithHost(host arwen.VMHost, destinationHandle, op1Handle, op2Handle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatSub
+	bigFloatBinaryOp(host, bigFloatSubName, gasToUse, destinationHandle, op1Handle, op2Handle, math.BigFloatSub)
+}
+
+//export v1_4_bigFloatMul
+func v1_4_bigFloatMul(context unsafe.Pointer, destinationHandle, op1Handle, op2Handle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatMulWithHost(host, destinationHandle, op1Handle, op2Handle)
+}
+
+// BigFloatMulWithHost sets the destination big float to op1 * op2
+func BigFloatMulWithHost(host arwen.VMHost, destinationHandle, op1Handle, op2Handle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatMul
+	bigFloatBinaryOp(host, bigFloatMulName, gasToUse, destinationHandle, op1Handle, op2Handle, math.BigFloatMul)
+}
+
+//export v1_4_bigFloatDiv
+func v1_4_bigFloatDiv(context unsafe.Pointer, destinationHandle, op1Handle, op2Handle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatDivWithHost(host, destinationHandle, op1Handle, op2Handle)
+}
+
+// BigFloatDivWithHost sets the destination big float to op1 / op2
+func BigFloatDivWithHost(host arwen.VMHost, destinationHandle, op1Handle, op2Handle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatDiv
+	bigFloatBinaryOp(host, bigFloatDivName, gasToUse, destinationHandle, op1Handle, op2Handle, math.BigFloatDiv)
+}
+
+//export v1_4_bigFloatNeg
+func v1_4_bigFloatNeg(context unsafe.Pointer, destinationHandle, opHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatNegWithHost(host, destinationHandle, opHandle)
+}
+
+// BigFloatNegWithHost sets the destination big float to -op
+func BigFloatNegWithHost(host arwen.VMHost, destinationHandle, opHandle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatNeg
+	bigFloatUnaryOp(host, bigFloatNegName, gasToUse, destinationHandle, opHandle, func(op *big.Float) (*big.Float, error) {
+		return math.BigFloatNeg(op), nil
+	})
+}
+
+//export v1_4_bigFloatAbs
+func v1_4_bigFloatAbs(context unsafe.Pointer, destinationHandle, opHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatAbsWithHost(host, destinationHandle, opHandle)
+}
+
+// BigFloatAbsWithHost sets the destination big float to |op|
+func BigFloatAbsWithHost(host arwen.VMHost, destinationHandle, opHandle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatAbs
+	bigFloatUnaryOp(host, bigFloatAbsName, gasToUse, destinationHandle, opHandle, func(op *big.Float) (*big.Float, error) {
+		return math.BigFloatAbs(op), nil
+	})
+}
+
+//export v1_4_bigFloatSqrt
+func v1_4_bigFloatSqrt(context unsafe.Pointer, destinationHandle, opHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatSqrtWithHost(host, destinationHandle, opHandle)
+}
+
+// BigFloatSqrtWithHost sets the destination big float to the square root of op
+func BigFloatSqrtWithHost(host arwen.VMHost, destinationHandle, opHandle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatSqrt
+	bigFloatUnaryOp(host, bigFloatSqrtName, gasToUse, destinationHandle, opHandle, math.BigFloatSqrt)
+}
+
+//export v1_4_bigFloatCmp
+func v1_4_bigFloatCmp(context unsafe.Pointer, op1Handle, op2Handle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return BigFloatCmpWithHost(host, op1Handle, op2Handle)
+}
+
+// BigFloatCmpWithHost returns -1, 0 or 1 if op1 is less than, equal to or greater than op2
+func BigFloatCmpWithHost(host arwen.VMHost, op1Handle, op2Handle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().BigFloatAPICost.BigFloatCmp
+	metering.UseGasAndAddTracedGas(bigFloatCmpName, gasToUse)
+
+	a, b, err := managedType.GetTwoBigFloats(op1Handle, op2Handle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return -2
+	}
+	return int32(a.Cmp(b))
+}
+
+//export v1_4_bigFloatPow
+func v1_4_bigFloatPow(context unsafe.Pointer, destinationHandle, opHandle, exponent int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatPowWithHost(host, destinationHandle, opHandle, exponent)
+}
+
+// BigFloatPowWithHost sets the destination big float to op^exponent; besides
+// the base cost, every multiplication performed is charged as a BigFloatMul
+func BigFloatPowWithHost(host arwen.VMHost, destinationHandle, opHandle, exponent int32) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(bigFloatPowName)
+
+	gasSchedule := metering.GasSchedule().BigFloatAPICost
+	metering.UseAndTraceGas(gasSchedule.BigFloatPow)
+
+	op, err := managedType.GetBigFloat(opHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	result, numMultiplications, err := math.BigFloatPow(op, exponent)
+	metering.UseAndTraceGas(math.MulUint64(numMultiplications, gasSchedule.BigFloatMul))
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	err = managedType.PutBigFloat(destinationHandle, result)
+	_ = arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution())
+}
+
+//export v1_4_bigFloatTruncate
+func v1_4_bigFloatTruncate(context unsafe.Pointer, opHandle, bigIntHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatTruncateWithHost(host, opHandle, bigIntHandle)
+}
+
+// BigFloatTruncateWithHost sets the big int to the integer part of op, rounding towards zero
+func BigFloatTruncateWithHost(host arwen.VMHost, opHandle, bigIntHandle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatTruncate
+	bigFloatToBigInt(host, bigFloatTruncateName, gasToUse, opHandle, bigIntHandle, math.BigFloatTruncate)
+}
+
+//export v1_4_bigFloatFloor
+func v1_4_bigFloatFloor(context unsafe.Pointer, opHandle, bigIntHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatFloorWithHost(host, opHandle, bigIntHandle)
+}
+
+// BigFloatFloorWithHost sets the big int to the greatest integer less than or equal to op
+func BigFloatFloorWithHost(host arwen.VMHost, opHandle, bigIntHandle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatFloor
+	bigFloatToBigInt(host, bigFloatFloorName, gasToUse, opHandle, bigIntHandle, math.BigFloatFloor)
+}
+
+//export v1_4_bigFloatCeil
+func v1_4_bigFloatCeil(context unsafe.Pointer, opHandle, bigIntHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatCeilWithHost(host, opHandle, bigIntHandle)
+}
+
+// BigFloatCeilWithHost sets the big int to the least integer greater than or equal to op
+func BigFloatCeilWithHost(host arwen.VMHost, opHandle, bigIntHandle int32) {
+	gasToUse := host.Metering().GasSchedule().BigFloatAPICost.BigFloatCeil
+	bigFloatToBigInt(host, bigFloatCeilName, gasToUse, opHandle, bigIntHandle, math.BigFloatCeil)
+}
+
+//export v1_4_bigFloatSetBigInt
+func v1_4_bigFloatSetBigInt(context unsafe.Pointer, destinationHandle, bigIntHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigFloatSetBigIntWithHost(host, destinationHandle, bigIntHandle)
+}
+
+// BigFloatSetBigIntWithHost sets the destination big float to the value of the
+// big int, rounded to the precision of the big floats
+func BigFloatSetBigIntWithHost(host arwen.VMHost, destinationHandle, bigIntHandle int32) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(bigFloatSetBigIntName)
+
+	gasToUse := metering.GasSchedule().BigFloatAPICost.BigFloatSetBigInt
+	metering.UseAndTraceGas(gasToUse)
+
+	value, err := managedType.GetBigInt(bigIntHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+	managedType.ConsumeGasForBigIntCopy(value)
+
+	result, err := math.BigFloatFromBigInt(value)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	err = managedType.PutBigFloat(destinationHandle, result)
+	_ = arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution())
+}
+
+//export v1_4_mBufferToBigFloat
+func v1_4_mBufferToBigFloat(context unsafe.Pointer, mBufferHandle, bigFloatHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferToBigFloatWithHost(host, mBufferHandle, bigFloatHandle)
+}
+
+// ManagedBufferToBigFloatWithHost decodes the managed buffer, which must hold a
+// value encoded by mBufferFromBigFloat, into the big float
+func ManagedBufferToBigFloatWithHost(host arwen.VMHost, mBufferHandle, bigFloatHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferToBigFloatName)
+
+	gasToUse := metering.GasSchedule().BigFloatAPICost.MBufferToBigFloat
+	metering.UseAndTraceGas(gasToUse)
+
+	data, err := managedType.GetBytes(mBufferHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(data)
+
+	value, err := math.DecodeBigFloat(data)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	err = managedType.PutBigFloat(bigFloatHandle, value)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	return 0
+}
+
+//export v1_4_mBufferFromBigFloat
+func v1_4_mBufferFromBigFloat(context unsafe.Pointer, mBufferHandle, bigFloatHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferFromBigFloatWithHost(host, mBufferHandle, bigFloatHandle)
+}
+
+// ManagedBufferFromBigFloatWithHost encodes the big float into the managed buffer
+func ManagedBufferFromBigFloatWithHost(host arwen.VMHost, mBufferHandle, bigFloatHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferFromBigFloatName)
+
+	gasToUse := metering.GasSchedule().BigFloatAPICost.MBufferFromBigFloat
+	metering.UseAndTraceGas(gasToUse)
+
+	value, err := managedType.GetBigFloat(bigFloatHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	data, err := math.EncodeBigFloat(value)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(data)
+
+	managedType.SetBytes(mBufferHandle, data)
+	return 0
+}
+
+func bigFloatBinaryOp(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	gasToUse uint64,
+	destinationHandle, op1Handle, op2Handle int32,
+	operation func(a *big.Float, b *big.Float) (*big.Float, error),
+) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+
+	a, b, err := managedType.GetTwoBigFloats(op1Handle, op2Handle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	result, err := operation(a, b)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	err = managedType.PutBigFloat(destinationHandle, result)
+	_ = arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution())
+}
+
+func bigFloatUnaryOp(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	gasToUse uint64,
+	destinationHandle, opHandle int32,
+	operation func(a *big.Float) (*big.Float, error),
+) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+
+	a, err := managedType.GetBigFloat(opHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	result, err := operation(a)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	err = managedType.PutBigFloat(destinationHandle, result)
+	_ = arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution())
+}
+
+func bigFloatToBigInt(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	gasToUse uint64,
+	opHandle, bigIntHandle int32,
+	conversion func(a *big.Float) *big.Int,
+) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(tracedFunctionName)
+
+	metering.UseAndTraceGas(gasToUse)
+
+	a, err := managedType.GetBigFloat(opHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	result := conversion(a)
+	managedType.ConsumeGasForBigIntCopy(result)
+
+	destination := managedType.GetBigIntOrCreate(bigIntHandle)
+	destination.Set(result)
+}
@@ -0,0 +1,309 @@
+package elrondapi
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
+)
+
+// BigIntModPowWithHost sets the destination big int to base^exponent mod modulus
+func BigIntModPowWithHost(host arwen.VMHost, destinationHandle, baseHandle, exponentHandle, modulusHandle int32) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(bigIntModPowName)
+
+	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntModPow
+	metering.UseAndTraceGas(gasToUse)
+
+	base, exponent, err := managedType.GetTwoBigInt(baseHandle, exponentHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+	modulus, err := managedType.GetBigInt(modulusHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	result, err := ModPowWithTypedArgs(host, base, exponent, modulus)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+	managedType.GetBigIntOrCreate(destinationHandle).Set(result)
+}
+
+// BigIntModInverseWithHost sets the destination big int to the inverse of the
+// value modulo modulus
+func BigIntModInverseWithHost(host arwen.VMHost, destinationHandle, valueHandle, modulusHandle int32) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(bigIntModInverseName)
+
+	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntModInverse
+	metering.UseAndTraceGas(gasToUse)
+
+	value, modulus, err := managedType.GetTwoBigInt(valueHandle, modulusHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	result, err := ModInverseWithTypedArgs(host, value, modulus)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+	managedType.GetBigIntOrCreate(destinationHandle).Set(result)
+}
+
+// BigIntGCDWithHost sets the destination big int to the greatest common divisor of op1 and op2
+func BigIntGCDWithHost(host arwen.VMHost, destinationHandle, op1Handle, op2Handle int32) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(bigIntGCDName)
+
+	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntGCD
+	metering.UseAndTraceGas(gasToUse)
+
+	a, b, err := managedType.GetTwoBigInt(op1Handle, op2Handle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	gcd, _, _ := GCDWithTypedArgs(host, a, b)
+	managedType.GetBigIntOrCreate(destinationHandle).Set(gcd)
+}
+
+// BigIntExtendedGCDWithHost sets the destination big ints to the greatest
+// common divisor g of op1 and op2 and to the Bézout coefficients x and y, such
+// that g = op1*x + op2*y
+func BigIntExtendedGCDWithHost(host arwen.VMHost, gcdHandle, xHandle, yHandle, op1Handle, op2Handle int32) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(bigIntExtendedGCDName)
+
+	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntGCD
+	metering.UseAndTraceGas(gasToUse)
+
+	a, b, err := managedType.GetTwoBigInt(op1Handle, op2Handle)
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	gcd, x, y := GCDWithTypedArgs(host, a, b)
+	managedType.GetBigIntOrCreate(gcdHandle).Set(gcd)
+	managedType.GetBigIntOrCreate(xHandle).Set(x)
+	managedType.GetBigIntOrCreate(yHandle).Set(y)
+}
+
+// ManagedModPowWithHost computes base^exponent mod modulus, all of them being
+// managed buffers holding unsigned big endian integers
+func ManagedModPowWithHost(host arwen.VMHost, baseHandle, exponentHandle, modulusHandle, resultHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(managedModPowName)
+
+	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntModPow
+	metering.UseAndTraceGas(gasToUse)
+
+	operands, err := readUnsignedManagedBuffers(host, baseHandle, exponentHandle, modulusHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	result, err := ModPowWithTypedArgs(host, operands[0], operands[1], operands[2])
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(resultHandle, result.Bytes())
+	return 0
+}
+
+// ManagedModInverseWithHost computes the inverse of the value modulo modulus,
+// all of them being managed buffers holding unsigned big endian integers
+func ManagedModInverseWithHost(host arwen.VMHost, valueHandle, modulusHandle, resultHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(managedModInverseName)
+
+	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntModInverse
+	metering.UseAndTraceGas(gasToUse)
+
+	operands, err := readUnsignedManagedBuffers(host, valueHandle, modulusHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	result, err := ModInverseWithTypedArgs(host, operands[0], operands[1])
+	if arwen.WithFaultAndHost(host, err, runtime.BigIntAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(resultHandle, result.Bytes())
+	return 0
+}
+
+// ManagedGCDWithHost computes the greatest common divisor of two managed
+// buffers holding unsigned big endian integers
+func ManagedGCDWithHost(host arwen.VMHost, op1Handle, op2Handle, resultHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(managedGCDName)
+
+	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntGCD
+	metering.UseAndTraceGas(gasToUse)
+
+	operands, err := readUnsignedManagedBuffers(host, op1Handle, op2Handle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gcd, _, _ := GCDWithTypedArgs(host, operands[0], operands[1])
+	managedType.SetBytes(resultHandle, gcd.Bytes())
+	return 0
+}
+
+// maxModPowOperandLength is the maximum length in bytes of each of the
+// operands of a modular exponentiation
+const maxModPowOperandLength = 1024
+
+// the operands shorter than these are charged as if they had this length,
+// since the fixed costs of the exponentiation dominate for them
+const minModPowWords = 8
+const minModPowIterations = 64
+
+// ModPowWithTypedArgs returns base^exponent mod modulus, in [0, modulus). The
+// gas follows EIP-2565: it is quadratic in the length in words of the modulus
+// and linear in the number of bits of the exponent.
+func ModPowWithTypedArgs(host arwen.VMHost, base, exponent, modulus *big.Int) (*big.Int, error) {
+	managedType := host.ManagedTypes()
+	metering := host.Metering()
+	managedType.ConsumeGasForBigIntCopy(base, exponent, modulus)
+
+	if byteLength(base) > maxModPowOperandLength ||
+		byteLength(exponent) > maxModPowOperandLength ||
+		byteLength(modulus) > maxModPowOperandLength {
+		return nil, arwen.ErrModPowOperandTooLarge
+	}
+
+	err := checkModulus(modulus)
+	if err != nil {
+		return nil, err
+	}
+	if exponent.Sign() < 0 {
+		return nil, arwen.ErrBadLowerBounds
+	}
+
+	words := uint64((modulus.BitLen() + 63) / 64)
+	if words < minModPowWords {
+		words = minModPowWords
+	}
+	iterations := uint64(exponent.BitLen())
+	if iterations < minModPowIterations {
+		iterations = minModPowIterations
+	}
+	wordMultiplications := math.MulUint64(words*words, iterations)
+	metering.UseAndTraceGas(math.MulUint64(wordMultiplications, metering.GasSchedule().BigIntAPICost.BigIntModPowPerWordMul))
+
+	return big.NewInt(0).Exp(base, exponent, modulus), nil
+}
+
+// ModInverseWithTypedArgs returns the inverse of the value modulo modulus, in [0, modulus)
+func ModInverseWithTypedArgs(host arwen.VMHost, value, modulus *big.Int) (*big.Int, error) {
+	managedType := host.ManagedTypes()
+	managedType.ConsumeGasForBigIntCopy(value, modulus)
+	managedType.ConsumeGasForThisBigIntNumberOfBytes(big.NewInt(int64(byteLength(modulus))))
+
+	err := checkModulus(modulus)
+	if err != nil {
+		return nil, err
+	}
+
+	reduced := big.NewInt(0).Mod(value, modulus)
+	gcd, x, _ := extendedGCD(reduced, modulus)
+	if gcd.Cmp(big.NewInt(1)) != 0 {
+		return nil, arwen.ErrNoModularInverse
+	}
+
+	return x.Mod(x, modulus), nil
+}
+
+// GCDWithTypedArgs returns the non-negative greatest common divisor g of a and
+// b, and x and y such that g = a*x + b*y
+func GCDWithTypedArgs(host arwen.VMHost, a, b *big.Int) (*big.Int, *big.Int, *big.Int) {
+	managedType := host.ManagedTypes()
+	managedType.ConsumeGasForBigIntCopy(a, b)
+
+	maxLength := byteLength(a)
+	if byteLength(b) > maxLength {
+		maxLength = byteLength(b)
+	}
+	managedType.ConsumeGasForThisBigIntNumberOfBytes(big.NewInt(int64(maxLength)))
+
+	return extendedGCD(a, b)
+}
+
+// extendedGCD computes the GCD on the absolute values, since big.Int.GCD does
+// not accept negative operands on all the supported Go versions
+func extendedGCD(a, b *big.Int) (*big.Int, *big.Int, *big.Int) {
+	x := big.NewInt(0)
+	y := big.NewInt(0)
+	absA := big.NewInt(0).Abs(a)
+	absB := big.NewInt(0).Abs(b)
+
+	if absA.Sign() == 0 && absB.Sign() == 0 {
+		return big.NewInt(0), x, y
+	}
+	if absA.Sign() == 0 {
+		y.SetInt64(int64(b.Sign()))
+		return absB, x, y
+	}
+	if absB.Sign() == 0 {
+		x.SetInt64(int64(a.Sign()))
+		return absA, x, y
+	}
+
+	gcd := big.NewInt(0).GCD(x, y, absA, absB)
+	if a.Sign() < 0 {
+		x.Neg(x)
+	}
+	if b.Sign() < 0 {
+		y.Neg(y)
+	}
+	return gcd, x, y
+}
+
+func checkModulus(modulus *big.Int) error {
+	if modulus.Sign() == 0 {
+		return arwen.ErrDivZero
+	}
+	if modulus.Sign() < 0 {
+		return arwen.ErrBadLowerBounds
+	}
+	return nil
+}
+
+func byteLength(value *big.Int) int {
+	return (value.BitLen() + 7) / 8
+}
+
+func readUnsignedManagedBuffers(host arwen.VMHost, handles ...int32) ([]*big.Int, error) {
+	managedType := host.ManagedTypes()
+
+	values := make([]*big.Int, 0, len(handles))
+	for _, handle := range handles {
+		data, err := managedType.GetBytes(handle)
+		if err != nil {
+			return nil, err
+		}
+		managedType.ConsumeGasForBytes(data)
+		values = append(values, big.NewInt(0).SetBytes(data))
+	}
+	return values, nil
+}
@@ -55,6 +55,14 @@ package elrondapi
 // extern void			v1_4_bigIntGetESDTExternalBalance(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce, int32_t result);
 // extern void			v1_4_bigIntGetExternalBalance(void *context, int32_t addressOffset, int32_t result);
 // extern void			v1_4_bigIntToString(void *context, int32_t bigIntHandle, int32_t destinaitonHandle);
+//
+// extern void			v1_4_bigIntModPow(void* context, int32_t destination, int32_t base, int32_t exponent, int32_t modulus);
+// extern void			v1_4_bigIntModInverse(void* context, int32_t destination, int32_t op, int32_t modulus);
+// extern void			v1_4_bigIntGCD(void* context, int32_t destination, int32_t op1, int32_t op2);
+// extern void			v1_4_bigIntExtendedGCD(void* context, int32_t gcdDestination, int32_t xDestination, int32_t yDestination, int32_t op1, int32_t op2);
+// extern int32_t		v1_4_managedModPow(void* context, int32_t baseHandle, int32_t exponentHandle, int32_t modulusHandle, int32_t resultHandle);
+// extern int32_t		v1_4_managedModInverse(void* context, int32_t opHandle, int32_t modulusHandle, int32_t resultHandle);
+// extern int32_t		v1_4_managedGCD(void* context, int32_t op1Handle, int32_t op2Handle, int32_t resultHandle);
 import "C"
 
 import (
@@ -110,6 +118,13 @@ const (
 	bigIntGetESDTExternalBalanceName  = "bigIntGetESDTExternalBalance"
 	bigIntGetExternalBalanceName      = "bigIntGetExternalBalance"
 	bigIntToStringName                = "bigIntToString"
+	bigIntModPowName                  = "bigIntModPow"
+	bigIntModInverseName              = "bigIntModInverse"
+	bigIntGCDName                     = "bigIntGCD"
+	bigIntExtendedGCDName             = "bigIntExtendedGCD"
+	managedModPowName                 = "managedModPow"
+	managedModInverseName             = "managedModInverse"
+	managedGCDName                    = "managedGCD"
 )
 
 // BigIntImports creates a new wasmer.Imports populated with the BigInt API methods
@@ -326,6 +341,41 @@ func BigIntImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("bigIntModPow", v1_4_bigIntModPow, C.v1_4_bigIntModPow)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntModInverse", v1_4_bigIntModInverse, C.v1_4_bigIntModInverse)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntGCD", v1_4_bigIntGCD, C.v1_4_bigIntGCD)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigIntExtendedGCD", v1_4_bigIntExtendedGCD, C.v1_4_bigIntExtendedGCD)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedModPow", v1_4_managedModPow, C.v1_4_managedModPow)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedModInverse", v1_4_managedModInverse, C.v1_4_managedModInverse)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGCD", v1_4_managedGCD, C.v1_4_managedGCD)
+	if err != nil {
+		return nil, err
+	}
+
 	return imports, nil
 }
 
@@ -1227,3 +1277,45 @@ func v1_4_bigIntToString(context unsafe.Pointer, bigIntHandle int32, destination
 	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(len(resultStr)))
 	metering.UseAndTraceGas(gasToUse)
 }
+
+//export v1_4_bigIntModPow
+func v1_4_bigIntModPow(context unsafe.Pointer, destinationHandle, baseHandle, exponentHandle, modulusHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigIntModPowWithHost(host, destinationHandle, baseHandle, exponentHandle, modulusHandle)
+}
+
+//export v1_4_bigIntModInverse
+func v1_4_bigIntModInverse(context unsafe.Pointer, destinationHandle, opHandle, modulusHandle int32) {
+	host := arwen.GetVMHost(context)
+	BigIntModInverseWithHost(host, destinationHandle, opHandle, modulusHandle)
+}
+
+//export v1_4_bigIntGCD
+func v1_4_bigIntGCD(context unsafe.Pointer, destinationHandle, op1Handle, op2Handle int32) {
+	host := arwen.GetVMHost(context)
+	BigIntGCDWithHost(host, destinationHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_bigIntExtendedGCD
+func v1_4_bigIntExtendedGCD(context unsafe.Pointer, gcdHandle, xHandle, yHandle, op1Handle, op2Handle int32) {
+	host := arwen.GetVMHost(context)
+	BigIntExtendedGCDWithHost(host, gcdHandle, xHandle, yHandle, op1Handle, op2Handle)
+}
+
+//export v1_4_managedModPow
+func v1_4_managedModPow(context unsafe.Pointer, baseHandle, exponentHandle, modulusHandle, resultHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedModPowWithHost(host, baseHandle, exponentHandle, modulusHandle, resultHandle)
+}
+
+//export v1_4_managedModInverse
+func v1_4_managedModInverse(context unsafe.Pointer, opHandle, modulusHandle, resultHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedModInverseWithHost(host, opHandle, modulusHandle, resultHandle)
+}
+
+//export v1_4_managedGCD
+func v1_4_managedGCD(context unsafe.Pointer, op1Handle, op2Handle, resultHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedGCDWithHost(host, op1Handle, op2Handle, resultHandle)
+}
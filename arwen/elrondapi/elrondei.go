@@ -9,6 +9,8 @@ package elrondapi
 // extern void		v1_4_getSCAddress(void *context, int32_t resultOffset);
 // extern void		v1_4_getOwnerAddress(void *context, int32_t resultOffset);
 // extern int32_t	v1_4_getShardOfAddress(void *context, int32_t addressOffset);
+// extern int32_t	v1_4_getNumberOfShards(void *context);
+// extern int32_t	v1_4_getSelfShardID(void *context);
 // extern int32_t	v1_4_isSmartContract(void *context, int32_t addressOffset);
 // extern void		v1_4_getExternalBalance(void *context, int32_t addressOffset, int32_t resultOffset);
 // extern int32_t	v1_4_blockHash(void *context, long long nonce, int32_t resultOffset);
@@ -45,6 +47,10 @@ package elrondapi
 // extern void		v1_4_returnData(void* context, int32_t dataOffset, int32_t length);
 // extern void		v1_4_signalError(void* context, int32_t messageOffset, int32_t messageLength);
 // extern long long v1_4_getGasLeft(void *context);
+// extern long long v1_4_getGasPrice(void *context);
+// extern long long v1_4_getGasProvided(void *context);
+// extern long long v1_4_getCallerNonce(void *context);
+// extern void		v1_4_getOriginalCaller(void *context, int32_t resultOffset);
 // extern int32_t	v1_4_getESDTBalance(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce, int32_t resultOffset);
 // extern int32_t	v1_4_getESDTNFTNameLength(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce);
 // extern int32_t	v1_4_getESDTNFTAttributeLength(void *context, int32_t addressOffset, int32_t tokenIDOffset, int32_t tokenIDLen, long long nonce);
@@ -62,6 +68,7 @@ package elrondapi
 // extern void		v1_4_upgradeContract(void *context, int32_t dstOffset, long long gas, int32_t valueOffset, int32_t codeOffset, int32_t codeMetadataOffset, int32_t length, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
 // extern void		v1_4_upgradeFromSourceContract(void *context, int32_t dstOffset, long long gas, int32_t valueOffset, int32_t addressOffset, int32_t codeMetadataOffset, int32_t numArguments, int32_t argumentsLengthOffset, int32_t dataOffset);
 // extern void		v1_4_asyncCall(void *context, int32_t dstOffset, int32_t valueOffset, int32_t dataOffset, int32_t length);
+// extern void		v1_4_createAsyncCallWithESDT(void *context, int32_t identifierOffset, int32_t identifierLength, int32_t dstOffset, int32_t valueOffset, int32_t numTokenTransfers, int32_t tokenTransfersArgsLengthOffset, int32_t tokenTransferDataOffset, int32_t dataOffset, int32_t length, int32_t successOffset, int32_t successLength, int32_t errorOffset, int32_t errorLength, long long gas);
 //
 // extern int32_t	v1_4_getNumReturnData(void *context);
 // extern int32_t	v1_4_getReturnDataSize(void *context, int32_t resultID);
@@ -73,6 +80,14 @@ package elrondapi
 // extern long long v1_4_getStorageLock(void *context, int32_t keyOffset, int32_t keyLength);
 // extern int32_t	v1_4_isStorageLocked(void *context, int32_t keyOffset, int32_t keyLength);
 // extern int32_t	v1_4_clearStorageLock(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t	v1_4_setStorageLockByNonce(void *context, int32_t keyOffset, int32_t keyLength, long long lockNonce);
+// extern long long v1_4_getStorageLockByNonce(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t	v1_4_isStorageLockedByNonce(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t	v1_4_clearStorageLockByNonce(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t	v1_4_setStorageLockByRound(void *context, int32_t keyOffset, int32_t keyLength, long long lockRound);
+// extern long long v1_4_getStorageLockByRound(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t	v1_4_isStorageLockedByRound(void *context, int32_t keyOffset, int32_t keyLength);
+// extern int32_t	v1_4_clearStorageLockByRound(void *context, int32_t keyOffset, int32_t keyLength);
 //
 // extern long long v1_4_getBlockTimestamp(void *context);
 // extern long long v1_4_getBlockNonce(void *context);
@@ -112,6 +127,8 @@ const (
 	getSCAddressName                 = "getSCAddress"
 	getOwnerAddressName              = "getOwnerAddress"
 	getShardOfAddressName            = "getShardOfAddress"
+	getNumberOfShardsName            = "getNumberOfShards"
+	getSelfShardIDName               = "getSelfShardID"
 	isSmartContractName              = "isSmartContract"
 	getExternalBalanceName           = "getExternalBalance"
 	blockHashName                    = "blockHash"
@@ -148,6 +165,10 @@ const (
 	returnDataName                   = "returnData"
 	signalErrorName                  = "signalError"
 	getGasLeftName                   = "getGasLeft"
+	getGasPriceName                  = "getGasPrice"
+	getGasProvidedName               = "getGasProvided"
+	getCallerNonceName               = "getCallerNonce"
+	getOriginalCallerName            = "getOriginalCaller"
 	getESDTBalanceName               = "getESDTBalance"
 	getESDTNFTNameLengthName         = "getESDTNFTNameLength"
 	getESDTNFTAttributeLengthName    = "getESDTNFTAttributeLength"
@@ -164,6 +185,7 @@ const (
 	upgradeContractName              = "upgradeContract"
 	upgradeFromSourceContractName    = "upgradeFromSourceContract"
 	asyncCallName                    = "asyncCall"
+	createAsyncCallWithESDTName      = "createAsyncCallWithESDT"
 	getNumReturnDataName             = "getNumReturnData"
 	getReturnDataSizeName            = "getReturnDataSize"
 	getReturnDataName                = "getReturnData"
@@ -173,6 +195,14 @@ const (
 	getStorageLockName               = "getStorageLock"
 	isStorageLockedName              = "isStorageLocked"
 	clearStorageLockName             = "clearStorageLock"
+	setStorageLockByNonceName        = "setStorageLockByNonce"
+	getStorageLockByNonceName        = "getStorageLockByNonce"
+	isStorageLockedByNonceName       = "isStorageLockedByNonce"
+	clearStorageLockByNonceName      = "clearStorageLockByNonce"
+	setStorageLockByRoundName        = "setStorageLockByRound"
+	getStorageLockByRoundName        = "getStorageLockByRound"
+	isStorageLockedByRoundName       = "isStorageLockedByRound"
+	clearStorageLockByRoundName      = "clearStorageLockByRound"
 	getBlockTimestampName            = "getBlockTimestamp"
 	getBlockNonceName                = "getBlockNonce"
 	getBlockRoundName                = "getBlockRound"
@@ -226,6 +256,16 @@ func ElrondEIImports() (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("getNumberOfShards", v1_4_getNumberOfShards, C.v1_4_getNumberOfShards)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getSelfShardID", v1_4_getSelfShardID, C.v1_4_getSelfShardID)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("isSmartContract", v1_4_isSmartContract, C.v1_4_isSmartContract)
 	if err != nil {
 		return nil, err
@@ -271,6 +311,11 @@ func ElrondEIImports() (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("createAsyncCallWithESDT", v1_4_createAsyncCallWithESDT, C.v1_4_createAsyncCallWithESDT)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("getArgumentLength", v1_4_getArgumentLength, C.v1_4_getArgumentLength)
 	if err != nil {
 		return nil, err
@@ -331,6 +376,46 @@ func ElrondEIImports() (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("setStorageLockByNonce", v1_4_setStorageLockByNonce, C.v1_4_setStorageLockByNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getStorageLockByNonce", v1_4_getStorageLockByNonce, C.v1_4_getStorageLockByNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("isStorageLockedByNonce", v1_4_isStorageLockedByNonce, C.v1_4_isStorageLockedByNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("clearStorageLockByNonce", v1_4_clearStorageLockByNonce, C.v1_4_clearStorageLockByNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("setStorageLockByRound", v1_4_setStorageLockByRound, C.v1_4_setStorageLockByRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getStorageLockByRound", v1_4_getStorageLockByRound, C.v1_4_getStorageLockByRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("isStorageLockedByRound", v1_4_isStorageLockedByRound, C.v1_4_isStorageLockedByRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("clearStorageLockByRound", v1_4_clearStorageLockByRound, C.v1_4_clearStorageLockByRound)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("getCaller", v1_4_getCaller, C.v1_4_getCaller)
 	if err != nil {
 		return nil, err
@@ -496,6 +581,26 @@ func ElrondEIImports() (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("getGasPrice", v1_4_getGasPrice, C.v1_4_getGasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getGasProvided", v1_4_getGasProvided, C.v1_4_getGasProvided)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getCallerNonce", v1_4_getCallerNonce, C.v1_4_getCallerNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("getOriginalCaller", v1_4_getOriginalCaller, C.v1_4_getOriginalCaller)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("executeOnDestContext", v1_4_executeOnDestContext, C.v1_4_executeOnDestContext)
 	if err != nil {
 		return nil, err
@@ -604,6 +709,30 @@ func v1_4_getGasLeft(context unsafe.Pointer) int64 {
 	return int64(metering.GasLeft())
 }
 
+//export v1_4_getGasPrice
+func v1_4_getGasPrice(context unsafe.Pointer) int64 {
+	host := arwen.GetVMHost(context)
+	return GetGasPriceWithHost(host)
+}
+
+//export v1_4_getGasProvided
+func v1_4_getGasProvided(context unsafe.Pointer) int64 {
+	host := arwen.GetVMHost(context)
+	return GetGasProvidedWithHost(host)
+}
+
+//export v1_4_getCallerNonce
+func v1_4_getCallerNonce(context unsafe.Pointer) int64 {
+	host := arwen.GetVMHost(context)
+	return GetCallerNonceWithHost(host)
+}
+
+//export v1_4_getOriginalCaller
+func v1_4_getOriginalCaller(context unsafe.Pointer, resultOffset int32) {
+	host := arwen.GetVMHost(context)
+	GetOriginalCallerWithHost(host, resultOffset)
+}
+
 //export v1_4_getSCAddress
 func v1_4_getSCAddress(context unsafe.Pointer, resultOffset int32) {
 	runtime := arwen.GetRuntimeContext(context)
@@ -656,6 +785,18 @@ func v1_4_getShardOfAddress(context unsafe.Pointer, addressOffset int32) int32 {
 	return int32(blockchain.GetShardOfAddress(address))
 }
 
+//export v1_4_getNumberOfShards
+func v1_4_getNumberOfShards(context unsafe.Pointer) int32 {
+	host := arwen.GetVMHost(context)
+	return GetNumberOfShardsWithHost(host)
+}
+
+//export v1_4_getSelfShardID
+func v1_4_getSelfShardID(context unsafe.Pointer) int32 {
+	host := arwen.GetVMHost(context)
+	return GetSelfShardIDWithHost(host)
+}
+
 //export v1_4_isSmartContract
 func v1_4_isSmartContract(context unsafe.Pointer, addressOffset int32) int32 {
 	blockchain := arwen.GetBlockchainContext(context)
@@ -1384,6 +1525,18 @@ func v1_4_multiTransferESDTNFTExecute(
 	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(actualLen))
 	metering.UseAndTraceGas(gasToUse)
 
+	return TransferESDTNFTExecuteWithTypedArgs(
+		host,
+		callArgs.dest,
+		esdtTransfersFromArgs(transferArgs, numTokenTransfers),
+		gasLimit,
+		callArgs.function,
+		callArgs.args,
+	)
+}
+
+// esdtTransfersFromArgs groups the token identifier, nonce and value arguments of each transfer
+func esdtTransfersFromArgs(transferArgs [][]byte, numTokenTransfers int32) []*vmcommon.ESDTTransfer {
 	transfers := make([]*vmcommon.ESDTTransfer, numTokenTransfers)
 	for i := int32(0); i < numTokenTransfers; i++ {
 		tokenStartIndex := i * parsers.ArgsPerTransfer
@@ -1399,14 +1552,7 @@ func v1_4_multiTransferESDTNFTExecute(
 		transfers[i] = transfer
 	}
 
-	return TransferESDTNFTExecuteWithTypedArgs(
-		host,
-		callArgs.dest,
-		transfers,
-		gasLimit,
-		callArgs.function,
-		callArgs.args,
-	)
+	return transfers
 }
 
 // TransferESDTNFTExecuteWithHost contains only memory reading of arguments
@@ -1587,6 +1733,137 @@ func v1_4_createAsyncCall(context unsafe.Pointer,
 	}
 }
 
+//export v1_4_createAsyncCallWithESDT
+func v1_4_createAsyncCallWithESDT(context unsafe.Pointer,
+	asyncContextIdentifier int32,
+	identifierLength int32,
+	destOffset int32,
+	valueOffset int32,
+	numTokenTransfers int32,
+	tokenTransfersArgsLengthOffset int32,
+	tokenTransferDataOffset int32,
+	dataOffset int32,
+	length int32,
+	successOffset int32,
+	successLength int32,
+	errorOffset int32,
+	errorLength int32,
+	gas int64,
+) {
+	host := arwen.GetVMHost(context)
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(createAsyncCallWithESDTName)
+
+	acIdentifier, err := runtime.MemLoad(asyncContextIdentifier, identifierLength)
+	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	calledSCAddress, err := runtime.MemLoad(destOffset, arwen.AddressLen)
+	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	value, err := runtime.MemLoad(valueOffset, arwen.BalanceLen)
+	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	transferArgs, actualLen, err := getArgumentsFromMemory(
+		host,
+		numTokenTransfers*parsers.ArgsPerTransfer,
+		tokenTransfersArgsLengthOffset,
+		tokenTransferDataOffset,
+	)
+	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	gasToUse := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(actualLen))
+	metering.UseAndTraceGas(gasToUse)
+
+	data, err := runtime.MemLoad(dataOffset, length)
+	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	successFunc, err := runtime.MemLoad(successOffset, successLength)
+	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	errorFunc, err := runtime.MemLoad(errorOffset, errorLength)
+	if arwen.WithFault(err, context, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	CreateAsyncCallWithTypedArgs(
+		host,
+		acIdentifier,
+		calledSCAddress,
+		value,
+		esdtTransfersFromArgs(transferArgs, numTokenTransfers),
+		data,
+		successFunc,
+		errorFunc,
+		gas,
+	)
+}
+
+// CreateAsyncCallWithTypedArgs adds a call to the async context with the given
+// identifier, paying either EGLD or a list of ESDT tokens, but not both. Unlike
+// createAsyncCall, it charges for the call and locks gas for the callbacks.
+func CreateAsyncCallWithTypedArgs(
+	host arwen.VMHost,
+	asyncContextIdentifier []byte,
+	destination []byte,
+	value []byte,
+	esdtTransfers []*vmcommon.ESDTTransfer,
+	data []byte,
+	successCallback []byte,
+	errorCallback []byte,
+	gas int64,
+) {
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	if len(esdtTransfers) > 0 && big.NewInt(0).SetBytes(value).Sign() > 0 {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrTransferValueOnESDTCall, runtime.ElrondAPIErrorShouldFailExecution())
+		return
+	}
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.CreateAsyncCallESDT
+	metering.UseAndTraceGas(gasToUse)
+
+	gasToUse = math.MulUint64(metering.GasSchedule().ElrondAPICost.TransferValue, uint64(len(esdtTransfers)))
+	metering.UseAndTraceGas(gasToUse)
+
+	// the callbacks must be able to run even if the destination consumes all its gas,
+	// which is also when the error callback sees the ESDT payment refunded
+	gasToLock := uint64(0)
+	if len(successCallback) > 0 || len(errorCallback) > 0 {
+		gasToLock = metering.ComputeGasLockedForAsync()
+		err := metering.UseGasBounded(gasToLock)
+		if err != nil {
+			runtime.SetRuntimeBreakpointValue(arwen.BreakpointOutOfGas)
+			return
+		}
+	}
+
+	err := runtime.AddAsyncContextCall(asyncContextIdentifier, &arwen.AsyncGeneratedCall{
+		Destination:     destination,
+		Data:            data,
+		GasLocked:       gasToLock,
+		ValueBytes:      value,
+		ESDTTransfers:   esdtTransfers,
+		SuccessCallback: string(successCallback),
+		ErrorCallback:   string(errorCallback),
+		ProvidedGas:     uint64(gas),
+	})
+	_ = arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution())
+}
+
 //export v1_4_setAsyncContextCallback
 func v1_4_setAsyncContextCallback(context unsafe.Pointer,
 	asyncContextIdentifier int32,
@@ -2151,6 +2428,54 @@ func v1_4_clearStorageLock(context unsafe.Pointer, keyOffset int32, keyLength in
 	return v1_4_setStorageLock(context, keyOffset, keyLength, 0)
 }
 
+//export v1_4_setStorageLockByNonce
+func v1_4_setStorageLockByNonce(context unsafe.Pointer, keyOffset int32, keyLength int32, lockNonce int64) int32 {
+	host := arwen.GetVMHost(context)
+	return SetStorageLockOfTypeWithHost(host, setStorageLockByNonceName, arwen.NonceLock, keyOffset, keyLength, lockNonce)
+}
+
+//export v1_4_getStorageLockByNonce
+func v1_4_getStorageLockByNonce(context unsafe.Pointer, keyOffset int32, keyLength int32) int64 {
+	host := arwen.GetVMHost(context)
+	return GetStorageLockOfTypeWithHost(host, getStorageLockByNonceName, arwen.NonceLock, keyOffset, keyLength)
+}
+
+//export v1_4_isStorageLockedByNonce
+func v1_4_isStorageLockedByNonce(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
+	host := arwen.GetVMHost(context)
+	return IsStorageLockedOfTypeWithHost(host, isStorageLockedByNonceName, arwen.NonceLock, keyOffset, keyLength)
+}
+
+//export v1_4_clearStorageLockByNonce
+func v1_4_clearStorageLockByNonce(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
+	host := arwen.GetVMHost(context)
+	return SetStorageLockOfTypeWithHost(host, clearStorageLockByNonceName, arwen.NonceLock, keyOffset, keyLength, 0)
+}
+
+//export v1_4_setStorageLockByRound
+func v1_4_setStorageLockByRound(context unsafe.Pointer, keyOffset int32, keyLength int32, lockRound int64) int32 {
+	host := arwen.GetVMHost(context)
+	return SetStorageLockOfTypeWithHost(host, setStorageLockByRoundName, arwen.RoundLock, keyOffset, keyLength, lockRound)
+}
+
+//export v1_4_getStorageLockByRound
+func v1_4_getStorageLockByRound(context unsafe.Pointer, keyOffset int32, keyLength int32) int64 {
+	host := arwen.GetVMHost(context)
+	return GetStorageLockOfTypeWithHost(host, getStorageLockByRoundName, arwen.RoundLock, keyOffset, keyLength)
+}
+
+//export v1_4_isStorageLockedByRound
+func v1_4_isStorageLockedByRound(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
+	host := arwen.GetVMHost(context)
+	return IsStorageLockedOfTypeWithHost(host, isStorageLockedByRoundName, arwen.RoundLock, keyOffset, keyLength)
+}
+
+//export v1_4_clearStorageLockByRound
+func v1_4_clearStorageLockByRound(context unsafe.Pointer, keyOffset int32, keyLength int32) int32 {
+	host := arwen.GetVMHost(context)
+	return SetStorageLockOfTypeWithHost(host, clearStorageLockByRoundName, arwen.RoundLock, keyOffset, keyLength, 0)
+}
+
 //export v1_4_getCaller
 func v1_4_getCaller(context unsafe.Pointer, resultOffset int32) {
 	runtime := arwen.GetRuntimeContext(context)
@@ -3194,6 +3519,16 @@ func DeployFromSourceContractWithTypedArgs(
 	return createContract(sender, data, value, metering, gasLimit, code, codeMetadata, host, runtime)
 }
 
+// nestedCallGasPrice returns the gas price of the current transaction, which
+// nested calls receive only after the corresponding flag is enabled
+func nestedCallGasPrice(host arwen.VMHost) uint64 {
+	if !host.NestedCallsGasPriceEnabled() {
+		return 0
+	}
+
+	return host.Runtime().GetVMInput().GasPrice
+}
+
 func createContract(
 	sender []byte,
 	data [][]byte,
@@ -3203,14 +3538,14 @@ func createContract(
 	code []byte,
 	codeMetadata []byte,
 	host arwen.VMHost,
-	_ arwen.RuntimeContext,
+	runtime arwen.RuntimeContext,
 ) ([]byte, error) {
 	contractCreate := &vmcommon.ContractCreateInput{
 		VMInput: vmcommon.VMInput{
 			CallerAddr:  sender,
 			Arguments:   data,
 			CallValue:   value,
-			GasPrice:    0,
+			GasPrice:    nestedCallGasPrice(host),
 			GasProvided: metering.BoundGasLimit(gasLimit),
 		},
 		ContractCode:         code,
@@ -3357,7 +3692,7 @@ func prepareIndirectContractCallInput(
 			CallerAddr:  sender,
 			Arguments:   data,
 			CallValue:   value,
-			GasPrice:    0,
+			GasPrice:    nestedCallGasPrice(host),
 			GasProvided: metering.BoundGasLimit(gasLimit),
 		},
 		RecipientAddr: destination,
@@ -0,0 +1,616 @@
+package elrondapi
+
+// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
+//
+// #include <stdlib.h>
+// typedef unsigned char uint8_t;
+// typedef int int32_t;
+//
+// extern int32_t	v1_4_mBufferCompare(void* context, int32_t mBufferHandle1, int32_t mBufferHandle2);
+// extern int32_t	v1_4_mBufferFind(void* context, int32_t mBufferHandle, int32_t patternHandle, int32_t startPosition);
+// extern int32_t	v1_4_mBufferFindByte(void* context, int32_t mBufferHandle, int32_t value, int32_t startPosition);
+// extern int32_t	v1_4_mBufferSplit(void* context, int32_t mBufferHandle, int32_t separatorHandle, int32_t destinationHandle);
+// extern int32_t	v1_4_mBufferParseDecimal(void* context, int32_t mBufferHandle, int32_t decimals, int32_t bigIntHandle);
+// extern int32_t	v1_4_mBufferFormatDecimal(void* context, int32_t bigIntHandle, int32_t decimals, int32_t destinationHandle);
+// extern int32_t	v1_4_mBufferToBase64(void* context, int32_t sourceHandle, int32_t destinationHandle);
+// extern int32_t	v1_4_mBufferFromBase64(void* context, int32_t sourceHandle, int32_t destinationHandle);
+// extern int32_t	v1_4_mBufferFromHex(void* context, int32_t sourceHandle, int32_t destinationHandle);
+// extern int32_t	v1_4_mBufferTopEncodeU64(void* context, int32_t destinationHandle, long long value);
+// extern long long	v1_4_mBufferTopDecodeU64(void* context, int32_t sourceHandle);
+// extern int32_t	v1_4_mBufferNestedEncodeU64(void* context, int32_t accumulatorHandle, long long value);
+// extern int32_t	v1_4_mBufferNestedEncodeBigUint(void* context, int32_t accumulatorHandle, int32_t bigIntHandle);
+// extern int32_t	v1_4_mBufferNestedEncodeBigInt(void* context, int32_t accumulatorHandle, int32_t bigIntHandle);
+// extern int32_t	v1_4_mBufferNestedDecodeBigUint(void* context, int32_t sourceHandle, int32_t position, int32_t bigIntHandle);
+// extern int32_t	v1_4_mBufferNestedDecodeBigInt(void* context, int32_t sourceHandle, int32_t position, int32_t bigIntHandle);
+import "C"
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+)
+
+const (
+	mBufferCompareName             = "mBufferCompare"
+	mBufferFindName                = "mBufferFind"
+	mBufferFindByteName            = "mBufferFindByte"
+	mBufferSplitName               = "mBufferSplit"
+	mBufferParseDecimalName        = "mBufferParseDecimal"
+	mBufferFormatDecimalName       = "mBufferFormatDecimal"
+	mBufferToBase64Name            = "mBufferToBase64"
+	mBufferFromBase64Name          = "mBufferFromBase64"
+	mBufferFromHexName             = "mBufferFromHex"
+	mBufferTopEncodeU64Name        = "mBufferTopEncodeU64"
+	mBufferTopDecodeU64Name        = "mBufferTopDecodeU64"
+	mBufferNestedEncodeU64Name     = "mBufferNestedEncodeU64"
+	mBufferNestedEncodeBigUintName = "mBufferNestedEncodeBigUint"
+	mBufferNestedEncodeBigIntName  = "mBufferNestedEncodeBigInt"
+	mBufferNestedDecodeBigUintName = "mBufferNestedDecodeBigUint"
+	mBufferNestedDecodeBigIntName  = "mBufferNestedDecodeBigInt"
+)
+
+// ManagedBufferStringImports creates a new wasmer.Imports populated with the
+// string processing and encoding methods of the ManagedBuffer API
+func ManagedBufferStringImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
+	imports = imports.Namespace("env")
+
+	imports, err := imports.Append("mBufferCompare", v1_4_mBufferCompare, C.v1_4_mBufferCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFind", v1_4_mBufferFind, C.v1_4_mBufferFind)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFindByte", v1_4_mBufferFindByte, C.v1_4_mBufferFindByte)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferSplit", v1_4_mBufferSplit, C.v1_4_mBufferSplit)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferParseDecimal", v1_4_mBufferParseDecimal, C.v1_4_mBufferParseDecimal)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFormatDecimal", v1_4_mBufferFormatDecimal, C.v1_4_mBufferFormatDecimal)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferToBase64", v1_4_mBufferToBase64, C.v1_4_mBufferToBase64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFromBase64", v1_4_mBufferFromBase64, C.v1_4_mBufferFromBase64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFromHex", v1_4_mBufferFromHex, C.v1_4_mBufferFromHex)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferTopEncodeU64", v1_4_mBufferTopEncodeU64, C.v1_4_mBufferTopEncodeU64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferTopDecodeU64", v1_4_mBufferTopDecodeU64, C.v1_4_mBufferTopDecodeU64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferNestedEncodeU64", v1_4_mBufferNestedEncodeU64, C.v1_4_mBufferNestedEncodeU64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferNestedEncodeBigUint", v1_4_mBufferNestedEncodeBigUint, C.v1_4_mBufferNestedEncodeBigUint)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferNestedEncodeBigInt", v1_4_mBufferNestedEncodeBigInt, C.v1_4_mBufferNestedEncodeBigInt)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferNestedDecodeBigUint", v1_4_mBufferNestedDecodeBigUint, C.v1_4_mBufferNestedDecodeBigUint)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferNestedDecodeBigInt", v1_4_mBufferNestedDecodeBigInt, C.v1_4_mBufferNestedDecodeBigInt)
+	if err != nil {
+		return nil, err
+	}
+
+	return imports, nil
+}
+
+//export v1_4_mBufferCompare
+func v1_4_mBufferCompare(context unsafe.Pointer, mBufferHandle1 int32, mBufferHandle2 int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferCompareWithHost(host, mBufferHandle1, mBufferHandle2)
+}
+
+// ManagedBufferCompareWithHost compares two managed buffers lexicographically,
+// returning -1, 0 or 1, or -2 if either handle is invalid
+func ManagedBufferCompareWithHost(host arwen.VMHost, mBufferHandle1 int32, mBufferHandle2 int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferCompareName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferCompare
+	metering.UseAndTraceGas(gasToUse)
+
+	bytes1, err := managedType.GetBytes(mBufferHandle1)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -2
+	}
+	bytes2, err := managedType.GetBytes(mBufferHandle2)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -2
+	}
+	managedType.ConsumeGasForBytes(bytes1)
+	managedType.ConsumeGasForBytes(bytes2)
+
+	return int32(bytes.Compare(bytes1, bytes2))
+}
+
+//export v1_4_mBufferFind
+func v1_4_mBufferFind(context unsafe.Pointer, mBufferHandle int32, patternHandle int32, startPosition int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferFindWithHost(host, mBufferHandle, patternHandle, startPosition)
+}
+
+// ManagedBufferFindWithHost returns the position of the first occurrence of
+// the pattern in the managed buffer at or after the start position, -1 if
+// there is none, or -2 on error
+func ManagedBufferFindWithHost(host arwen.VMHost, mBufferHandle int32, patternHandle int32, startPosition int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferFindName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferFind
+	metering.UseAndTraceGas(gasToUse)
+
+	data, err := managedType.GetBytes(mBufferHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -2
+	}
+	pattern, err := managedType.GetBytes(patternHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -2
+	}
+	if startPosition < 0 || int(startPosition) > len(data) {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrBadBounds, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return -2
+	}
+	managedType.ConsumeGasForBytes(data[startPosition:])
+	managedType.ConsumeGasForBytes(pattern)
+
+	position := bytes.Index(data[startPosition:], pattern)
+	if position < 0 {
+		return -1
+	}
+	return startPosition + int32(position)
+}
+
+//export v1_4_mBufferFindByte
+func v1_4_mBufferFindByte(context unsafe.Pointer, mBufferHandle int32, value int32, startPosition int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferFindByteWithHost(host, mBufferHandle, value, startPosition)
+}
+
+// ManagedBufferFindByteWithHost returns the position of the first occurrence
+// of the byte in the managed buffer at or after the start position, -1 if
+// there is none, or -2 on error
+func ManagedBufferFindByteWithHost(host arwen.VMHost, mBufferHandle int32, value int32, startPosition int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferFindByteName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferFind
+	metering.UseAndTraceGas(gasToUse)
+
+	if value < 0 || value > 255 {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrArgOutOfRange, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return -2
+	}
+	data, err := managedType.GetBytes(mBufferHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -2
+	}
+	if startPosition < 0 || int(startPosition) > len(data) {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrBadBounds, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return -2
+	}
+	managedType.ConsumeGasForBytes(data[startPosition:])
+
+	position := bytes.IndexByte(data[startPosition:], byte(value))
+	if position < 0 {
+		return -1
+	}
+	return startPosition + int32(position)
+}
+
+//export v1_4_mBufferSplit
+func v1_4_mBufferSplit(context unsafe.Pointer, mBufferHandle int32, separatorHandle int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferSplitWithHost(host, mBufferHandle, separatorHandle, destinationHandle)
+}
+
+// ManagedBufferSplitWithHost splits the managed buffer around every occurrence
+// of the separator, storing the handles of the new managed buffers holding the
+// pieces in the destination managed vec. It returns the number of pieces, or
+// -1 on error.
+func ManagedBufferSplitWithHost(host arwen.VMHost, mBufferHandle int32, separatorHandle int32, destinationHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferSplitName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferSplit
+	metering.UseAndTraceGas(gasToUse)
+
+	data, err := managedType.GetBytes(mBufferHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	separator, err := managedType.GetBytes(separatorHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	_, err = managedType.GetBytes(destinationHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(data)
+
+	pieces, err := splitManagedBytes(data, separator)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().ManagedBufferAPICost.MBufferNew, uint64(len(pieces)))
+	metering.UseAndTraceGas(gasToUse)
+
+	mVecBytes := make([]byte, 0, len(pieces)*managedVecHandleLen)
+	for _, piece := range pieces {
+		pieceHandle := managedType.NewManagedBufferFromBytes(piece)
+		mVecBytes = append(mVecBytes, encodeManagedVecHandle(pieceHandle)...)
+	}
+	managedType.ConsumeGasForBytes(mVecBytes)
+	managedType.SetBytes(destinationHandle, mVecBytes)
+
+	return int32(len(pieces))
+}
+
+//export v1_4_mBufferParseDecimal
+func v1_4_mBufferParseDecimal(context unsafe.Pointer, mBufferHandle int32, decimals int32, bigIntHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferParseDecimalWithHost(host, mBufferHandle, decimals, bigIntHandle)
+}
+
+// ManagedBufferParseDecimalWithHost parses the decimal string held by the
+// managed buffer, such as "-12.5", into the big int, scaled by 10^decimals
+func ManagedBufferParseDecimalWithHost(host arwen.VMHost, mBufferHandle int32, decimals int32, bigIntHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferParseDecimalName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferParseDecimal
+	metering.UseAndTraceGas(gasToUse)
+
+	data, err := managedType.GetBytes(mBufferHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(data)
+
+	value, err := parseDecimal(data, decimals)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBigIntCopy(value)
+
+	managedType.GetBigIntOrCreate(bigIntHandle).Set(value)
+	return 0
+}
+
+//export v1_4_mBufferFormatDecimal
+func v1_4_mBufferFormatDecimal(context unsafe.Pointer, bigIntHandle int32, decimals int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferFormatDecimalWithHost(host, bigIntHandle, decimals, destinationHandle)
+}
+
+// ManagedBufferFormatDecimalWithHost writes the big int divided by
+// 10^decimals as a decimal string in the managed buffer, with all its
+// fractional digits
+func ManagedBufferFormatDecimalWithHost(host arwen.VMHost, bigIntHandle int32, decimals int32, destinationHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferFormatDecimalName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferFormatDecimal
+	metering.UseAndTraceGas(gasToUse)
+
+	value, err := managedType.GetBigInt(bigIntHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBigIntCopy(value)
+
+	formatted, err := formatDecimal(value, decimals)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(formatted)
+
+	managedType.SetBytes(destinationHandle, formatted)
+	return 0
+}
+
+//export v1_4_mBufferToBase64
+func v1_4_mBufferToBase64(context unsafe.Pointer, sourceHandle int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferToBase64WithHost(host, sourceHandle, destinationHandle)
+}
+
+// ManagedBufferToBase64WithHost writes the standard, padded base64 encoding
+// of the source managed buffer in the destination managed buffer
+func ManagedBufferToBase64WithHost(host arwen.VMHost, sourceHandle int32, destinationHandle int32) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferToBase64Name)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferToBase64
+	metering.UseAndTraceGas(gasToUse)
+
+	return transformManagedBuffer(host, sourceHandle, destinationHandle, func(data []byte) ([]byte, error) {
+		return []byte(base64.StdEncoding.EncodeToString(data)), nil
+	})
+}
+
+//export v1_4_mBufferFromBase64
+func v1_4_mBufferFromBase64(context unsafe.Pointer, sourceHandle int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferFromBase64WithHost(host, sourceHandle, destinationHandle)
+}
+
+// ManagedBufferFromBase64WithHost decodes the standard, padded base64 string
+// of the source managed buffer into the destination managed buffer
+func ManagedBufferFromBase64WithHost(host arwen.VMHost, sourceHandle int32, destinationHandle int32) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferFromBase64Name)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferFromBase64
+	metering.UseAndTraceGas(gasToUse)
+
+	return transformManagedBuffer(host, sourceHandle, destinationHandle, func(data []byte) ([]byte, error) {
+		decoded, err := base64.StdEncoding.DecodeString(string(data))
+		if err != nil {
+			return nil, arwen.ErrInvalidEncodedData
+		}
+		return decoded, nil
+	})
+}
+
+//export v1_4_mBufferFromHex
+func v1_4_mBufferFromHex(context unsafe.Pointer, sourceHandle int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferFromHexWithHost(host, sourceHandle, destinationHandle)
+}
+
+// ManagedBufferFromHexWithHost decodes the hex string of the source managed
+// buffer into the destination managed buffer
+func ManagedBufferFromHexWithHost(host arwen.VMHost, sourceHandle int32, destinationHandle int32) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferFromHexName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferFromHex
+	metering.UseAndTraceGas(gasToUse)
+
+	return transformManagedBuffer(host, sourceHandle, destinationHandle, func(data []byte) ([]byte, error) {
+		decoded, err := hex.DecodeString(string(data))
+		if err != nil {
+			return nil, arwen.ErrInvalidEncodedData
+		}
+		return decoded, nil
+	})
+}
+
+//export v1_4_mBufferTopEncodeU64
+func v1_4_mBufferTopEncodeU64(context unsafe.Pointer, destinationHandle int32, value int64) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferTopEncodeU64WithHost(host, destinationHandle, value)
+}
+
+// ManagedBufferTopEncodeU64WithHost sets the managed buffer to the minimal big
+// endian representation of the value, interpreted as unsigned
+func ManagedBufferTopEncodeU64WithHost(host arwen.VMHost, destinationHandle int32, value int64) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferTopEncodeU64
+	metering.UseGasAndAddTracedGas(mBufferTopEncodeU64Name, gasToUse)
+
+	_, err := managedType.GetBytes(destinationHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(destinationHandle, topEncodeU64(uint64(value)))
+	return 0
+}
+
+//export v1_4_mBufferTopDecodeU64
+func v1_4_mBufferTopDecodeU64(context unsafe.Pointer, sourceHandle int32) int64 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferTopDecodeU64WithHost(host, sourceHandle)
+}
+
+// ManagedBufferTopDecodeU64WithHost returns the u64 top-encoded in the managed buffer
+func ManagedBufferTopDecodeU64WithHost(host arwen.VMHost, sourceHandle int32) int64 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferTopDecodeU64
+	metering.UseGasAndAddTracedGas(mBufferTopDecodeU64Name, gasToUse)
+
+	data, err := managedType.GetBytes(sourceHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 0
+	}
+
+	value, err := topDecodeU64(data)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 0
+	}
+	return int64(value)
+}
+
+//export v1_4_mBufferNestedEncodeU64
+func v1_4_mBufferNestedEncodeU64(context unsafe.Pointer, accumulatorHandle int32, value int64) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferNestedEncodeU64WithHost(host, accumulatorHandle, value)
+}
+
+// ManagedBufferNestedEncodeU64WithHost appends the value, interpreted as
+// unsigned, to the managed buffer as 8 big endian bytes
+func ManagedBufferNestedEncodeU64WithHost(host arwen.VMHost, accumulatorHandle int32, value int64) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mBufferNestedEncodeU64Name)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferNestedEncode
+	metering.UseAndTraceGas(gasToUse)
+
+	return appendToManagedBuffer(host, accumulatorHandle, nestedEncodeU64(uint64(value)))
+}
+
+//export v1_4_mBufferNestedEncodeBigUint
+func v1_4_mBufferNestedEncodeBigUint(context unsafe.Pointer, accumulatorHandle int32, bigIntHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferNestedEncodeBigIntWithHost(host, mBufferNestedEncodeBigUintName, accumulatorHandle, bigIntHandle, false)
+}
+
+//export v1_4_mBufferNestedEncodeBigInt
+func v1_4_mBufferNestedEncodeBigInt(context unsafe.Pointer, accumulatorHandle int32, bigIntHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferNestedEncodeBigIntWithHost(host, mBufferNestedEncodeBigIntName, accumulatorHandle, bigIntHandle, true)
+}
+
+// ManagedBufferNestedEncodeBigIntWithHost appends the big int to the managed
+// buffer, as its unsigned or two's complement bytes prefixed by their length
+func ManagedBufferNestedEncodeBigIntWithHost(host arwen.VMHost, tracedFunctionName string, accumulatorHandle int32, bigIntHandle int32, signed bool) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(tracedFunctionName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferNestedEncode
+	metering.UseAndTraceGas(gasToUse)
+
+	value, err := managedType.GetBigInt(bigIntHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	if !signed && value.Sign() < 0 {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrBadLowerBounds, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	return appendToManagedBuffer(host, accumulatorHandle, nestedEncodeBigInt(value, signed))
+}
+
+//export v1_4_mBufferNestedDecodeBigUint
+func v1_4_mBufferNestedDecodeBigUint(context unsafe.Pointer, sourceHandle int32, position int32, bigIntHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferNestedDecodeBigIntWithHost(host, mBufferNestedDecodeBigUintName, sourceHandle, position, bigIntHandle, false)
+}
+
+//export v1_4_mBufferNestedDecodeBigInt
+func v1_4_mBufferNestedDecodeBigInt(context unsafe.Pointer, sourceHandle int32, position int32, bigIntHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferNestedDecodeBigIntWithHost(host, mBufferNestedDecodeBigIntName, sourceHandle, position, bigIntHandle, true)
+}
+
+// ManagedBufferNestedDecodeBigIntWithHost decodes into the big int the nested
+// encoding found at the given position of the managed buffer, and returns the
+// position right after it, or -1 on error
+func ManagedBufferNestedDecodeBigIntWithHost(host arwen.VMHost, tracedFunctionName string, sourceHandle int32, position int32, bigIntHandle int32, signed bool) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(tracedFunctionName)
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferNestedDecode
+	metering.UseAndTraceGas(gasToUse)
+
+	data, err := managedType.GetBytes(sourceHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	value, nextPosition, err := nestedDecodeBigInt(data, position, signed)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(data[position:nextPosition])
+
+	managedType.GetBigIntOrCreate(bigIntHandle).Set(value)
+	return nextPosition
+}
+
+func transformManagedBuffer(host arwen.VMHost, sourceHandle int32, destinationHandle int32, transform func([]byte) ([]byte, error)) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+
+	data, err := managedType.GetBytes(sourceHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(data)
+
+	result, err := transform(data)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(result)
+
+	managedType.SetBytes(destinationHandle, result)
+	return 0
+}
+
+func appendToManagedBuffer(host arwen.VMHost, accumulatorHandle int32, data []byte) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+
+	managedType.ConsumeGasForBytes(data)
+	isSuccess := managedType.AppendBytes(accumulatorHandle, data)
+	if !isSuccess {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrNoManagedBufferUnderThisHandle, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return 1
+	}
+	return 0
+}
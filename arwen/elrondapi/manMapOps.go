@@ -0,0 +1,277 @@
+package elrondapi
+
+// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
+//
+// #include <stdlib.h>
+// typedef unsigned char uint8_t;
+// typedef int int32_t;
+//
+// extern int32_t	v1_4_mMapNew(void* context);
+// extern int32_t	v1_4_mMapPut(void* context, int32_t mMapHandle, int32_t keyHandle, int32_t valueHandle);
+// extern int32_t	v1_4_mMapGet(void* context, int32_t mMapHandle, int32_t keyHandle, int32_t outValueHandle);
+// extern int32_t	v1_4_mMapContains(void* context, int32_t mMapHandle, int32_t keyHandle);
+// extern int32_t	v1_4_mMapRemove(void* context, int32_t mMapHandle, int32_t keyHandle, int32_t outValueHandle);
+// extern int32_t	v1_4_mMapLen(void* context, int32_t mMapHandle);
+// extern int32_t	v1_4_mMapKeys(void* context, int32_t mMapHandle, int32_t destinationHandle);
+import "C"
+import (
+	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+)
+
+const (
+	mMapNewName      = "mMapNew"
+	mMapPutName      = "mMapPut"
+	mMapGetName      = "mMapGet"
+	mMapContainsName = "mMapContains"
+	mMapRemoveName   = "mMapRemove"
+	mMapLenName      = "mMapLen"
+	mMapKeysName     = "mMapKeys"
+)
+
+// ManagedMapImports creates a new wasmer.Imports populated with the ManagedMap API methods
+func ManagedMapImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
+	imports = imports.Namespace("env")
+
+	imports, err := imports.Append("mMapNew", v1_4_mMapNew, C.v1_4_mMapNew)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mMapPut", v1_4_mMapPut, C.v1_4_mMapPut)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mMapGet", v1_4_mMapGet, C.v1_4_mMapGet)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mMapContains", v1_4_mMapContains, C.v1_4_mMapContains)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mMapRemove", v1_4_mMapRemove, C.v1_4_mMapRemove)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mMapLen", v1_4_mMapLen, C.v1_4_mMapLen)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mMapKeys", v1_4_mMapKeys, C.v1_4_mMapKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return imports, nil
+}
+
+//export v1_4_mMapNew
+func v1_4_mMapNew(context unsafe.Pointer) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedMapNewWithHost(host)
+}
+
+// ManagedMapNewWithHost creates a new empty managed map and returns its handle
+func ManagedMapNewWithHost(host arwen.VMHost) int32 {
+	managedType := host.ManagedTypes()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ManagedMapAPICost.MMapNew
+	metering.UseGasAndAddTracedGas(mMapNewName, gasToUse)
+
+	return managedType.NewManagedMap()
+}
+
+//export v1_4_mMapPut
+func v1_4_mMapPut(context unsafe.Pointer, mMapHandle int32, keyHandle int32, valueHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedMapPutWithHost(host, mMapHandle, keyHandle, valueHandle)
+}
+
+// ManagedMapPutWithHost sets the contents of the value buffer under the contents of
+// the key buffer in the managed map
+func ManagedMapPutWithHost(host arwen.VMHost, mMapHandle int32, keyHandle int32, valueHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mMapPutName)
+
+	gasToUse := metering.GasSchedule().ManagedMapAPICost.MMapPut
+	metering.UseAndTraceGas(gasToUse)
+
+	key, err := managedType.GetBytes(keyHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(key)
+
+	value, err := managedType.GetBytes(valueHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(value)
+
+	err = managedType.ManagedMapPut(mMapHandle, key, value)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_mMapGet
+func v1_4_mMapGet(context unsafe.Pointer, mMapHandle int32, keyHandle int32, outValueHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedMapGetWithHost(host, mMapHandle, keyHandle, outValueHandle)
+}
+
+// ManagedMapGetWithHost copies the value under the given key of the managed map into
+// the output buffer; a missing key yields an empty buffer
+func ManagedMapGetWithHost(host arwen.VMHost, mMapHandle int32, keyHandle int32, outValueHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mMapGetName)
+
+	gasToUse := metering.GasSchedule().ManagedMapAPICost.MMapGet
+	metering.UseAndTraceGas(gasToUse)
+
+	key, err := managedType.GetBytes(keyHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(key)
+
+	value, err := managedType.ManagedMapGet(mMapHandle, key)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(value)
+
+	managedType.SetBytes(outValueHandle, value)
+	return 0
+}
+
+//export v1_4_mMapContains
+func v1_4_mMapContains(context unsafe.Pointer, mMapHandle int32, keyHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedMapContainsWithHost(host, mMapHandle, keyHandle)
+}
+
+// ManagedMapContainsWithHost returns 1 if the managed map has a value under the
+// given key, 0 otherwise
+func ManagedMapContainsWithHost(host arwen.VMHost, mMapHandle int32, keyHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mMapContainsName)
+
+	gasToUse := metering.GasSchedule().ManagedMapAPICost.MMapContains
+	metering.UseAndTraceGas(gasToUse)
+
+	key, err := managedType.GetBytes(keyHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(key)
+
+	found, err := managedType.ManagedMapContains(mMapHandle, key)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	if found {
+		return 1
+	}
+	return 0
+}
+
+//export v1_4_mMapRemove
+func v1_4_mMapRemove(context unsafe.Pointer, mMapHandle int32, keyHandle int32, outValueHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedMapRemoveWithHost(host, mMapHandle, keyHandle, outValueHandle)
+}
+
+// ManagedMapRemoveWithHost removes the given key from the managed map and copies its
+// former value into the output buffer
+func ManagedMapRemoveWithHost(host arwen.VMHost, mMapHandle int32, keyHandle int32, outValueHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mMapRemoveName)
+
+	gasToUse := metering.GasSchedule().ManagedMapAPICost.MMapRemove
+	metering.UseAndTraceGas(gasToUse)
+
+	key, err := managedType.GetBytes(keyHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(key)
+
+	value, err := managedType.ManagedMapRemove(mMapHandle, key)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(value)
+
+	managedType.SetBytes(outValueHandle, value)
+	return 0
+}
+
+//export v1_4_mMapLen
+func v1_4_mMapLen(context unsafe.Pointer, mMapHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedMapLenWithHost(host, mMapHandle)
+}
+
+// ManagedMapLenWithHost returns the number of keys of the managed map
+func ManagedMapLenWithHost(host arwen.VMHost, mMapHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ManagedMapAPICost.MMapLen
+	metering.UseGasAndAddTracedGas(mMapLenName, gasToUse)
+
+	length := managedType.ManagedMapLen(mMapHandle)
+	if length == -1 {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrNoManagedMapUnderThisHandle, runtime.ManagedBufferAPIErrorShouldFailExecution())
+	}
+
+	return length
+}
+
+//export v1_4_mMapKeys
+func v1_4_mMapKeys(context unsafe.Pointer, mMapHandle int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedMapKeysWithHost(host, mMapHandle, destinationHandle)
+}
+
+// ManagedMapKeysWithHost writes the keys of the managed map, in ascending byte order,
+// as a managed vec of managed buffers and returns their number
+func ManagedMapKeysWithHost(host arwen.VMHost, mMapHandle int32, destinationHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mMapKeysName)
+
+	gasToUse := metering.GasSchedule().ManagedMapAPICost.MMapKeys
+	metering.UseAndTraceGas(gasToUse)
+
+	keys, err := managedType.ManagedMapKeys(mMapHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	managedType.WriteManagedVecOfManagedBuffers(keys, destinationHandle)
+	return int32(len(keys))
+}
@@ -0,0 +1,134 @@
+package elrondapi
+
+// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
+//
+// #include <stdlib.h>
+// typedef unsigned char uint8_t;
+// typedef int int32_t;
+//
+// extern int32_t	v1_4_bigIntFree(void* context, int32_t bigIntHandle);
+// extern int32_t	v1_4_bigFloatFree(void* context, int32_t bigFloatHandle);
+// extern int32_t	v1_4_mBufferFree(void* context, int32_t mBufferHandle);
+// extern int32_t	v1_4_mMapFree(void* context, int32_t mMapHandle);
+// extern int32_t	v1_4_freeEC(void* context, int32_t ecHandle);
+import "C"
+import (
+	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+)
+
+const (
+	bigIntFreeName   = "bigIntFree"
+	bigFloatFreeName = "bigFloatFree"
+	mBufferFreeName  = "mBufferFree"
+	mMapFreeName     = "mMapFree"
+	freeECName       = "freeEC"
+)
+
+// ManagedMemoryImports creates a new wasmer.Imports populated with the methods
+// releasing managed values before the end of the transaction
+func ManagedMemoryImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
+	imports = imports.Namespace("env")
+
+	imports, err := imports.Append("bigIntFree", v1_4_bigIntFree, C.v1_4_bigIntFree)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("bigFloatFree", v1_4_bigFloatFree, C.v1_4_bigFloatFree)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mBufferFree", v1_4_mBufferFree, C.v1_4_mBufferFree)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mMapFree", v1_4_mMapFree, C.v1_4_mMapFree)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("freeEC", v1_4_freeEC, C.v1_4_freeEC)
+	if err != nil {
+		return nil, err
+	}
+
+	return imports, nil
+}
+
+//export v1_4_bigIntFree
+func v1_4_bigIntFree(context unsafe.Pointer, bigIntHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return BigIntFreeWithHost(host, bigIntHandle)
+}
+
+// BigIntFreeWithHost releases the big int under the given handle
+func BigIntFreeWithHost(host arwen.VMHost, bigIntHandle int32) int32 {
+	return managedFreeWithHost(host, bigIntFreeName, bigIntHandle, host.ManagedTypes().FreeBigInt, host.Runtime().BigIntAPIErrorShouldFailExecution())
+}
+
+//export v1_4_bigFloatFree
+func v1_4_bigFloatFree(context unsafe.Pointer, bigFloatHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return BigFloatFreeWithHost(host, bigFloatHandle)
+}
+
+// BigFloatFreeWithHost releases the big float under the given handle
+func BigFloatFreeWithHost(host arwen.VMHost, bigFloatHandle int32) int32 {
+	return managedFreeWithHost(host, bigFloatFreeName, bigFloatHandle, host.ManagedTypes().FreeBigFloat, host.Runtime().BigIntAPIErrorShouldFailExecution())
+}
+
+//export v1_4_mBufferFree
+func v1_4_mBufferFree(context unsafe.Pointer, mBufferHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedBufferFreeWithHost(host, mBufferHandle)
+}
+
+// ManagedBufferFreeWithHost releases the managed buffer under the given handle.
+// A managed vec is a managed buffer, so it is released the same way, without
+// releasing its items.
+func ManagedBufferFreeWithHost(host arwen.VMHost, mBufferHandle int32) int32 {
+	return managedFreeWithHost(host, mBufferFreeName, mBufferHandle, host.ManagedTypes().FreeManagedBuffer, host.Runtime().ManagedBufferAPIErrorShouldFailExecution())
+}
+
+//export v1_4_mMapFree
+func v1_4_mMapFree(context unsafe.Pointer, mMapHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedMapFreeWithHost(host, mMapHandle)
+}
+
+// ManagedMapFreeWithHost releases the managed map under the given handle
+func ManagedMapFreeWithHost(host arwen.VMHost, mMapHandle int32) int32 {
+	return managedFreeWithHost(host, mMapFreeName, mMapHandle, host.ManagedTypes().FreeManagedMap, host.Runtime().ManagedBufferAPIErrorShouldFailExecution())
+}
+
+//export v1_4_freeEC
+func v1_4_freeEC(context unsafe.Pointer, ecHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return EllipticCurveFreeWithHost(host, ecHandle)
+}
+
+// EllipticCurveFreeWithHost releases the elliptic curve under the given handle
+func EllipticCurveFreeWithHost(host arwen.VMHost, ecHandle int32) int32 {
+	return managedFreeWithHost(host, freeECName, ecHandle, host.ManagedTypes().FreeEllipticCurve, host.Runtime().CryptoAPIErrorShouldFailExecution())
+}
+
+// managedFreeWithHost releases a managed value, so that its handle no longer
+// counts towards the caps on the live handles and managed bytes. The handle
+// may later be reused for a new value of the same type.
+func managedFreeWithHost(host arwen.VMHost, tracedFunctionName string, handle int32, free func(int32) error, failExecution bool) int32 {
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ManagedMemoryAPICost.ManagedFree
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+
+	err := free(handle)
+	if arwen.WithFaultAndHost(host, err, failExecution) {
+		return -1
+	}
+	return 0
+}
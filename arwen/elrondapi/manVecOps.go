@@ -0,0 +1,508 @@
+package elrondapi
+
+// // Declare the function signatures (see [cgo](https://golang.org/cmd/cgo/)).
+//
+// #include <stdlib.h>
+// typedef unsigned char uint8_t;
+// typedef int int32_t;
+//
+// extern int32_t	v1_4_mVecNew(void* context);
+// extern int32_t	v1_4_mVecPush(void* context, int32_t mVecHandle, int32_t itemHandle);
+// extern int32_t	v1_4_mVecGet(void* context, int32_t mVecHandle, int32_t index);
+// extern int32_t	v1_4_mVecSet(void* context, int32_t mVecHandle, int32_t index, int32_t itemHandle);
+// extern int32_t	v1_4_mVecRemove(void* context, int32_t mVecHandle, int32_t index);
+// extern int32_t	v1_4_mVecLen(void* context, int32_t mVecHandle);
+// extern int32_t	v1_4_mVecSlice(void* context, int32_t mVecHandle, int32_t startIndex, int32_t endIndex, int32_t destinationHandle);
+// extern int32_t	v1_4_mVecConcat(void* context, int32_t accumulatorHandle, int32_t otherHandle);
+//
+// extern int32_t	v1_4_mVecPushBigInt(void* context, int32_t mVecHandle, int32_t bigIntHandle);
+// extern int32_t	v1_4_mVecGetBigInt(void* context, int32_t mVecHandle, int32_t index, int32_t destinationHandle);
+// extern int32_t	v1_4_mVecSetBigInt(void* context, int32_t mVecHandle, int32_t index, int32_t bigIntHandle);
+//
+// extern int32_t	v1_4_mVecPushU64(void* context, int32_t mVecHandle, long long value);
+// extern long long	v1_4_mVecGetU64(void* context, int32_t mVecHandle, int32_t index);
+// extern int32_t	v1_4_mVecSetU64(void* context, int32_t mVecHandle, int32_t index, long long value);
+// extern int32_t	v1_4_mVecRemoveU64(void* context, int32_t mVecHandle, int32_t index);
+// extern int32_t	v1_4_mVecLenU64(void* context, int32_t mVecHandle);
+// extern int32_t	v1_4_mVecSliceU64(void* context, int32_t mVecHandle, int32_t startIndex, int32_t endIndex, int32_t destinationHandle);
+import "C"
+import (
+	"encoding/binary"
+	"unsafe"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
+)
+
+const (
+	mVecNewName        = "mVecNew"
+	mVecPushName       = "mVecPush"
+	mVecGetName        = "mVecGet"
+	mVecSetName        = "mVecSet"
+	mVecRemoveName     = "mVecRemove"
+	mVecLenName        = "mVecLen"
+	mVecSliceName      = "mVecSlice"
+	mVecConcatName     = "mVecConcat"
+	mVecPushBigIntName = "mVecPushBigInt"
+	mVecGetBigIntName  = "mVecGetBigInt"
+	mVecSetBigIntName  = "mVecSetBigInt"
+	mVecPushU64Name    = "mVecPushU64"
+	mVecGetU64Name     = "mVecGetU64"
+	mVecSetU64Name     = "mVecSetU64"
+	mVecRemoveU64Name  = "mVecRemoveU64"
+	mVecLenU64Name     = "mVecLenU64"
+	mVecSliceU64Name   = "mVecSliceU64"
+)
+
+// A managed vec is a managed buffer holding its items back to back: 4-byte
+// big endian handles for the vecs of managed types, 8-byte big endian values
+// for the vecs of u64
+const (
+	managedVecHandleLen = 4
+	managedVecU64Len    = 8
+)
+
+// ManagedVecImports creates a new wasmer.Imports populated with the ManagedVec API methods
+func ManagedVecImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
+	imports = imports.Namespace("env")
+
+	imports, err := imports.Append("mVecNew", v1_4_mVecNew, C.v1_4_mVecNew)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecPush", v1_4_mVecPush, C.v1_4_mVecPush)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecGet", v1_4_mVecGet, C.v1_4_mVecGet)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecSet", v1_4_mVecSet, C.v1_4_mVecSet)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecRemove", v1_4_mVecRemove, C.v1_4_mVecRemove)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecLen", v1_4_mVecLen, C.v1_4_mVecLen)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecSlice", v1_4_mVecSlice, C.v1_4_mVecSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecConcat", v1_4_mVecConcat, C.v1_4_mVecConcat)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecPushBigInt", v1_4_mVecPushBigInt, C.v1_4_mVecPushBigInt)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecGetBigInt", v1_4_mVecGetBigInt, C.v1_4_mVecGetBigInt)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecSetBigInt", v1_4_mVecSetBigInt, C.v1_4_mVecSetBigInt)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecPushU64", v1_4_mVecPushU64, C.v1_4_mVecPushU64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecGetU64", v1_4_mVecGetU64, C.v1_4_mVecGetU64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecSetU64", v1_4_mVecSetU64, C.v1_4_mVecSetU64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecRemoveU64", v1_4_mVecRemoveU64, C.v1_4_mVecRemoveU64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecLenU64", v1_4_mVecLenU64, C.v1_4_mVecLenU64)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("mVecSliceU64", v1_4_mVecSliceU64, C.v1_4_mVecSliceU64)
+	if err != nil {
+		return nil, err
+	}
+
+	return imports, nil
+}
+
+//export v1_4_mVecNew
+func v1_4_mVecNew(context unsafe.Pointer) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecNewWithHost(host)
+}
+
+// ManagedVecNewWithHost creates a new empty managed vec and returns its handle
+func ManagedVecNewWithHost(host arwen.VMHost) int32 {
+	managedType := host.ManagedTypes()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecNew
+	metering.UseGasAndAddTracedGas(mVecNewName, gasToUse)
+
+	return managedType.NewManagedBuffer()
+}
+
+//export v1_4_mVecPush
+func v1_4_mVecPush(context unsafe.Pointer, mVecHandle int32, itemHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecPushWithHost(host, mVecHandle, itemHandle)
+}
+
+// ManagedVecPushWithHost appends the given handle to the managed vec
+func ManagedVecPushWithHost(host arwen.VMHost, mVecHandle int32, itemHandle int32) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mVecPushName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecPush
+	metering.UseAndTraceGas(gasToUse)
+
+	err := managedVecPushItem(host, mVecHandle, encodeManagedVecHandle(itemHandle))
+	if arwen.WithFaultAndHost(host, err, host.Runtime().ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_mVecGet
+func v1_4_mVecGet(context unsafe.Pointer, mVecHandle int32, index int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecGetWithHost(host, mVecHandle, index)
+}
+
+// ManagedVecGetWithHost returns the handle at the given index of the managed vec
+func ManagedVecGetWithHost(host arwen.VMHost, mVecHandle int32, index int32) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mVecGetName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecGet
+	metering.UseAndTraceGas(gasToUse)
+
+	item, err := managedVecGetItem(host, mVecHandle, index, managedVecHandleLen)
+	if arwen.WithFaultAndHost(host, err, host.Runtime().ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return int32(binary.BigEndian.Uint32(item))
+}
+
+//export v1_4_mVecSet
+func v1_4_mVecSet(context unsafe.Pointer, mVecHandle int32, index int32, itemHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecSetWithHost(host, mVecHandle, index, itemHandle)
+}
+
+// ManagedVecSetWithHost replaces the handle at the given index of the managed vec
+func ManagedVecSetWithHost(host arwen.VMHost, mVecHandle int32, index int32, itemHandle int32) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mVecSetName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecSet
+	metering.UseAndTraceGas(gasToUse)
+
+	err := managedVecSetItem(host, mVecHandle, index, encodeManagedVecHandle(itemHandle))
+	if arwen.WithFaultAndHost(host, err, host.Runtime().ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_mVecRemove
+func v1_4_mVecRemove(context unsafe.Pointer, mVecHandle int32, index int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecRemoveWithHost(host, mVecHandle, index)
+}
+
+// ManagedVecRemoveWithHost removes the handle at the given index of the
+// managed vec, shifting the following ones
+func ManagedVecRemoveWithHost(host arwen.VMHost, mVecHandle int32, index int32) int32 {
+	return managedVecRemoveWithItemLen(host, mVecRemoveName, mVecHandle, index, managedVecHandleLen)
+}
+
+//export v1_4_mVecLen
+func v1_4_mVecLen(context unsafe.Pointer, mVecHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecLenWithHost(host, mVecHandle)
+}
+
+// ManagedVecLenWithHost returns the number of handles in the managed vec
+func ManagedVecLenWithHost(host arwen.VMHost, mVecHandle int32) int32 {
+	return managedVecLenWithItemLen(host, mVecLenName, mVecHandle, managedVecHandleLen)
+}
+
+//export v1_4_mVecSlice
+func v1_4_mVecSlice(context unsafe.Pointer, mVecHandle int32, startIndex int32, endIndex int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecSliceWithHost(host, mVecHandle, startIndex, endIndex, destinationHandle)
+}
+
+// ManagedVecSliceWithHost writes the handles between startIndex (inclusive)
+// and endIndex (exclusive) of the managed vec into the destination vec
+func ManagedVecSliceWithHost(host arwen.VMHost, mVecHandle int32, startIndex int32, endIndex int32, destinationHandle int32) int32 {
+	return managedVecSliceWithItemLen(host, mVecSliceName, mVecHandle, startIndex, endIndex, destinationHandle, managedVecHandleLen)
+}
+
+//export v1_4_mVecConcat
+func v1_4_mVecConcat(context unsafe.Pointer, accumulatorHandle int32, otherHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecConcatWithHost(host, accumulatorHandle, otherHandle)
+}
+
+// ManagedVecConcatWithHost appends the items of the other managed vec to the
+// accumulator vec; both vecs must hold items of the same type
+func ManagedVecConcatWithHost(host arwen.VMHost, accumulatorHandle int32, otherHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mVecConcatName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecConcat
+	metering.UseAndTraceGas(gasToUse)
+
+	otherBytes, err := managedType.GetBytes(otherHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBytes(otherBytes)
+
+	isSuccess := managedType.AppendBytes(accumulatorHandle, otherBytes)
+	if !isSuccess {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrNoManagedBufferUnderThisHandle, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_mVecPushBigInt
+func v1_4_mVecPushBigInt(context unsafe.Pointer, mVecHandle int32, bigIntHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecPushBigIntWithHost(host, mVecHandle, bigIntHandle)
+}
+
+// ManagedVecPushBigIntWithHost appends a copy of the given big int to the
+// managed vec, so that later changes to the big int do not affect the vec
+func ManagedVecPushBigIntWithHost(host arwen.VMHost, mVecHandle int32, bigIntHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mVecPushBigIntName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecPushBigInt
+	metering.UseAndTraceGas(gasToUse)
+
+	value, err := managedType.GetBigInt(bigIntHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBigIntCopy(value)
+
+	itemHandle := managedType.NewBigInt(value)
+	err = managedVecPushItem(host, mVecHandle, encodeManagedVecHandle(itemHandle))
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_mVecGetBigInt
+func v1_4_mVecGetBigInt(context unsafe.Pointer, mVecHandle int32, index int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecGetBigIntWithHost(host, mVecHandle, index, destinationHandle)
+}
+
+// ManagedVecGetBigIntWithHost copies the big int at the given index of the
+// managed vec into the destination big int
+func ManagedVecGetBigIntWithHost(host arwen.VMHost, mVecHandle int32, index int32, destinationHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mVecGetBigIntName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecGetBigInt
+	metering.UseAndTraceGas(gasToUse)
+
+	item, err := managedVecGetItem(host, mVecHandle, index, managedVecHandleLen)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	value, err := managedType.GetBigInt(int32(binary.BigEndian.Uint32(item)))
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBigIntCopy(value)
+
+	destination := managedType.GetBigIntOrCreate(destinationHandle)
+	destination.Set(value)
+	return 0
+}
+
+//export v1_4_mVecSetBigInt
+func v1_4_mVecSetBigInt(context unsafe.Pointer, mVecHandle int32, index int32, bigIntHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecSetBigIntWithHost(host, mVecHandle, index, bigIntHandle)
+}
+
+// ManagedVecSetBigIntWithHost replaces the big int at the given index of the
+// managed vec with a copy of the given big int
+func ManagedVecSetBigIntWithHost(host arwen.VMHost, mVecHandle int32, index int32, bigIntHandle int32) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(mVecSetBigIntName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecSetBigInt
+	metering.UseAndTraceGas(gasToUse)
+
+	value, err := managedType.GetBigInt(bigIntHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBigIntCopy(value)
+
+	_, err = managedVecGetItem(host, mVecHandle, index, managedVecHandleLen)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	itemHandle := managedType.NewBigInt(value)
+	err = managedVecSetItem(host, mVecHandle, index, encodeManagedVecHandle(itemHandle))
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_mVecPushU64
+func v1_4_mVecPushU64(context unsafe.Pointer, mVecHandle int32, value int64) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecPushU64WithHost(host, mVecHandle, value)
+}
+
+// ManagedVecPushU64WithHost appends the given value to the managed vec of u64
+func ManagedVecPushU64WithHost(host arwen.VMHost, mVecHandle int32, value int64) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mVecPushU64Name)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecPushU64
+	metering.UseAndTraceGas(gasToUse)
+
+	err := managedVecPushItem(host, mVecHandle, encodeManagedVecU64(value))
+	if arwen.WithFaultAndHost(host, err, host.Runtime().ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_mVecGetU64
+func v1_4_mVecGetU64(context unsafe.Pointer, mVecHandle int32, index int32) int64 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecGetU64WithHost(host, mVecHandle, index)
+}
+
+// ManagedVecGetU64WithHost returns the value at the given index of the managed vec of u64
+func ManagedVecGetU64WithHost(host arwen.VMHost, mVecHandle int32, index int32) int64 {
+	metering := host.Metering()
+	metering.StartGasTracing(mVecGetU64Name)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecGetU64
+	metering.UseAndTraceGas(gasToUse)
+
+	item, err := managedVecGetItem(host, mVecHandle, index, managedVecU64Len)
+	if arwen.WithFaultAndHost(host, err, host.Runtime().ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return int64(binary.BigEndian.Uint64(item))
+}
+
+//export v1_4_mVecSetU64
+func v1_4_mVecSetU64(context unsafe.Pointer, mVecHandle int32, index int32, value int64) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecSetU64WithHost(host, mVecHandle, index, value)
+}
+
+// ManagedVecSetU64WithHost replaces the value at the given index of the managed vec of u64
+func ManagedVecSetU64WithHost(host arwen.VMHost, mVecHandle int32, index int32, value int64) int32 {
+	metering := host.Metering()
+	metering.StartGasTracing(mVecSetU64Name)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecSetU64
+	metering.UseAndTraceGas(gasToUse)
+
+	err := managedVecSetItem(host, mVecHandle, index, encodeManagedVecU64(value))
+	if arwen.WithFaultAndHost(host, err, host.Runtime().ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return 0
+}
+
+//export v1_4_mVecRemoveU64
+func v1_4_mVecRemoveU64(context unsafe.Pointer, mVecHandle int32, index int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecRemoveU64WithHost(host, mVecHandle, index)
+}
+
+// ManagedVecRemoveU64WithHost removes the value at the given index of the
+// managed vec of u64, shifting the following ones
+func ManagedVecRemoveU64WithHost(host arwen.VMHost, mVecHandle int32, index int32) int32 {
+	return managedVecRemoveWithItemLen(host, mVecRemoveU64Name, mVecHandle, index, managedVecU64Len)
+}
+
+//export v1_4_mVecLenU64
+func v1_4_mVecLenU64(context unsafe.Pointer, mVecHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecLenU64WithHost(host, mVecHandle)
+}
+
+// ManagedVecLenU64WithHost returns the number of values in the managed vec of u64
+func ManagedVecLenU64WithHost(host arwen.VMHost, mVecHandle int32) int32 {
+	return managedVecLenWithItemLen(host, mVecLenU64Name, mVecHandle, managedVecU64Len)
+}
+
+//export v1_4_mVecSliceU64
+func v1_4_mVecSliceU64(context unsafe.Pointer, mVecHandle int32, startIndex int32, endIndex int32, destinationHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedVecSliceU64WithHost(host, mVecHandle, startIndex, endIndex, destinationHandle)
+}
+
+// ManagedVecSliceU64WithHost writes the values between startIndex (inclusive)
+// and endIndex (exclusive) of the managed vec of u64 into the destination vec
+func ManagedVecSliceU64WithHost(host arwen.VMHost, mVecHandle int32, startIndex int32, endIndex int32, destinationHandle int32) int32 {
+	return managedVecSliceWithItemLen(host, mVecSliceU64Name, mVecHandle, startIndex, endIndex, destinationHandle, managedVecU64Len)
+}
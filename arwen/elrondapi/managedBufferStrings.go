@@ -0,0 +1,161 @@
+package elrondapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	twos "github.com/ElrondNetwork/big-int-util/twos-complement"
+)
+
+// maxManagedDecimals bounds the number of decimals accepted when parsing or
+// formatting fixed point decimal strings
+const maxManagedDecimals = 255
+
+// nested encodings of big ints are prefixed by their length as a 4-byte big endian integer
+const nestedLengthPrefixLen = 4
+
+// splitManagedBytes splits the data around every occurrence of the separator
+func splitManagedBytes(data []byte, separator []byte) ([][]byte, error) {
+	if len(separator) == 0 {
+		return nil, arwen.ErrEmptySeparator
+	}
+	return bytes.Split(data, separator), nil
+}
+
+// parseDecimal parses an optionally signed decimal string with at most the
+// given number of fractional digits, returning it scaled by 10^decimals
+func parseDecimal(data []byte, decimals int32) (*big.Int, error) {
+	if decimals < 0 || decimals > maxManagedDecimals {
+		return nil, arwen.ErrArgOutOfRange
+	}
+
+	negative := false
+	if len(data) > 0 && (data[0] == '-' || data[0] == '+') {
+		negative = data[0] == '-'
+		data = data[1:]
+	}
+
+	integralPart := data
+	fractionalPart := []byte{}
+	dotPosition := bytes.IndexByte(data, '.')
+	if dotPosition >= 0 {
+		integralPart = data[:dotPosition]
+		fractionalPart = data[dotPosition+1:]
+		if len(fractionalPart) == 0 {
+			return nil, arwen.ErrInvalidDecimalString
+		}
+	}
+	if len(integralPart) == 0 || len(fractionalPart) > int(decimals) {
+		return nil, arwen.ErrInvalidDecimalString
+	}
+	if !isDecimalDigits(integralPart) || !isDecimalDigits(fractionalPart) {
+		return nil, arwen.ErrInvalidDecimalString
+	}
+
+	digits := make([]byte, 0, len(integralPart)+int(decimals))
+	digits = append(digits, integralPart...)
+	digits = append(digits, fractionalPart...)
+	digits = append(digits, bytes.Repeat([]byte{'0'}, int(decimals)-len(fractionalPart))...)
+
+	result, ok := big.NewInt(0).SetString(string(digits), 10)
+	if !ok {
+		return nil, arwen.ErrInvalidDecimalString
+	}
+	if negative {
+		result.Neg(result)
+	}
+	return result, nil
+}
+
+// formatDecimal formats the value divided by 10^decimals, always writing all
+// the fractional digits
+func formatDecimal(value *big.Int, decimals int32) ([]byte, error) {
+	if decimals < 0 || decimals > maxManagedDecimals {
+		return nil, arwen.ErrArgOutOfRange
+	}
+
+	digits := []byte(big.NewInt(0).Abs(value).String())
+	if len(digits) <= int(decimals) {
+		padding := bytes.Repeat([]byte{'0'}, int(decimals)-len(digits)+1)
+		digits = append(padding, digits...)
+	}
+
+	integralLength := len(digits) - int(decimals)
+	result := make([]byte, 0, len(digits)+2)
+	if value.Sign() < 0 {
+		result = append(result, '-')
+	}
+	result = append(result, digits[:integralLength]...)
+	if decimals > 0 {
+		result = append(result, '.')
+		result = append(result, digits[integralLength:]...)
+	}
+	return result, nil
+}
+
+func isDecimalDigits(data []byte) bool {
+	for _, digit := range data {
+		if digit < '0' || digit > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// topEncodeU64 returns the minimal big endian representation of the value,
+// which is empty for zero
+func topEncodeU64(value uint64) []byte {
+	return big.NewInt(0).SetUint64(value).Bytes()
+}
+
+func topDecodeU64(data []byte) (uint64, error) {
+	if len(data) > 8 {
+		return 0, arwen.ErrInvalidEncodedData
+	}
+	return big.NewInt(0).SetBytes(data).Uint64(), nil
+}
+
+func nestedEncodeU64(value uint64) []byte {
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, value)
+	return encoded
+}
+
+// nestedEncodeBigInt returns the top encoding of the value prefixed by its length
+func nestedEncodeBigInt(value *big.Int, signed bool) []byte {
+	var topEncoded []byte
+	if signed {
+		topEncoded = twos.ToBytes(value)
+	} else {
+		topEncoded = value.Bytes()
+	}
+
+	encoded := make([]byte, nestedLengthPrefixLen, nestedLengthPrefixLen+len(topEncoded))
+	binary.BigEndian.PutUint32(encoded, uint32(len(topEncoded)))
+	return append(encoded, topEncoded...)
+}
+
+// nestedDecodeBigInt decodes the big int nested-encoded at the given position
+// of the data and returns the position right after it
+func nestedDecodeBigInt(data []byte, position int32, signed bool) (*big.Int, int32, error) {
+	if position < 0 || int(position)+nestedLengthPrefixLen > len(data) {
+		return nil, 0, arwen.ErrInvalidEncodedData
+	}
+
+	start := int(position) + nestedLengthPrefixLen
+	length := binary.BigEndian.Uint32(data[position:start])
+	if uint64(length) > uint64(len(data)-start) {
+		return nil, 0, arwen.ErrInvalidEncodedData
+	}
+
+	end := start + int(length)
+	value := big.NewInt(0)
+	if signed {
+		twos.SetBytes(value, data[start:end])
+	} else {
+		value.SetBytes(data[start:end])
+	}
+	return value, int32(end), nil
+}
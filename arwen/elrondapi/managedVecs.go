@@ -0,0 +1,180 @@
+package elrondapi
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+)
+
+func managedVecLenWithItemLen(host arwen.VMHost, tracedFunctionName string, mVecHandle int32, itemLen int) int32 {
+	managedType := host.ManagedTypes()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecLen
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+
+	mVecBytes, err := managedType.GetBytes(mVecHandle)
+	if arwen.WithFaultAndHost(host, err, host.Runtime().ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	numItems, err := managedVecNumItems(mVecBytes, itemLen)
+	if arwen.WithFaultAndHost(host, err, host.Runtime().ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return int32(numItems)
+}
+
+func managedVecRemoveWithItemLen(host arwen.VMHost, tracedFunctionName string, mVecHandle int32, index int32, itemLen int) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(tracedFunctionName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecRemove
+	metering.UseAndTraceGas(gasToUse)
+
+	mVecBytes, err := managedType.GetBytes(mVecHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	itemStart, err := managedVecItemStart(mVecBytes, index, itemLen)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	newBytes := make([]byte, 0, len(mVecBytes)-itemLen)
+	newBytes = append(newBytes, mVecBytes[:itemStart]...)
+	newBytes = append(newBytes, mVecBytes[itemStart+itemLen:]...)
+	managedType.ConsumeGasForBytes(newBytes)
+
+	managedType.SetBytes(mVecHandle, newBytes)
+	return 0
+}
+
+func managedVecSliceWithItemLen(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	mVecHandle int32,
+	startIndex int32,
+	endIndex int32,
+	destinationHandle int32,
+	itemLen int,
+) int32 {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(tracedFunctionName)
+
+	gasToUse := metering.GasSchedule().ManagedVecAPICost.MVecSlice
+	metering.UseAndTraceGas(gasToUse)
+
+	mVecBytes, err := managedType.GetBytes(mVecHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	numItems, err := managedVecNumItems(mVecBytes, itemLen)
+	if arwen.WithFaultAndHost(host, err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	if startIndex < 0 || endIndex < startIndex || int(endIndex) > numItems {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrBadBounds, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	slice := mVecBytes[int(startIndex)*itemLen : int(endIndex)*itemLen]
+	managedType.ConsumeGasForBytes(slice)
+
+	managedType.SetBytes(destinationHandle, slice)
+	return 0
+}
+
+func managedVecPushItem(host arwen.VMHost, mVecHandle int32, item []byte) error {
+	managedType := host.ManagedTypes()
+
+	mVecBytes, err := managedType.GetBytes(mVecHandle)
+	if err != nil {
+		return err
+	}
+
+	_, err = managedVecNumItems(mVecBytes, len(item))
+	if err != nil {
+		return err
+	}
+
+	managedType.ConsumeGasForBytes(item)
+	managedType.AppendBytes(mVecHandle, item)
+	return nil
+}
+
+func managedVecGetItem(host arwen.VMHost, mVecHandle int32, index int32, itemLen int) ([]byte, error) {
+	mVecBytes, err := host.ManagedTypes().GetBytes(mVecHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	itemStart, err := managedVecItemStart(mVecBytes, index, itemLen)
+	if err != nil {
+		return nil, err
+	}
+
+	return mVecBytes[itemStart : itemStart+itemLen], nil
+}
+
+func managedVecSetItem(host arwen.VMHost, mVecHandle int32, index int32, item []byte) error {
+	managedType := host.ManagedTypes()
+
+	mVecBytes, err := managedType.GetBytes(mVecHandle)
+	if err != nil {
+		return err
+	}
+
+	itemStart, err := managedVecItemStart(mVecBytes, index, len(item))
+	if err != nil {
+		return err
+	}
+
+	// the bytes of the managed buffer are never modified in place, since
+	// they might be shared with the saved states of the managed types
+	newBytes := make([]byte, len(mVecBytes))
+	copy(newBytes, mVecBytes)
+	copy(newBytes[itemStart:], item)
+	managedType.ConsumeGasForBytes(item)
+
+	managedType.SetBytes(mVecHandle, newBytes)
+	return nil
+}
+
+func managedVecNumItems(mVecBytes []byte, itemLen int) (int, error) {
+	if len(mVecBytes)%itemLen != 0 {
+		return 0, arwen.ErrInvalidManagedVec
+	}
+	return len(mVecBytes) / itemLen, nil
+}
+
+func managedVecItemStart(mVecBytes []byte, index int32, itemLen int) (int, error) {
+	numItems, err := managedVecNumItems(mVecBytes, itemLen)
+	if err != nil {
+		return 0, err
+	}
+	if index < 0 || int(index) >= numItems {
+		return 0, arwen.ErrBadBounds
+	}
+	return int(index) * itemLen, nil
+}
+
+func encodeManagedVecHandle(handle int32) []byte {
+	item := make([]byte, managedVecHandleLen)
+	binary.BigEndian.PutUint32(item, uint32(handle))
+	return item
+}
+
+func encodeManagedVecU64(value int64) []byte {
+	item := make([]byte, managedVecU64Len)
+	binary.BigEndian.PutUint64(item, uint64(value))
+	return item
+}
@@ -10,6 +10,11 @@ package elrondapi
 // extern void	v1_4_managedSCAddress(void *context, int32_t addressHandle);
 // extern void	v1_4_managedOwnerAddress(void *context, int32_t addressHandle);
 // extern void	v1_4_managedCaller(void *context, int32_t addressHandle);
+// extern void	v1_4_managedGetCodeHash(void *context, int32_t addressHandle, int32_t resultHandle);
+// extern void	v1_4_managedGetCodeMetadata(void *context, int32_t addressHandle, int32_t resultHandle);
+// extern long long	v1_4_managedGetAccountNonce(void *context, int32_t addressHandle);
+// extern void	v1_4_managedGetAccountOwner(void *context, int32_t addressHandle, int32_t resultHandle);
+// extern void	v1_4_managedGetAccountUsername(void *context, int32_t addressHandle, int32_t resultHandle);
 // extern void	v1_4_managedSignalError(void* context, int32_t errHandle1);
 // extern void	v1_4_managedWriteLog(void* context, int32_t topicsHandle, int32_t dataHandle);
 //
@@ -24,6 +29,7 @@ package elrondapi
 // extern void		v1_4_managedUpgradeContract(void *context, int32_t dstHandle, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
 // extern void		v1_4_managedUpgradeFromSourceContract(void *context, int32_t dstHandle, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
 // extern void		v1_4_managedAsyncCall(void *context, int32_t dstHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle);
+// extern void		v1_4_managedCreateAsyncCall(void *context, int32_t identifierHandle, int32_t dstHandle, int32_t valueHandle, int32_t tokenTransfersHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t successHandle, int32_t errorHandle, long long gas);
 //
 // extern void		v1_4_managedGetMultiESDTCallValue(void *context, int32_t multiCallValueHandle);
 // extern void		v1_4_managedGetESDTBalance(void *context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce, int32_t valueHandle);
@@ -32,13 +38,29 @@ package elrondapi
 // extern void		v1_4_managedGetReturnData(void *context, int32_t resultID, int32_t resultHandle);
 // extern void		v1_4_managedGetPrevBlockRandomSeed(void *context, int32_t resultHandle);
 // extern void		v1_4_managedGetBlockRandomSeed(void *context, int32_t resultHandle);
+// extern void		v1_4_managedGetFrameRandomSeed(void *context, int32_t resultHandle);
 // extern void		v1_4_managedGetStateRootHash(void *context, int32_t resultHandle);
 // extern void		v1_4_managedGetOriginalTxHash(void *context, int32_t resultHandle);
+// extern void		v1_4_managedGetOriginalCaller(void *context, int32_t resultHandle);
 //
 // extern int32_t   v1_4_managedIsESDTFrozen(void *context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce);
 // extern int32_t   v1_4_managedIsPaused(void *context, int32_t tokenIDHandle);
 // extern int32_t   v1_4_managedIsLimitedTransfer(void *context, int32_t tokenIDHandle);
 // extern void      v1_4_managedBufferToHex(void *context, int32_t sourceHandle, int32_t destHandle);
+//
+// extern int32_t   v1_4_managedSetStorageLock(void *context, int32_t keyHandle, long long lockTimestamp);
+// extern long long v1_4_managedGetStorageLock(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedIsStorageLocked(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedClearStorageLock(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedSetStorageLockByNonce(void *context, int32_t keyHandle, long long lockNonce);
+// extern long long v1_4_managedGetStorageLockByNonce(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedIsStorageLockedByNonce(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedClearStorageLockByNonce(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedSetStorageLockByRound(void *context, int32_t keyHandle, long long lockRound);
+// extern long long v1_4_managedGetStorageLockByRound(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedIsStorageLockedByRound(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedClearStorageLockByRound(void *context, int32_t keyHandle);
+// extern int32_t   v1_4_managedGetStorageLocks(void *context, int32_t lockType, int32_t keysHandle);
 import "C"
 
 import (
@@ -56,6 +78,11 @@ const (
 	managedSCAddressName                    = "managedSCAddress"
 	managedOwnerAddressName                 = "managedOwnerAddress"
 	managedCallerName                       = "managedCaller"
+	managedGetCodeHashName                  = "managedGetCodeHash"
+	managedGetCodeMetadataName              = "managedGetCodeMetadata"
+	managedGetAccountNonceName              = "managedGetAccountNonce"
+	managedGetAccountOwnerName              = "managedGetAccountOwner"
+	managedGetAccountUsernameName           = "managedGetAccountUsername"
 	managedSignalErrorName                  = "managedSignalError"
 	managedWriteLogName                     = "managedWriteLog"
 	managedMultiTransferESDTNFTExecuteName  = "managedMultiTransferESDTNFTExecute"
@@ -69,18 +96,34 @@ const (
 	managedUpgradeContractName              = "managedUpgradeContract"
 	managedUpgradeFromSourceContractName    = "managedUpgradeFromSourceContract"
 	managedAsyncCallName                    = "managedAsyncCall"
+	managedCreateAsyncCallName              = "managedCreateAsyncCall"
 	managedGetMultiESDTCallValueName        = "managedGetMultiESDTCallValue"
 	managedGetESDTBalanceName               = "managedGetESDTBalance"
 	managedGetESDTTokenDataName             = "managedGetESDTTokenData"
 	managedGetReturnDataName                = "managedGetReturnData"
 	managedGetPrevBlockRandomSeedName       = "managedGetPrevBlockRandomSeed"
 	managedGetBlockRandomSeedName           = "managedGetBlockRandomSeed"
+	managedGetFrameRandomSeedName           = "managedGetFrameRandomSeed"
 	managedGetStateRootHashName             = "managedGetStateRootHash"
 	managedGetOriginalTxHashName            = "managedGetOriginalTxHash"
+	managedGetOriginalCallerName            = "managedGetOriginalCaller"
 	managedIsESDTFrozenName                 = "managedIsESDTFrozen"
 	managedIsLimitedTransferName            = "managedIsLimitedTransfer"
 	managedIsPausedName                     = "managedIsPaused"
 	managedBufferToHexName                  = "managedBufferToHex"
+	managedSetStorageLockName               = "managedSetStorageLock"
+	managedGetStorageLockName               = "managedGetStorageLock"
+	managedIsStorageLockedName              = "managedIsStorageLocked"
+	managedClearStorageLockName             = "managedClearStorageLock"
+	managedSetStorageLockByNonceName        = "managedSetStorageLockByNonce"
+	managedGetStorageLockByNonceName        = "managedGetStorageLockByNonce"
+	managedIsStorageLockedByNonceName       = "managedIsStorageLockedByNonce"
+	managedClearStorageLockByNonceName      = "managedClearStorageLockByNonce"
+	managedSetStorageLockByRoundName        = "managedSetStorageLockByRound"
+	managedGetStorageLockByRoundName        = "managedGetStorageLockByRound"
+	managedIsStorageLockedByRoundName       = "managedIsStorageLockedByRound"
+	managedClearStorageLockByRoundName      = "managedClearStorageLockByRound"
+	managedGetStorageLocksName              = "managedGetStorageLocks"
 )
 
 // ManagedEIImports creates a new wasmer.Imports populated with variants of the API methods that use managed types only.
@@ -102,6 +145,31 @@ func ManagedEIImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("managedGetCodeHash", v1_4_managedGetCodeHash, C.v1_4_managedGetCodeHash)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetCodeMetadata", v1_4_managedGetCodeMetadata, C.v1_4_managedGetCodeMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetAccountNonce", v1_4_managedGetAccountNonce, C.v1_4_managedGetAccountNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetAccountOwner", v1_4_managedGetAccountOwner, C.v1_4_managedGetAccountOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetAccountUsername", v1_4_managedGetAccountUsername, C.v1_4_managedGetAccountUsername)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("managedSignalError", v1_4_managedSignalError, C.v1_4_managedSignalError)
 	if err != nil {
 		return nil, err
@@ -167,6 +235,11 @@ func ManagedEIImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("managedCreateAsyncCall", v1_4_managedCreateAsyncCall, C.v1_4_managedCreateAsyncCall)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("managedGetMultiESDTCallValue", v1_4_managedGetMultiESDTCallValue, C.v1_4_managedGetMultiESDTCallValue)
 	if err != nil {
 		return nil, err
@@ -197,6 +270,11 @@ func ManagedEIImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("managedGetFrameRandomSeed", v1_4_managedGetFrameRandomSeed, C.v1_4_managedGetFrameRandomSeed)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("managedGetStateRootHash", v1_4_managedGetStateRootHash, C.v1_4_managedGetStateRootHash)
 	if err != nil {
 		return nil, err
@@ -207,6 +285,11 @@ func ManagedEIImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("managedGetOriginalCaller", v1_4_managedGetOriginalCaller, C.v1_4_managedGetOriginalCaller)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = imports.Append("managedIsESDTFrozen", v1_4_managedIsESDTFrozen, C.v1_4_managedIsESDTFrozen)
 	if err != nil {
 		return nil, err
@@ -227,6 +310,71 @@ func ManagedEIImports(imports *wasmer.Imports) (*wasmer.Imports, error) {
 		return nil, err
 	}
 
+	imports, err = imports.Append("managedSetStorageLock", v1_4_managedSetStorageLock, C.v1_4_managedSetStorageLock)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetStorageLock", v1_4_managedGetStorageLock, C.v1_4_managedGetStorageLock)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedIsStorageLocked", v1_4_managedIsStorageLocked, C.v1_4_managedIsStorageLocked)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedClearStorageLock", v1_4_managedClearStorageLock, C.v1_4_managedClearStorageLock)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSetStorageLockByNonce", v1_4_managedSetStorageLockByNonce, C.v1_4_managedSetStorageLockByNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetStorageLockByNonce", v1_4_managedGetStorageLockByNonce, C.v1_4_managedGetStorageLockByNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedIsStorageLockedByNonce", v1_4_managedIsStorageLockedByNonce, C.v1_4_managedIsStorageLockedByNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedClearStorageLockByNonce", v1_4_managedClearStorageLockByNonce, C.v1_4_managedClearStorageLockByNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedSetStorageLockByRound", v1_4_managedSetStorageLockByRound, C.v1_4_managedSetStorageLockByRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetStorageLockByRound", v1_4_managedGetStorageLockByRound, C.v1_4_managedGetStorageLockByRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedIsStorageLockedByRound", v1_4_managedIsStorageLockedByRound, C.v1_4_managedIsStorageLockedByRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedClearStorageLockByRound", v1_4_managedClearStorageLockByRound, C.v1_4_managedClearStorageLockByRound)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = imports.Append("managedGetStorageLocks", v1_4_managedGetStorageLocks, C.v1_4_managedGetStorageLocks)
+	if err != nil {
+		return nil, err
+	}
+
 	return imports, nil
 }
 
@@ -275,6 +423,36 @@ func v1_4_managedCaller(context unsafe.Pointer, destinationHandle int32) {
 	managedType.SetBytes(destinationHandle, caller)
 }
 
+//export v1_4_managedGetCodeHash
+func v1_4_managedGetCodeHash(context unsafe.Pointer, addressHandle int32, resultHandle int32) {
+	host := arwen.GetVMHost(context)
+	ManagedGetCodeHashWithHost(host, addressHandle, resultHandle)
+}
+
+//export v1_4_managedGetCodeMetadata
+func v1_4_managedGetCodeMetadata(context unsafe.Pointer, addressHandle int32, resultHandle int32) {
+	host := arwen.GetVMHost(context)
+	ManagedGetCodeMetadataWithHost(host, addressHandle, resultHandle)
+}
+
+//export v1_4_managedGetAccountNonce
+func v1_4_managedGetAccountNonce(context unsafe.Pointer, addressHandle int32) int64 {
+	host := arwen.GetVMHost(context)
+	return ManagedGetAccountNonceWithHost(host, addressHandle)
+}
+
+//export v1_4_managedGetAccountOwner
+func v1_4_managedGetAccountOwner(context unsafe.Pointer, addressHandle int32, resultHandle int32) {
+	host := arwen.GetVMHost(context)
+	ManagedGetAccountOwnerWithHost(host, addressHandle, resultHandle)
+}
+
+//export v1_4_managedGetAccountUsername
+func v1_4_managedGetAccountUsername(context unsafe.Pointer, addressHandle int32, resultHandle int32) {
+	host := arwen.GetVMHost(context)
+	ManagedGetAccountUsernameWithHost(host, addressHandle, resultHandle)
+}
+
 //export v1_4_managedSignalError
 func v1_4_managedSignalError(context unsafe.Pointer, errHandle int32) {
 	managedType := arwen.GetManagedTypesContext(context)
@@ -347,6 +525,12 @@ func v1_4_managedGetOriginalTxHash(context unsafe.Pointer, resultHandle int32) {
 	managedType.SetBytes(resultHandle, runtime.GetOriginalTxHash())
 }
 
+//export v1_4_managedGetOriginalCaller
+func v1_4_managedGetOriginalCaller(context unsafe.Pointer, resultHandle int32) {
+	host := arwen.GetVMHost(context)
+	ManagedGetOriginalCallerWithHost(host, resultHandle)
+}
+
 //export v1_4_managedGetStateRootHash
 func v1_4_managedGetStateRootHash(context unsafe.Pointer, resultHandle int32) {
 	blockchain := arwen.GetBlockchainContext(context)
@@ -371,6 +555,24 @@ func v1_4_managedGetBlockRandomSeed(context unsafe.Pointer, resultHandle int32)
 	managedType.SetBytes(resultHandle, blockchain.CurrentRandomSeed())
 }
 
+//export v1_4_managedGetFrameRandomSeed
+func v1_4_managedGetFrameRandomSeed(context unsafe.Pointer, resultHandle int32) {
+	host := arwen.GetVMHost(context)
+	ManagedGetFrameRandomSeedWithHost(host, resultHandle)
+}
+
+// ManagedGetFrameRandomSeedWithHost sets the random seed of the current call
+// frame into the managed buffer, for contracts deriving their own randomness
+func ManagedGetFrameRandomSeedWithHost(host arwen.VMHost, resultHandle int32) {
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetBlockRandomSeed
+	metering.UseGasAndAddTracedGas(managedGetFrameRandomSeedName, gasToUse)
+
+	managedType.SetBytes(resultHandle, managedType.GetFrameRandomSeed())
+}
+
 //export v1_4_managedGetPrevBlockRandomSeed
 func v1_4_managedGetPrevBlockRandomSeed(context unsafe.Pointer, resultHandle int32) {
 	blockchain := arwen.GetBlockchainContext(context)
@@ -556,6 +758,98 @@ func ManagedAsyncCallWithHost(
 	}
 }
 
+//export v1_4_managedCreateAsyncCall
+func v1_4_managedCreateAsyncCall(
+	context unsafe.Pointer,
+	identifierHandle int32,
+	destHandle int32,
+	valueHandle int32,
+	tokenTransfersHandle int32,
+	functionHandle int32,
+	argumentsHandle int32,
+	successHandle int32,
+	errorHandle int32,
+	gas int64) {
+	host := arwen.GetVMHost(context)
+	ManagedCreateAsyncCallWithHost(
+		host,
+		identifierHandle,
+		destHandle,
+		valueHandle,
+		tokenTransfersHandle,
+		functionHandle,
+		argumentsHandle,
+		successHandle,
+		errorHandle,
+		gas)
+}
+
+// ManagedCreateAsyncCallWithHost adds a call to the async context identified by
+// the given managed buffer, paying the value and the ESDT transfers read from managed types
+func ManagedCreateAsyncCallWithHost(
+	host arwen.VMHost,
+	identifierHandle int32,
+	destHandle int32,
+	valueHandle int32,
+	tokenTransfersHandle int32,
+	functionHandle int32,
+	argumentsHandle int32,
+	successHandle int32,
+	errorHandle int32,
+	gas int64) {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(managedCreateAsyncCallName)
+
+	identifier, err := managedType.GetBytes(identifierHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	vmInput, err := readDestinationFunctionArguments(host, destHandle, functionHandle, argumentsHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	value, err := managedType.GetBigInt(valueHandle)
+	if err != nil {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
+		return
+	}
+
+	transfers, err := readESDTTransfers(managedType, tokenTransfersHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	successCallback, err := managedType.GetBytes(successHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	errorCallback, err := managedType.GetBytes(errorHandle)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	data := makeCrossShardCallFromInput(vmInput.function, vmInput.arguments)
+
+	gasToUse := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(len(data)))
+	metering.UseAndTraceGas(gasToUse)
+
+	CreateAsyncCallWithTypedArgs(
+		host,
+		identifier,
+		vmInput.destination,
+		value.Bytes(),
+		transfers,
+		[]byte(data),
+		successCallback,
+		errorCallback,
+		gas)
+}
+
 //export v1_4_managedUpgradeFromSourceContract
 func v1_4_managedUpgradeFromSourceContract(
 	context unsafe.Pointer,
@@ -1056,3 +1350,81 @@ func v1_4_managedBufferToHex(context unsafe.Pointer, sourceHandle int32, destHan
 	encoded := hex.EncodeToString(mBuff)
 	managedType.SetBytes(destHandle, []byte(encoded))
 }
+
+//export v1_4_managedSetStorageLock
+func v1_4_managedSetStorageLock(context unsafe.Pointer, keyHandle int32, lockTimestamp int64) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedSetStorageLockOfTypeWithHost(host, managedSetStorageLockName, arwen.TimestampLock, keyHandle, lockTimestamp)
+}
+
+//export v1_4_managedGetStorageLock
+func v1_4_managedGetStorageLock(context unsafe.Pointer, keyHandle int32) int64 {
+	host := arwen.GetVMHost(context)
+	return ManagedGetStorageLockOfTypeWithHost(host, managedGetStorageLockName, arwen.TimestampLock, keyHandle)
+}
+
+//export v1_4_managedIsStorageLocked
+func v1_4_managedIsStorageLocked(context unsafe.Pointer, keyHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedIsStorageLockedOfTypeWithHost(host, managedIsStorageLockedName, arwen.TimestampLock, keyHandle)
+}
+
+//export v1_4_managedClearStorageLock
+func v1_4_managedClearStorageLock(context unsafe.Pointer, keyHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedSetStorageLockOfTypeWithHost(host, managedClearStorageLockName, arwen.TimestampLock, keyHandle, 0)
+}
+
+//export v1_4_managedSetStorageLockByNonce
+func v1_4_managedSetStorageLockByNonce(context unsafe.Pointer, keyHandle int32, lockNonce int64) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedSetStorageLockOfTypeWithHost(host, managedSetStorageLockByNonceName, arwen.NonceLock, keyHandle, lockNonce)
+}
+
+//export v1_4_managedGetStorageLockByNonce
+func v1_4_managedGetStorageLockByNonce(context unsafe.Pointer, keyHandle int32) int64 {
+	host := arwen.GetVMHost(context)
+	return ManagedGetStorageLockOfTypeWithHost(host, managedGetStorageLockByNonceName, arwen.NonceLock, keyHandle)
+}
+
+//export v1_4_managedIsStorageLockedByNonce
+func v1_4_managedIsStorageLockedByNonce(context unsafe.Pointer, keyHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedIsStorageLockedOfTypeWithHost(host, managedIsStorageLockedByNonceName, arwen.NonceLock, keyHandle)
+}
+
+//export v1_4_managedClearStorageLockByNonce
+func v1_4_managedClearStorageLockByNonce(context unsafe.Pointer, keyHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedSetStorageLockOfTypeWithHost(host, managedClearStorageLockByNonceName, arwen.NonceLock, keyHandle, 0)
+}
+
+//export v1_4_managedSetStorageLockByRound
+func v1_4_managedSetStorageLockByRound(context unsafe.Pointer, keyHandle int32, lockRound int64) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedSetStorageLockOfTypeWithHost(host, managedSetStorageLockByRoundName, arwen.RoundLock, keyHandle, lockRound)
+}
+
+//export v1_4_managedGetStorageLockByRound
+func v1_4_managedGetStorageLockByRound(context unsafe.Pointer, keyHandle int32) int64 {
+	host := arwen.GetVMHost(context)
+	return ManagedGetStorageLockOfTypeWithHost(host, managedGetStorageLockByRoundName, arwen.RoundLock, keyHandle)
+}
+
+//export v1_4_managedIsStorageLockedByRound
+func v1_4_managedIsStorageLockedByRound(context unsafe.Pointer, keyHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedIsStorageLockedOfTypeWithHost(host, managedIsStorageLockedByRoundName, arwen.RoundLock, keyHandle)
+}
+
+//export v1_4_managedClearStorageLockByRound
+func v1_4_managedClearStorageLockByRound(context unsafe.Pointer, keyHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedSetStorageLockOfTypeWithHost(host, managedClearStorageLockByRoundName, arwen.RoundLock, keyHandle, 0)
+}
+
+//export v1_4_managedGetStorageLocks
+func v1_4_managedGetStorageLocks(context unsafe.Pointer, lockType int32, keysHandle int32) int32 {
+	host := arwen.GetVMHost(context)
+	return ManagedGetStorageLocksWithHost(host, lockType, keysHandle)
+}
@@ -0,0 +1,403 @@
+package elrondapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
+)
+
+// each entry of the lock registry is made of the lock type (1 byte), the
+// deadline (8 bytes), the key length (4 bytes) and the key
+const storageLockEntryHeaderLength = 1 + 8 + 4
+
+type storageLockEntry struct {
+	lockType arwen.StorageLockType
+	deadline int64
+	key      []byte
+}
+
+func storageLockKey(lockType arwen.StorageLockType, key []byte) ([]byte, error) {
+	// the full slice expression prevents CustomStorageKey from writing into the backing array of key
+	key = key[:len(key):len(key)]
+	switch lockType {
+	case arwen.TimestampLock:
+		return arwen.CustomStorageKey(arwen.TimeLockKeyPrefix, key), nil
+	case arwen.NonceLock:
+		return arwen.CustomStorageKey(arwen.NonceLockKeyPrefix, key), nil
+	case arwen.RoundLock:
+		return arwen.CustomStorageKey(arwen.RoundLockKeyPrefix, key), nil
+	default:
+		return nil, arwen.ErrInvalidStorageLockType
+	}
+}
+
+// SetStorageLockOfTypeWithHost - setStorageLock of any type, with the key in the wasm memory
+func SetStorageLockOfTypeWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	keyOffset int32,
+	keyLength int32,
+	deadline int64,
+) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.Int64StorageStore
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+
+	key, err := runtime.MemLoad(keyOffset, keyLength)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return SetStorageLockOfTypeWithTypedArgs(host, tracedFunctionName, lockType, key, deadline)
+}
+
+// ManagedSetStorageLockOfTypeWithHost - setStorageLock of any type, with the key in a managed buffer
+func ManagedSetStorageLockOfTypeWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	keyHandle int32,
+	deadline int64,
+) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.Int64StorageStore
+	metering.UseGasAndAddTracedGas(tracedFunctionName, gasToUse)
+
+	key, err := managedType.GetBytes(keyHandle)
+	if err != nil {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return SetStorageLockOfTypeWithTypedArgs(host, tracedFunctionName, lockType, key, deadline)
+}
+
+// SetStorageLockOfTypeWithTypedArgs sets the deadline of a storage lock of
+// any type and registers the lock, so that it can be enumerated; a deadline
+// of 0 clears the lock. The timestamp locks set through the legacy
+// setStorageLock are not registered.
+func SetStorageLockOfTypeWithTypedArgs(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	key []byte,
+	deadline int64,
+) int32 {
+	runtime := host.Runtime()
+	storage := host.Storage()
+
+	lockKey, err := storageLockKey(lockType, key)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	bigDeadline := big.NewInt(0).SetInt64(deadline)
+	storageStatus, err := storage.SetProtectedStorage(lockKey, bigDeadline.Bytes())
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	err = registerStorageLock(host, tracedFunctionName, lockType, key, deadline)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return int32(storageStatus)
+}
+
+// GetStorageLockOfTypeWithHost - getStorageLock of any type, with the key in the wasm memory
+func GetStorageLockOfTypeWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	keyOffset int32,
+	keyLength int32,
+) int64 {
+	runtime := host.Runtime()
+
+	key, err := runtime.MemLoad(keyOffset, keyLength)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return GetStorageLockOfTypeWithTypedArgs(host, tracedFunctionName, lockType, key)
+}
+
+// ManagedGetStorageLockOfTypeWithHost - getStorageLock of any type, with the key in a managed buffer
+func ManagedGetStorageLockOfTypeWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	keyHandle int32,
+) int64 {
+	runtime := host.Runtime()
+	managedType := host.ManagedTypes()
+
+	key, err := managedType.GetBytes(keyHandle)
+	if err != nil {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return GetStorageLockOfTypeWithTypedArgs(host, tracedFunctionName, lockType, key)
+}
+
+// GetStorageLockOfTypeWithTypedArgs returns the deadline of a storage lock of any type
+func GetStorageLockOfTypeWithTypedArgs(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	key []byte,
+) int64 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	storage := host.Storage()
+
+	lockKey, err := storageLockKey(lockType, key)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	data, usedCache := storage.GetStorage(lockKey)
+	storage.UseGasForStorageLoad(tracedFunctionName, metering.GasSchedule().ElrondAPICost.StorageLoad, usedCache)
+
+	return big.NewInt(0).SetBytes(data).Int64()
+}
+
+// IsStorageLockedOfTypeWithHost - isStorageLocked of any type, with the key in the wasm memory
+func IsStorageLockedOfTypeWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	keyOffset int32,
+	keyLength int32,
+) int32 {
+	runtime := host.Runtime()
+
+	key, err := runtime.MemLoad(keyOffset, keyLength)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return IsStorageLockedOfTypeWithTypedArgs(host, tracedFunctionName, lockType, key)
+}
+
+// ManagedIsStorageLockedOfTypeWithHost - isStorageLocked of any type, with the key in a managed buffer
+func ManagedIsStorageLockedOfTypeWithHost(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	keyHandle int32,
+) int32 {
+	runtime := host.Runtime()
+	managedType := host.ManagedTypes()
+
+	key, err := managedType.GetBytes(keyHandle)
+	if err != nil {
+		_ = arwen.WithFaultAndHost(host, arwen.ErrArgOutOfRange, runtime.ElrondAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return IsStorageLockedOfTypeWithTypedArgs(host, tracedFunctionName, lockType, key)
+}
+
+// IsStorageLockedOfTypeWithTypedArgs returns 1 if the deadline of the storage
+// lock has not been reached yet, 0 if it has, and -1 on error
+func IsStorageLockedOfTypeWithTypedArgs(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	key []byte,
+) int32 {
+	deadline := GetStorageLockOfTypeWithTypedArgs(host, tracedFunctionName, lockType, key)
+	if deadline < 0 {
+		return -1
+	}
+
+	if deadline <= currentStorageLockReference(host, tracedFunctionName, lockType) {
+		return 0
+	}
+
+	return 1
+}
+
+// ManagedGetStorageLocksWithHost writes the keys of the locks of the given
+// type held by the current contract into a managed vec of managed buffers
+// and returns their number, or -1 on error
+func ManagedGetStorageLocksWithHost(host arwen.VMHost, lockType int32, keysHandle int32) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	keys, err := GetStorageLocksWithTypedArgs(host, managedGetStorageLocksName, arwen.StorageLockType(lockType))
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	keysLength := 0
+	for _, key := range keys {
+		keysLength += len(key)
+	}
+	gasToUse := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(keysLength))
+	metering.UseGasAndAddTracedGas(managedGetStorageLocksName, gasToUse)
+
+	managedType.WriteManagedVecOfManagedBuffers(keys, keysHandle)
+	return int32(len(keys))
+}
+
+// GetStorageLocksWithTypedArgs returns the keys of the storage locks of the
+// given type which the current contract still holds
+func GetStorageLocksWithTypedArgs(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+) ([][]byte, error) {
+	_, err := storageLockKey(lockType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := loadStorageLockRegistry(host, tracedFunctionName)
+	reference := currentStorageLockReference(host, tracedFunctionName, lockType)
+
+	keys := make([][]byte, 0)
+	for _, entry := range entries {
+		if entry.lockType == lockType && entry.deadline > reference {
+			keys = append(keys, entry.key)
+		}
+	}
+
+	return keys, nil
+}
+
+// currentStorageLockReference returns the current timestamp, nonce or round,
+// to be compared with the deadline of a lock of the given type
+func currentStorageLockReference(host arwen.VMHost, tracedFunctionName string, lockType arwen.StorageLockType) int64 {
+	metering := host.Metering()
+	gasSchedule := metering.GasSchedule()
+
+	switch lockType {
+	case arwen.NonceLock:
+		metering.UseGasAndAddTracedGas(tracedFunctionName, gasSchedule.ElrondAPICost.GetBlockNonce)
+	case arwen.RoundLock:
+		metering.UseGasAndAddTracedGas(tracedFunctionName, gasSchedule.ElrondAPICost.GetBlockRound)
+	default:
+		metering.UseGasAndAddTracedGas(tracedFunctionName, gasSchedule.ElrondAPICost.GetBlockTimeStamp)
+	}
+
+	return storageLockReference(host, lockType)
+}
+
+func storageLockReference(host arwen.VMHost, lockType arwen.StorageLockType) int64 {
+	blockchain := host.Blockchain()
+
+	switch lockType {
+	case arwen.NonceLock:
+		return int64(blockchain.CurrentNonce())
+	case arwen.RoundLock:
+		return int64(blockchain.CurrentRound())
+	default:
+		return int64(blockchain.CurrentTimeStamp())
+	}
+}
+
+// registerStorageLock adds, updates or removes (for a deadline of 0) the lock
+// in the lock registry; the expired locks are dropped from the registry, so
+// that it only grows with the locks still held
+func registerStorageLock(
+	host arwen.VMHost,
+	tracedFunctionName string,
+	lockType arwen.StorageLockType,
+	key []byte,
+	deadline int64,
+) error {
+	entries := loadStorageLockRegistry(host, tracedFunctionName)
+
+	updatedEntries := make([]*storageLockEntry, 0, len(entries)+1)
+	found := false
+	for _, entry := range entries {
+		if entry.lockType != lockType || !bytes.Equal(entry.key, key) {
+			if !isStorageLockExpired(host, entry.lockType, entry.deadline) {
+				updatedEntries = append(updatedEntries, entry)
+			}
+			continue
+		}
+
+		found = true
+		if !isStorageLockExpired(host, lockType, deadline) {
+			entry.deadline = deadline
+			updatedEntries = append(updatedEntries, entry)
+		}
+	}
+	if !found && !isStorageLockExpired(host, lockType, deadline) {
+		updatedEntries = append(updatedEntries, &storageLockEntry{
+			lockType: lockType,
+			deadline: deadline,
+			key:      append([]byte{}, key...),
+		})
+	}
+
+	_, err := host.Storage().SetProtectedStorage([]byte(arwen.StorageLocksKey), encodeStorageLockRegistry(updatedEntries))
+	return err
+}
+
+// isStorageLockExpired returns true for cleared locks and for the locks whose deadline has passed
+func isStorageLockExpired(host arwen.VMHost, lockType arwen.StorageLockType, deadline int64) bool {
+	return deadline == 0 || deadline <= storageLockReference(host, lockType)
+}
+
+func loadStorageLockRegistry(host arwen.VMHost, tracedFunctionName string) []*storageLockEntry {
+	metering := host.Metering()
+	storage := host.Storage()
+
+	data, usedCache := storage.GetStorage([]byte(arwen.StorageLocksKey))
+	storage.UseGasForStorageLoad(tracedFunctionName, metering.GasSchedule().ElrondAPICost.StorageLoad, usedCache)
+
+	return decodeStorageLockRegistry(data)
+}
+
+func encodeStorageLockRegistry(entries []*storageLockEntry) []byte {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var data []byte
+	for _, entry := range entries {
+		header := make([]byte, storageLockEntryHeaderLength)
+		header[0] = byte(entry.lockType)
+		binary.BigEndian.PutUint64(header[1:9], uint64(entry.deadline))
+		binary.BigEndian.PutUint32(header[9:], uint32(len(entry.key)))
+		data = append(data, header...)
+		data = append(data, entry.key...)
+	}
+	return data
+}
+
+// decodeStorageLockRegistry decodes the lock registry, ignoring a malformed tail
+func decodeStorageLockRegistry(data []byte) []*storageLockEntry {
+	entries := make([]*storageLockEntry, 0)
+	for len(data) >= storageLockEntryHeaderLength {
+		keyLength := uint64(binary.BigEndian.Uint32(data[9:storageLockEntryHeaderLength]))
+		if keyLength > uint64(len(data)-storageLockEntryHeaderLength) {
+			break
+		}
+		entryLength := storageLockEntryHeaderLength + int(keyLength)
+
+		entries = append(entries, &storageLockEntry{
+			lockType: arwen.StorageLockType(data[0]),
+			deadline: int64(binary.BigEndian.Uint64(data[1:9])),
+			key:      append([]byte{}, data[storageLockEntryHeaderLength:entryLength]...),
+		})
+		data = data[entryLength:]
+	}
+	return entries
+}
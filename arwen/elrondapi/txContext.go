@@ -0,0 +1,85 @@
+package elrondapi
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+)
+
+// GetGasPriceWithHost returns the gas price of the current transaction; nested
+// execution contexts receive it only after NestedCallsGasPrice is enabled
+func GetGasPriceWithHost(host arwen.VMHost) int64 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetGasPrice
+	metering.UseGasAndAddTracedGas(getGasPriceName, gasToUse)
+
+	return int64(runtime.GetVMInput().GasPrice)
+}
+
+// GetGasProvidedWithHost returns the gas provided to the current execution
+// context, as opposed to the gas left, which decreases during execution
+func GetGasProvidedWithHost(host arwen.VMHost) int64 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetGasProvided
+	metering.UseGasAndAddTracedGas(getGasProvidedName, gasToUse)
+
+	return int64(runtime.GetVMInput().GasProvided)
+}
+
+// GetCallerNonceWithHost returns the nonce of the caller of the current
+// execution context, or -1 on error
+func GetCallerNonceWithHost(host arwen.VMHost) int64 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	blockchain := host.Blockchain()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetCallerNonce
+	metering.UseGasAndAddTracedGas(getCallerNonceName, gasToUse)
+
+	nonce, err := blockchain.GetNonce(runtime.GetVMInput().CallerAddr)
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return int64(nonce)
+}
+
+// GetOriginalCallerWithHost writes into memory the sender of the original
+// transaction, which, unlike the caller, stays the same in the nested execution
+// contexts; it fails when the VM does not know the original sender, see
+// RuntimeContext.GetOriginalCallerAddr
+func GetOriginalCallerWithHost(host arwen.VMHost, resultOffset int32) {
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetOriginalCaller
+	metering.UseGasAndAddTracedGas(getOriginalCallerName, gasToUse)
+
+	originalCaller, err := runtime.GetOriginalCallerAddr()
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	err = runtime.MemStore(resultOffset, originalCaller)
+	_ = arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution())
+}
+
+// ManagedGetOriginalCallerWithHost writes the sender of the original
+// transaction into a managed buffer
+func ManagedGetOriginalCallerWithHost(host arwen.VMHost, resultHandle int32) {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().ElrondAPICost.GetOriginalCaller
+	metering.UseGasAndAddTracedGas(managedGetOriginalCallerName, gasToUse)
+
+	originalCaller, err := runtime.GetOriginalCallerAddr()
+	if arwen.WithFaultAndHost(host, err, runtime.ElrondAPIErrorShouldFailExecution()) {
+		return
+	}
+
+	managedType.SetBytes(resultHandle, originalCaller)
+}
@@ -116,6 +116,12 @@ var ErrStorageValueOutOfRange = errors.New("storage value out of range")
 // ErrDivZero signals that an attempt to divide by 0 has been made
 var ErrDivZero = errors.New("division by 0")
 
+// ErrNoModularInverse signals that a value has no inverse modulo the given modulus
+var ErrNoModularInverse = errors.New("no modular inverse")
+
+// ErrModPowOperandTooLarge signals that an operand of a modular exponentiation is too large
+var ErrModPowOperandTooLarge = errors.New("modular exponentiation operand too large")
+
 // ErrBitwiseNegative signals that an attempt to apply a bitwise operation on negative numbers has been made
 var ErrBitwiseNegative = errors.New("bitwise operations only allowed on positive integers")
 
@@ -162,6 +168,9 @@ var ErrTransferValueOnESDTCall = errors.New("transfer value on esdt call")
 // ErrNoBigIntUnderThisHandle signals that there is no bigInt for the given handle
 var ErrNoBigIntUnderThisHandle = errors.New("no bigInt under the given handle")
 
+// ErrNoBigFloatUnderThisHandle signals that there is no bigFloat for the given handle
+var ErrNoBigFloatUnderThisHandle = errors.New("no bigFloat under the given handle")
+
 // ErrLengthOfBufferNotCorrect signals that length of the buffer is not correct
 var ErrLengthOfBufferNotCorrect = errors.New("length of buffer is not correct")
 
@@ -174,6 +183,27 @@ var ErrPointNotOnCurve = errors.New("point is not on curve")
 // ErrNoManagedBufferUnderThisHandle signals that there is no buffer for the given handle
 var ErrNoManagedBufferUnderThisHandle = errors.New("no managed buffer under the given handle")
 
+// ErrInvalidManagedVec signals that the length of a managed vec is not a multiple of the length of its items
+var ErrInvalidManagedVec = errors.New("invalid managed vec")
+
+// ErrEmptySeparator signals that a managed buffer was split around an empty separator
+var ErrEmptySeparator = errors.New("empty separator")
+
+// ErrInvalidDecimalString signals that a managed buffer does not hold a valid decimal string
+var ErrInvalidDecimalString = errors.New("invalid decimal string")
+
+// ErrInvalidEncodedData signals that a managed buffer cannot be decoded in the requested format
+var ErrInvalidEncodedData = errors.New("invalid encoded data")
+
+// ErrTooManyManagedHandles signals that the transaction has reached the maximum number of live managed handles
+var ErrTooManyManagedHandles = errors.New("too many live managed handles")
+
+// ErrManagedMemoryExhausted signals that the transaction has reached the maximum number of bytes held by managed types
+var ErrManagedMemoryExhausted = errors.New("managed memory exhausted")
+
+// ErrNoManagedMapUnderThisHandle signals that there is no managed map for the given handle
+var ErrNoManagedMapUnderThisHandle = errors.New("no managed map under the given handle")
+
 // ErrNilHostParameters signals that nil host parameters was provided
 var ErrNilHostParameters = errors.New("nil host parameters")
 
@@ -192,6 +222,12 @@ var ErrTooManyESDTTransfers = errors.New("too many ESDT transfers")
 // ErrNilEpochNotifier signals that epoch notifier is nil
 var ErrNilEpochNotifier = errors.New("nil epoch notifier")
 
+// ErrAccountNotInSelfShard signals that the account info of an address from another shard was requested
+var ErrAccountNotInSelfShard = errors.New("account not in self shard")
+
+// ErrOriginalCallerNotAvailable signals that the sender of the original transaction is not known to the VM
+var ErrOriginalCallerNotAvailable = errors.New("original caller not available")
+
 // ErrVMIsClosing signals that vm is closing
 var ErrVMIsClosing = errors.New("vm is closing")
 
@@ -206,3 +242,6 @@ var ErrInvalidTokenIndex = errors.New("invalid token index")
 
 // ErrInvalidBuiltInFunctionCall signals that built in function was used in the wrong context
 var ErrInvalidBuiltInFunctionCall = errors.New("invalid built in function call")
+
+// ErrInvalidStorageLockType signals that an unknown type of storage lock was requested
+var ErrInvalidStorageLockType = errors.New("invalid storage lock type")
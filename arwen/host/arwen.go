@@ -70,6 +70,66 @@ type vmHost struct {
 
 	useDifferentGasCostForReadingCachedStorageEpoch uint32
 	flagUseDifferentGasCostForCachedStorage         atomic.Flag
+
+	secureRandomnessEnableEpoch uint32
+	flagSecureRandomness        atomic.Flag
+
+	managedMemoryLimitsEnableEpoch uint32
+	flagManagedMemoryLimits        atomic.Flag
+
+	nestedCallsGasPriceEnableEpoch uint32
+	flagNestedCallsGasPrice        atomic.Flag
+
+	fixAsyncCallbackResolutionEnableEpoch uint32
+	flagFixAsyncCallbackResolution        atomic.Flag
+
+	asyncCallESDTPaymentEnableEpoch uint32
+	flagAsyncCallESDTPayment        atomic.Flag
+
+	extendedStorageLocksEnableEpoch uint32
+	flagExtendedStorageLocks        atomic.Flag
+
+	managedMapEnableEpoch uint32
+	flagManagedMap        atomic.Flag
+
+	managedVecEnableEpoch uint32
+	flagManagedVec        atomic.Flag
+
+	bigFloatEnableEpoch uint32
+	flagBigFloat        atomic.Flag
+
+	bigIntModularEnableEpoch uint32
+	flagBigIntModular        atomic.Flag
+
+	managedBufferStringsEnableEpoch uint32
+	flagManagedBufferStrings        atomic.Flag
+
+	managedMemoryFreeEnableEpoch uint32
+	flagManagedMemoryFree        atomic.Flag
+
+	extendedHashingEnableEpoch uint32
+	flagExtendedHashing        atomic.Flag
+
+	secp256ExtensionsEnableEpoch uint32
+	flagSecp256Extensions        atomic.Flag
+
+	aggregatedBLSEnableEpoch uint32
+	flagAggregatedBLS        atomic.Flag
+
+	bn254EnableEpoch uint32
+	flagBN254        atomic.Flag
+
+	ed25519BatchEnableEpoch uint32
+	flagEd25519Batch        atomic.Flag
+
+	merkleProofEnableEpoch uint32
+	flagMerkleProof        atomic.Flag
+
+	accountInfoEnableEpoch uint32
+	flagAccountInfo        atomic.Flag
+
+	txContextEnableEpoch uint32
+	flagTxContext        atomic.Flag
 }
 
 // NewArwenVM creates a new Arwen vmHost
@@ -94,7 +154,11 @@ func NewArwenVM(
 		return nil, arwen.ErrNilEpochNotifier
 	}
 
-	cryptoHook := factory.NewVMCrypto()
+	cryptoHook := hostParameters.VMCrypto
+	if check.IfNil(cryptoHook) {
+		cryptoHook = factory.NewVMCrypto()
+	}
+
 	host := &vmHost{
 		cryptoHook:           cryptoHook,
 		meteringContext:      nil,
@@ -113,6 +177,26 @@ func NewArwenVM(
 		createNFTThroughExecByCallerEnableEpoch:         hostParameters.CreateNFTThroughExecByCallerEnableEpoch,
 		fixFailExecutionOnErrorEnableEpoch:              hostParameters.FixFailExecutionOnErrorEnableEpoch,
 		useDifferentGasCostForReadingCachedStorageEpoch: hostParameters.UseDifferentGasCostForReadingCachedStorageEpoch,
+		secureRandomnessEnableEpoch:                     hostParameters.SecureRandomnessEnableEpoch,
+		managedMemoryLimitsEnableEpoch:                  hostParameters.ManagedMemoryLimitsEnableEpoch,
+		nestedCallsGasPriceEnableEpoch:                  hostParameters.NestedCallsGasPriceEnableEpoch,
+		fixAsyncCallbackResolutionEnableEpoch:           hostParameters.FixAsyncCallbackResolutionEnableEpoch,
+		asyncCallESDTPaymentEnableEpoch:                 hostParameters.AsyncCallESDTPaymentEnableEpoch,
+		extendedStorageLocksEnableEpoch:                 hostParameters.ExtendedStorageLocksEnableEpoch,
+		managedMapEnableEpoch:                           hostParameters.ManagedMapEnableEpoch,
+		managedVecEnableEpoch:                           hostParameters.ManagedVecEnableEpoch,
+		bigFloatEnableEpoch:                             hostParameters.BigFloatEnableEpoch,
+		bigIntModularEnableEpoch:                        hostParameters.BigIntModularEnableEpoch,
+		managedBufferStringsEnableEpoch:                 hostParameters.ManagedBufferStringsEnableEpoch,
+		managedMemoryFreeEnableEpoch:                    hostParameters.ManagedMemoryFreeEnableEpoch,
+		extendedHashingEnableEpoch:                      hostParameters.ExtendedHashingEnableEpoch,
+		secp256ExtensionsEnableEpoch:                    hostParameters.Secp256ExtensionsEnableEpoch,
+		aggregatedBLSEnableEpoch:                        hostParameters.AggregatedBLSEnableEpoch,
+		bn254EnableEpoch:                                hostParameters.BN254EnableEpoch,
+		ed25519BatchEnableEpoch:                         hostParameters.Ed25519BatchEnableEpoch,
+		merkleProofEnableEpoch:                          hostParameters.MerkleProofEnableEpoch,
+		accountInfoEnableEpoch:                          hostParameters.AccountInfoEnableEpoch,
+		txContextEnableEpoch:                            hostParameters.TxContextEnableEpoch,
 	}
 
 	newExecutionTimeout := time.Duration(hostParameters.TimeOutForSCExecutionInMilliseconds) * time.Millisecond
@@ -134,6 +218,11 @@ func NewArwenVM(
 		return nil, err
 	}
 
+	imports, err = elrondapi.BigFloatImports(imports)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = elrondapi.ManagedEIImports(imports)
 	if err != nil {
 		return nil, err
@@ -144,6 +233,26 @@ func NewArwenVM(
 		return nil, err
 	}
 
+	imports, err = elrondapi.ManagedBufferStringImports(imports)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = elrondapi.ManagedMapImports(imports)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = elrondapi.ManagedVecImports(imports)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err = elrondapi.ManagedMemoryImports(imports)
+	if err != nil {
+		return nil, err
+	}
+
 	imports, err = cryptoapi.CryptoImports(imports)
 	if err != nil {
 		return nil, err
@@ -156,10 +265,14 @@ func NewArwenVM(
 
 	host.scAPIMethods = imports
 
-	host.blockchainContext, err = contexts.NewBlockchainContext(host, blockChainHook)
+	blockchainContext, err := contexts.NewBlockchainContext(host, blockChainHook)
 	if err != nil {
 		return nil, err
 	}
+	if !check.IfNil(hostParameters.ShardCoordinator) {
+		blockchainContext.SetShardCoordinator(hostParameters.ShardCoordinator)
+	}
+	host.blockchainContext = blockchainContext
 
 	host.runtimeContext, err = contexts.NewRuntimeContext(
 		host,
@@ -194,7 +307,15 @@ func NewArwenVM(
 		return nil, err
 	}
 
-	host.managedTypesContext, err = contexts.NewManagedTypesContext(host)
+	maxManagedHandles := hostParameters.MaxManagedHandles
+	if maxManagedHandles == 0 {
+		maxManagedHandles = arwen.DefaultMaxManagedHandles
+	}
+	maxManagedBytes := hostParameters.MaxManagedBytes
+	if maxManagedBytes == 0 {
+		maxManagedBytes = arwen.DefaultMaxManagedBytes
+	}
+	host.managedTypesContext, err = contexts.NewManagedTypesContext(host, maxManagedHandles, maxManagedBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -557,6 +678,66 @@ func (host *vmHost) EpochConfirmed(epoch uint32, _ uint64) {
 
 	host.flagUseDifferentGasCostForCachedStorage.SetValue(epoch >= host.useDifferentGasCostForReadingCachedStorageEpoch)
 	log.Debug("Arwen VM: use different gas costs when reading cached storage", "enabled", host.flagUseDifferentGasCostForCachedStorage.IsSet())
+
+	host.flagSecureRandomness.SetValue(epoch >= host.secureRandomnessEnableEpoch)
+	log.Debug("Arwen VM: secure randomness", "enabled", host.flagSecureRandomness.IsSet())
+
+	host.flagManagedMemoryLimits.SetValue(epoch >= host.managedMemoryLimitsEnableEpoch)
+	log.Debug("Arwen VM: managed memory limits", "enabled", host.flagManagedMemoryLimits.IsSet())
+
+	host.flagNestedCallsGasPrice.SetValue(epoch >= host.nestedCallsGasPriceEnableEpoch)
+	log.Debug("Arwen VM: nested calls gas price", "enabled", host.flagNestedCallsGasPrice.IsSet())
+
+	host.flagFixAsyncCallbackResolution.SetValue(epoch >= host.fixAsyncCallbackResolutionEnableEpoch)
+	log.Debug("Arwen VM: fix async callback resolution", "enabled", host.flagFixAsyncCallbackResolution.IsSet())
+
+	host.flagAsyncCallESDTPayment.SetValue(epoch >= host.asyncCallESDTPaymentEnableEpoch)
+	log.Debug("Arwen VM: async call ESDT payment", "enabled", host.flagAsyncCallESDTPayment.IsSet())
+
+	host.flagExtendedStorageLocks.SetValue(epoch >= host.extendedStorageLocksEnableEpoch)
+	log.Debug("Arwen VM: extended storage locks", "enabled", host.flagExtendedStorageLocks.IsSet())
+
+	host.flagManagedMap.SetValue(epoch >= host.managedMapEnableEpoch)
+	log.Debug("Arwen VM: managed map", "enabled", host.flagManagedMap.IsSet())
+
+	host.flagManagedVec.SetValue(epoch >= host.managedVecEnableEpoch)
+	log.Debug("Arwen VM: managed vec", "enabled", host.flagManagedVec.IsSet())
+
+	host.flagBigFloat.SetValue(epoch >= host.bigFloatEnableEpoch)
+	log.Debug("Arwen VM: big float", "enabled", host.flagBigFloat.IsSet())
+
+	host.flagBigIntModular.SetValue(epoch >= host.bigIntModularEnableEpoch)
+	log.Debug("Arwen VM: big int modular arithmetic", "enabled", host.flagBigIntModular.IsSet())
+
+	host.flagManagedBufferStrings.SetValue(epoch >= host.managedBufferStringsEnableEpoch)
+	log.Debug("Arwen VM: managed buffer strings", "enabled", host.flagManagedBufferStrings.IsSet())
+
+	host.flagManagedMemoryFree.SetValue(epoch >= host.managedMemoryFreeEnableEpoch)
+	log.Debug("Arwen VM: managed memory free", "enabled", host.flagManagedMemoryFree.IsSet())
+
+	host.flagExtendedHashing.SetValue(epoch >= host.extendedHashingEnableEpoch)
+	log.Debug("Arwen VM: extended hashing", "enabled", host.flagExtendedHashing.IsSet())
+
+	host.flagSecp256Extensions.SetValue(epoch >= host.secp256ExtensionsEnableEpoch)
+	log.Debug("Arwen VM: secp256 extensions", "enabled", host.flagSecp256Extensions.IsSet())
+
+	host.flagAggregatedBLS.SetValue(epoch >= host.aggregatedBLSEnableEpoch)
+	log.Debug("Arwen VM: aggregated BLS", "enabled", host.flagAggregatedBLS.IsSet())
+
+	host.flagBN254.SetValue(epoch >= host.bn254EnableEpoch)
+	log.Debug("Arwen VM: BN254", "enabled", host.flagBN254.IsSet())
+
+	host.flagEd25519Batch.SetValue(epoch >= host.ed25519BatchEnableEpoch)
+	log.Debug("Arwen VM: ed25519 batch verification", "enabled", host.flagEd25519Batch.IsSet())
+
+	host.flagMerkleProof.SetValue(epoch >= host.merkleProofEnableEpoch)
+	log.Debug("Arwen VM: merkle proof", "enabled", host.flagMerkleProof.IsSet())
+
+	host.flagAccountInfo.SetValue(epoch >= host.accountInfoEnableEpoch)
+	log.Debug("Arwen VM: account info", "enabled", host.flagAccountInfo.IsSet())
+
+	host.flagTxContext.SetValue(epoch >= host.txContextEnableEpoch)
+	log.Debug("Arwen VM: transaction context", "enabled", host.flagTxContext.IsSet())
 }
 
 // FixOOGReturnCodeEnabled returns true if the corresponding flag is set
@@ -574,6 +755,108 @@ func (host *vmHost) CreateNFTOnExecByCallerEnabled() bool {
 	return host.flagCreateNFTThroughExecByCaller.IsSet()
 }
 
+// SecureRandomnessEnabled returns true if the corresponding flag is set. Once
+// set, contracts read per-frame ChaCha20 randomness, so a given transaction no
+// longer reads the random bytes it would have read before the flag.
+func (host *vmHost) SecureRandomnessEnabled() bool {
+	return host.flagSecureRandomness.IsSet()
+}
+
+// AsyncCallESDTPaymentEnabled returns true if the corresponding flag is set
+func (host *vmHost) AsyncCallESDTPaymentEnabled() bool {
+	return host.flagAsyncCallESDTPayment.IsSet()
+}
+
+// ExtendedStorageLocksEnabled returns true if the corresponding flag is set
+func (host *vmHost) ExtendedStorageLocksEnabled() bool {
+	return host.flagExtendedStorageLocks.IsSet()
+}
+
+// ManagedMapEnabled returns true if the corresponding flag is set
+func (host *vmHost) ManagedMapEnabled() bool {
+	return host.flagManagedMap.IsSet()
+}
+
+// ManagedVecEnabled returns true if the corresponding flag is set
+func (host *vmHost) ManagedVecEnabled() bool {
+	return host.flagManagedVec.IsSet()
+}
+
+// BigFloatEnabled returns true if the corresponding flag is set
+func (host *vmHost) BigFloatEnabled() bool {
+	return host.flagBigFloat.IsSet()
+}
+
+// BigIntModularEnabled returns true if the corresponding flag is set
+func (host *vmHost) BigIntModularEnabled() bool {
+	return host.flagBigIntModular.IsSet()
+}
+
+// ManagedBufferStringsEnabled returns true if the corresponding flag is set
+func (host *vmHost) ManagedBufferStringsEnabled() bool {
+	return host.flagManagedBufferStrings.IsSet()
+}
+
+// ManagedMemoryFreeEnabled returns true if the corresponding flag is set
+func (host *vmHost) ManagedMemoryFreeEnabled() bool {
+	return host.flagManagedMemoryFree.IsSet()
+}
+
+// ExtendedHashingEnabled returns true if the corresponding flag is set
+func (host *vmHost) ExtendedHashingEnabled() bool {
+	return host.flagExtendedHashing.IsSet()
+}
+
+// Secp256ExtensionsEnabled returns true if the corresponding flag is set
+func (host *vmHost) Secp256ExtensionsEnabled() bool {
+	return host.flagSecp256Extensions.IsSet()
+}
+
+// AggregatedBLSEnabled returns true if the corresponding flag is set
+func (host *vmHost) AggregatedBLSEnabled() bool {
+	return host.flagAggregatedBLS.IsSet()
+}
+
+// BN254Enabled returns true if the corresponding flag is set
+func (host *vmHost) BN254Enabled() bool {
+	return host.flagBN254.IsSet()
+}
+
+// Ed25519BatchEnabled returns true if the corresponding flag is set
+func (host *vmHost) Ed25519BatchEnabled() bool {
+	return host.flagEd25519Batch.IsSet()
+}
+
+// MerkleProofEnabled returns true if the corresponding flag is set
+func (host *vmHost) MerkleProofEnabled() bool {
+	return host.flagMerkleProof.IsSet()
+}
+
+// AccountInfoEnabled returns true if the corresponding flag is set
+func (host *vmHost) AccountInfoEnabled() bool {
+	return host.flagAccountInfo.IsSet()
+}
+
+// TxContextEnabled returns true if the corresponding flag is set
+func (host *vmHost) TxContextEnabled() bool {
+	return host.flagTxContext.IsSet()
+}
+
+// FixAsyncCallbackResolutionEnabled returns true if the corresponding flag is set
+func (host *vmHost) FixAsyncCallbackResolutionEnabled() bool {
+	return host.flagFixAsyncCallbackResolution.IsSet()
+}
+
+// NestedCallsGasPriceEnabled returns true if the corresponding flag is set
+func (host *vmHost) NestedCallsGasPriceEnabled() bool {
+	return host.flagNestedCallsGasPrice.IsSet()
+}
+
+// ManagedMemoryLimitsEnabled returns true if the corresponding flag is set
+func (host *vmHost) ManagedMemoryLimitsEnabled() bool {
+	return host.flagManagedMemoryLimits.IsSet()
+}
+
 func (host *vmHost) setGasTracerEnabledIfLogIsTrace() {
 	host.Metering().SetGasTracing(false)
 	if logGasTrace.GetLevel() == logger.LogTrace {
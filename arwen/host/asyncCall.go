@@ -280,6 +280,42 @@ func (host *vmHost) sendAsyncCallToDestination(asyncCallInfo arwen.AsyncCallInfo
 	return nil
 }
 
+// sendAsyncGeneratedCallToDestination sends a cross-shard async call; an ESDT
+// payment leaves this shard through the ESDT transfer built-in function, with
+// the call data appended, and it is returned by the destination shard
+// together with the callback if the call fails there
+func (host *vmHost) sendAsyncGeneratedCallToDestination(asyncCall *arwen.AsyncGeneratedCall) error {
+	if !asyncCall.HasESDTTransfers() {
+		return host.sendAsyncCallToDestination(asyncCall)
+	}
+
+	runtime := host.Runtime()
+	output := host.Output()
+
+	callInput, err := host.createDestinationContractCallInput(asyncCall)
+	if err != nil {
+		runtime.FailExecution(err)
+		return err
+	}
+	callInput.GasProvided = asyncCall.GetGasLimit()
+
+	_, err = output.TransferESDT(asyncCall.Destination, runtime.GetSCAddress(), asyncCall.ESDTTransfers, callInput)
+	if err != nil {
+		metering := host.Metering()
+		metering.UseGas(metering.GasLeft())
+		runtime.FailExecution(err)
+		return err
+	}
+
+	// the destination must respond with a callback, for which gas was locked
+	destAcc, _ := output.GetOutputAccount(asyncCall.Destination)
+	outputTransfer := &destAcc.OutputTransfers[len(destAcc.OutputTransfers)-1]
+	outputTransfer.CallType = vm.AsynchronousCall
+	outputTransfer.GasLocked = asyncCall.GetGasLocked()
+
+	return nil
+}
+
 // TODO add locked gas during future refactoring, if needed
 func (host *vmHost) sendCallbackToCurrentCaller() error {
 	runtime := host.Runtime()
@@ -581,7 +617,7 @@ func (host *vmHost) processAsyncInfo(asyncInfo *arwen.AsyncContextInfo) (*arwen.
 	for _, asyncContext := range pendingMapInfo.AsyncContextMap {
 		for _, asyncCall := range asyncContext.AsyncCalls {
 			if !host.canExecuteSynchronously(asyncCall.Destination, asyncCall.Data) {
-				sendErr := host.sendAsyncCallToDestination(asyncCall)
+				sendErr := host.sendAsyncGeneratedCallToDestination(asyncCall)
 				if sendErr != nil {
 					return nil, sendErr
 				}
@@ -593,11 +629,32 @@ func (host *vmHost) processAsyncInfo(asyncInfo *arwen.AsyncContextInfo) (*arwen.
 }
 
 /**
- * processAsyncCall executes an async call and processes the callback if no extra calls are pending
+ * processAsyncCall executes an async call and processes the callback if no extra calls are pending.
+ *  The ESDT payment of the call is transferred before the execution and reverted if the execution fails,
+ *  so that the tokens are back with the caller when its error callback runs
  */
 func (host *vmHost) processAsyncCall(asyncCall *arwen.AsyncGeneratedCall) error {
 	input, _ := host.createDestinationContractCallInput(asyncCall)
+
+	snapshotBeforeTransfer := 0
+	if asyncCall.HasESDTTransfers() {
+		var err error
+		snapshotBeforeTransfer, err = host.transferAsyncCallESDT(asyncCall, input)
+		if err != nil {
+			return err
+		}
+	}
+
 	output, asyncMap, executionError := host.ExecuteOnDestContext(input)
+	if asyncCall.HasESDTTransfers() {
+		host.finishAsyncCallESDTTransfer(asyncCall, output, snapshotBeforeTransfer)
+
+		// a failed execution returns no async context, so nothing can be
+		// pending, and the error callback must see the payment returned
+		if asyncMap == nil {
+			return host.callbackAsync(asyncCall, output, executionError)
+		}
+	}
 
 	pendingMap := host.getPendingAsyncCalls(asyncMap)
 	if len(pendingMap.AsyncContextMap) == 0 {
@@ -607,6 +664,47 @@ func (host *vmHost) processAsyncCall(asyncCall *arwen.AsyncGeneratedCall) error
 	return executionError
 }
 
+/**
+ * transferAsyncCallESDT transfers the ESDT payment of an intra-shard async call through the output context,
+ *  like a synchronous call with ESDT payment would, and returns the blockchain snapshot from before the transfer.
+ *  The output state is pushed before the transfer, so that finishAsyncCallESDTTransfer can undo only the transfer
+ */
+func (host *vmHost) transferAsyncCallESDT(asyncCall *arwen.AsyncGeneratedCall, input *vmcommon.ContractCallInput) (int, error) {
+	blockchain := host.Blockchain()
+	output := host.Output()
+
+	snapshotBeforeTransfer := blockchain.GetSnapshot()
+	output.PushState()
+
+	gasLimitForExec, err := output.TransferESDT(asyncCall.Destination, input.CallerAddr, asyncCall.ESDTTransfers, input)
+	if err != nil {
+		output.PopSetActiveState()
+		blockchain.RevertToSnapshot(snapshotBeforeTransfer)
+		return 0, err
+	}
+
+	input.ESDTTransfers = asyncCall.ESDTTransfers
+	input.GasProvided = gasLimitForExec
+	return snapshotBeforeTransfer, nil
+}
+
+/**
+ * finishAsyncCallESDTTransfer keeps the ESDT payment of a successful async call, or reverts the transfer and
+ *  removes its output transfer and logs if the destination failed; the failed destination itself has already
+ *  been reverted by ExecuteOnDestContext, and the changes made by the caller before the transfer are kept
+ */
+func (host *vmHost) finishAsyncCallESDTTransfer(asyncCall *arwen.AsyncGeneratedCall, vmOutput *vmcommon.VMOutput, snapshotBeforeTransfer int) {
+	output := host.Output()
+	if vmOutput.ReturnCode == vmcommon.Ok {
+		output.PopDiscard()
+		return
+	}
+
+	log.Trace("async call: ESDT payment returned to caller", "dest", asyncCall.Destination)
+	output.PopSetActiveState()
+	host.Blockchain().RevertToSnapshot(snapshotBeforeTransfer)
+}
+
 /**
  * callbackAsync will execute a callback from an async call that was ran on this host and set it's status to resolved or rejected
  */
@@ -629,6 +727,11 @@ func (host *vmHost) callbackAsync(asyncCall *arwen.AsyncGeneratedCall, vmOutput
 		return err
 	}
 
+	// Restore gas locked while still on the caller instance; otherwise, the
+	// locked gas will appear to have been used twice by the caller instance.
+	// Only the calls created by createAsyncCallWithESDT lock gas.
+	host.Metering().RestoreGas(asyncCall.GetGasLocked())
+
 	// Callback omits for now any async call - TODO: take into consideration async calls generated from callbacks
 	callbackVMOutput, _, callBackErr := host.ExecuteOnDestContext(callbackCallInput)
 	err = host.processCallbackVMOutput(callbackVMOutput, callBackErr, vmOutput.ReturnCode, false)
@@ -795,6 +898,11 @@ func (host *vmHost) processCallbackStack() error {
 		return err
 	}
 
+	// A user account has no callback to be resolved
+	if host.FixAsyncCallbackResolutionEnabled() && !host.Blockchain().IsSmartContract(asyncInfo.CallerAddr) {
+		return nil
+	}
+
 	// Now figure out if we can execute the callback here or different shard
 	if !host.canExecuteSynchronously(asyncInfo.CallerAddr, asyncInfo.ReturnData) {
 		err = host.sendStorageCallbackToDestination(asyncInfo.CallerAddr, asyncInfo.ReturnData)
@@ -897,7 +1005,11 @@ func (host *vmHost) getFunctionByCallType(callType vm.CallType) (wasmer.Exported
 		for _, asyncCall := range asyncContext.AsyncCalls {
 			if bytes.Equal(vmInput.CallerAddr, asyncCall.Destination) {
 				customCallback = true
-				runtime.SetCustomCallFunction(asyncCall.SuccessCallback)
+				callbackFunction := asyncCall.SuccessCallback
+				if vmInput.ReturnCallAfterError && host.FixAsyncCallbackResolutionEnabled() {
+					callbackFunction = asyncCall.ErrorCallback
+				}
+				runtime.SetCustomCallFunction(callbackFunction)
 				break
 			}
 		}
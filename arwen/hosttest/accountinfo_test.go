@@ -0,0 +1,205 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var deployedContractAddress = test.MakeTestSCAddress("deployedContract")
+var deployedContractCode = []byte("known contract code")
+
+func runAccountInfoTest(
+	t *testing.T,
+	setup func(world *worldmock.MockWorld),
+	testFunction func(host arwen.VMHost),
+	assertResults func(verify *test.VMOutputVerifier),
+) {
+	buildAccountInfoTest(t, setup, testFunction).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			assertResults(verify)
+		})
+}
+
+func buildAccountInfoTest(
+	t *testing.T,
+	setup func(world *worldmock.MockWorld),
+	testFunction func(host arwen.VMHost),
+) *test.MockInstancesTestTemplate {
+	return test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						testFunction(parentInstance.Host)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1000000).
+			WithFunction("testFunction").
+			Build()).
+		WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {
+			setup(world)
+		})
+}
+
+func finishAccountInfo(host arwen.VMHost, address []byte) {
+	managedType := host.ManagedTypes()
+	addressHandle := managedType.NewManagedBufferFromBytes(address)
+
+	resultHandle := managedType.NewManagedBuffer()
+	elrondapi.ManagedGetCodeHashWithHost(host, addressHandle, resultHandle)
+	finishManagedBuffer(host, resultHandle)
+
+	elrondapi.ManagedGetCodeMetadataWithHost(host, addressHandle, resultHandle)
+	finishManagedBuffer(host, resultHandle)
+
+	elrondapi.ManagedGetAccountOwnerWithHost(host, addressHandle, resultHandle)
+	finishManagedBuffer(host, resultHandle)
+
+	elrondapi.ManagedGetAccountUsernameWithHost(host, addressHandle, resultHandle)
+	finishManagedBuffer(host, resultHandle)
+
+	nonce := elrondapi.ManagedGetAccountNonceWithHost(host, addressHandle)
+	finishInt64(host.Output(), nonce)
+}
+
+func TestAccountInfo_DeployedContract(t *testing.T) {
+	var expectedCodeHash []byte
+	runAccountInfoTest(t,
+		func(world *worldmock.MockWorld) {
+			account := world.AcctMap.CreateSmartContractAccount(test.UserAddress, deployedContractAddress, nil, world)
+			account.SetCodeAndMetadata(deployedContractCode, &vmcommon.CodeMetadata{Upgradeable: true, Readable: true})
+			account.Nonce = 3
+			expectedCodeHash = account.CodeHash
+		},
+		func(host arwen.VMHost) {
+			finishAccountInfo(host, deployedContractAddress)
+		},
+		func(verify *test.VMOutputVerifier) {
+			metadata := vmcommon.CodeMetadata{Upgradeable: true, Readable: true}
+			verify.Ok().
+				ReturnData(expectedCodeHash, metadata.ToBytes(), test.UserAddress, []byte{}, []byte{3})
+		})
+}
+
+func TestAccountInfo_UserAccount(t *testing.T) {
+	runAccountInfoTest(t,
+		func(world *worldmock.MockWorld) {
+			account := world.AcctMap.CreateAccount(test.UserAddress, world)
+			account.Username = []byte("alice.elrond")
+			account.Nonce = 42
+		},
+		func(host arwen.VMHost) {
+			finishAccountInfo(host, test.UserAddress)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{}, []byte{}, []byte{}, []byte("alice.elrond"), []byte{42})
+		})
+}
+
+func TestAccountInfo_MissingAccount(t *testing.T) {
+	runAccountInfoTest(t,
+		func(world *worldmock.MockWorld) {},
+		func(host arwen.VMHost) {
+			finishAccountInfo(host, test.MakeTestSCAddress("missing"))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{}, []byte{}, []byte{}, []byte{}, []byte{})
+		})
+}
+
+func TestAccountInfo_InvalidAddressHandle(t *testing.T) {
+	runAccountInfoTest(t,
+		func(world *worldmock.MockWorld) {},
+		func(host arwen.VMHost) {
+			elrondapi.ManagedGetCodeHashWithHost(host, 123, host.ManagedTypes().NewManagedBuffer())
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(arwen.ErrNoManagedBufferUnderThisHandle.Error())
+		})
+}
+
+func TestAccountInfo_OtherShardAccount(t *testing.T) {
+	runAccountInfoTest(t,
+		func(world *worldmock.MockWorld) {
+			account := world.AcctMap.CreateAccount(test.UserAddress, world)
+			account.ShardID = 2
+			account.Nonce = 42
+		},
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			addressHandle := managedType.NewManagedBufferFromBytes(test.UserAddress)
+			elrondapi.ManagedGetCodeHashWithHost(host, addressHandle, managedType.NewManagedBuffer())
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(arwen.ErrAccountNotInSelfShard.Error())
+		})
+}
+
+func TestAccountInfo_OtherShardAccountNonce(t *testing.T) {
+	runAccountInfoTest(t,
+		func(world *worldmock.MockWorld) {
+			account := world.AcctMap.CreateAccount(test.UserAddress, world)
+			account.ShardID = 2
+			account.Nonce = 42
+		},
+		func(host arwen.VMHost) {
+			addressHandle := host.ManagedTypes().NewManagedBufferFromBytes(test.UserAddress)
+			finishInt64(host.Output(), elrondapi.ManagedGetAccountNonceWithHost(host, addressHandle))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(arwen.ErrAccountNotInSelfShard.Error())
+		})
+}
+
+func TestAccountInfo_ShardInfo(t *testing.T) {
+	shardCoordinator := worldmock.NewMockWorld()
+	shardCoordinator.SelfShardID = 1
+	shardCoordinator.AcctMap.CreateAccount(test.UserAddress, shardCoordinator).ShardID = 2
+
+	buildAccountInfoTest(t,
+		func(world *worldmock.MockWorld) {},
+		func(host arwen.VMHost) {
+			finishInt64(host.Output(), int64(elrondapi.GetNumberOfShardsWithHost(host)))
+			finishInt64(host.Output(), int64(elrondapi.GetSelfShardIDWithHost(host)))
+		}).
+		WithEnableEpochs(func(parameters *arwen.VMHostParameters) {
+			parameters.ShardCoordinator = shardCoordinator
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(big.NewInt(3).Bytes(), big.NewInt(1).Bytes())
+		})
+}
+
+func TestAccountInfo_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"getNumberOfShards",
+			"getSelfShardID",
+			"managedGetCodeHash",
+			"managedGetCodeMetadata",
+			"managedGetAccountNonce",
+			"managedGetAccountOwner",
+			"managedGetAccountUsername",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.AccountInfoEnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -0,0 +1,131 @@
+package hosttest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/stretchr/testify/require"
+)
+
+var asyncCallbackTxHash = []byte("async callback tx hash")
+
+func disableFixAsyncCallbackResolution(parameters *arwen.VMHostParameters) {
+	parameters.FixAsyncCallbackResolutionEnableEpoch = test.UnreachedEpochForTests
+}
+
+// runAsyncCallbackTest executes, on the shard of the parent contract, the callback
+// of an async call which the parent made to the child on another shard, on
+// behalf of the user who sent the original transaction
+func runAsyncCallbackTest(
+	t *testing.T,
+	returnCallAfterError bool,
+	otherCallPending bool,
+	setEnableEpochs func(*arwen.VMHostParameters),
+	assertResults func(verify *test.VMOutputVerifier),
+) {
+	asyncCalls := []*arwen.AsyncGeneratedCall{
+		{
+			Status:          arwen.AsyncCallPending,
+			Destination:     test.ChildAddress,
+			Data:            []byte("childFunction"),
+			SuccessCallback: "onSuccess",
+			ErrorCallback:   "onError",
+		},
+	}
+	if otherCallPending {
+		asyncCalls = append(asyncCalls, &arwen.AsyncGeneratedCall{
+			Status:          arwen.AsyncCallPending,
+			Destination:     test.ThirdPartyAddress,
+			Data:            []byte("nephewFunction"),
+			SuccessCallback: "onSuccess",
+			ErrorCallback:   "onError",
+		})
+	}
+	asyncInfo := &arwen.AsyncContextInfo{
+		CallerAddr: test.UserAddress,
+		AsyncContextMap: map[string]*arwen.AsyncContext{
+			"context": {AsyncCalls: asyncCalls},
+		},
+	}
+	asyncInfoBytes, err := json.Marshal(asyncInfo)
+	require.Nil(t, err)
+
+	input := test.CreateTestContractCallInputBuilder().
+		WithCallerAddr(test.ChildAddress).
+		WithRecipientAddr(test.ParentAddress).
+		WithCallType(vm.AsynchronousCallBack).
+		WithOriginalTxHash(asyncCallbackTxHash).
+		WithGasProvided(100000).
+		WithFunction(arwen.CallbackFunctionName).
+		Build()
+	input.ReturnCallAfterError = returnCallAfterError
+
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("onSuccess", func() *mock.InstanceMock {
+						parentInstance.Host.Output().Finish([]byte("success callback"))
+						return parentInstance
+					})
+					parentInstance.AddMockMethod("onError", func() *mock.InstanceMock {
+						parentInstance.Host.Output().Finish([]byte("error callback"))
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(input).
+		WithEnableEpochs(setEnableEpochs).
+		WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {
+			world.AcctMap.CreateAccount(test.UserAddress, world)
+			asyncDataKey := arwen.CustomStorageKey(arwen.AsyncDataPrefix, asyncCallbackTxHash)
+			world.AcctMap.GetAccount(test.ParentAddress).Storage[string(asyncDataKey)] = asyncInfoBytes
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			assertResults(verify)
+		})
+}
+
+func requireNoOutputTransfersTo(t *testing.T, verify *test.VMOutputVerifier, address []byte) {
+	outputAccount, ok := verify.VmOutput.OutputAccounts[string(address)]
+	if ok {
+		require.Empty(t, outputAccount.OutputTransfers)
+	}
+}
+
+func TestAsyncCallbacks_ErrorCallbackAfterError(t *testing.T) {
+	runAsyncCallbackTest(t, true, true, nil, func(verify *test.VMOutputVerifier) {
+		verify.Ok().
+			ReturnData([]byte("error callback"))
+	})
+}
+
+func TestAsyncCallbacks_ErrorCallbackAfterError_BeforeEnableEpoch(t *testing.T) {
+	runAsyncCallbackTest(t, true, true, disableFixAsyncCallbackResolution, func(verify *test.VMOutputVerifier) {
+		verify.Ok().
+			ReturnData([]byte("success callback"))
+	})
+}
+
+func TestAsyncCallbacks_ResolvedForUser(t *testing.T) {
+	runAsyncCallbackTest(t, false, false, nil, func(verify *test.VMOutputVerifier) {
+		verify.Ok().
+			ReturnData([]byte("success callback"))
+		requireNoOutputTransfersTo(t, verify, test.UserAddress)
+	})
+}
+
+func TestAsyncCallbacks_ResolvedForUser_BeforeEnableEpoch(t *testing.T) {
+	runAsyncCallbackTest(t, false, false, disableFixAsyncCallbackResolution, func(verify *test.VMOutputVerifier) {
+		// the resolved async context is sent back to the user as a callback,
+		// with all the gas left, which fails the execution
+		verify.ExecutionFailed().
+			ReturnMessage("input and output gas does not match")
+	})
+}
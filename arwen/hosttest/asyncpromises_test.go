@@ -0,0 +1,224 @@
+package hosttest
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/txDataBuilder"
+	"github.com/stretchr/testify/require"
+)
+
+var promiseCrossShardAddress = test.MakeTestSCAddress("crossShardSC")
+
+const promiseInitialESDTBalance = uint64(100)
+const promiseESDTValue = uint64(30)
+const promiseGasProvided = 1000000
+const promiseGasForAsyncCall = 200000
+
+func createPromiseWithESDT(host arwen.VMHost, destination []byte, value int64) {
+	managedType := host.ManagedTypes()
+
+	transfer := make([]byte, 16)
+	tokenHandle := managedType.NewManagedBufferFromBytes(test.ESDTTestTokenName)
+	valueHandle := managedType.NewBigIntFromInt64(int64(promiseESDTValue))
+	binary.BigEndian.PutUint32(transfer[0:4], uint32(tokenHandle))
+	binary.BigEndian.PutUint32(transfer[12:16], uint32(valueHandle))
+
+	elrondapi.ManagedCreateAsyncCallWithHost(
+		host,
+		managedType.NewManagedBufferFromBytes([]byte("promises")),
+		managedType.NewManagedBufferFromBytes(destination),
+		managedType.NewBigIntFromInt64(value),
+		managedType.NewManagedBufferFromBytes(transfer),
+		managedType.NewManagedBufferFromBytes([]byte("receiveTokens")),
+		managedType.NewManagedBuffer(),
+		managedType.NewManagedBufferFromBytes([]byte("onSuccess")),
+		managedType.NewManagedBufferFromBytes([]byte("onError")),
+		promiseGasForAsyncCall)
+}
+
+func runPromiseWithESDTTest(
+	t *testing.T,
+	destination []byte,
+	value int64,
+	childFails bool,
+	beforePromise func(host arwen.VMHost),
+	assertResults func(world *worldmock.MockWorld, verify *test.VMOutputVerifier),
+) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						if beforePromise != nil {
+							beforePromise(parentInstance.Host)
+						}
+						createPromiseWithESDT(parentInstance.Host, destination, value)
+						return parentInstance
+					})
+					parentInstance.AddMockMethod("onSuccess", func() *mock.InstanceMock {
+						parentInstance.Host.Output().Finish([]byte("success callback"))
+						return parentInstance
+					})
+					parentInstance.AddMockMethod("onError", func() *mock.InstanceMock {
+						parentInstance.Host.Output().Finish([]byte("error callback"))
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(0).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("receiveTokens", func() *mock.InstanceMock {
+						host := childInstance.Host
+						if childFails {
+							host.Runtime().FailExecution(nil)
+							return childInstance
+						}
+						host.Output().Finish(host.Runtime().GetVMInput().ESDTTransfers[0].ESDTValue.Bytes())
+						return childInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(promiseGasProvided).
+			WithFunction("testFunction").
+			Build()).
+		WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {
+			parentAccount := world.AcctMap.GetAccount(test.ParentAddress)
+			_ = parentAccount.SetTokenBalanceUint64(test.ESDTTestTokenName, 0, promiseInitialESDTBalance)
+			world.AcctMap.CreateAccount(test.UserAddress, world)
+			crossShardAccount := world.AcctMap.CreateSmartContractAccount(test.UserAddress, promiseCrossShardAddress, nil, world)
+			crossShardAccount.ShardID = 1
+			createMockBuiltinFunctions(t, host, world)
+			setZeroCodeCosts(host)
+		}).
+		AndAssertResults(assertResults)
+}
+
+func requireESDTBalance(t *testing.T, world *worldmock.MockWorld, address []byte, expected uint64) {
+	balance, _ := world.AcctMap.GetAccount(address).GetTokenBalanceUint64(test.ESDTTestTokenName, 0)
+	require.Equal(t, expected, balance)
+}
+
+func TestAsyncPromises_ESDTPayment_IntraShard_Success(t *testing.T) {
+	runPromiseWithESDTTest(t, test.ChildAddress, 0, false, nil,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					big.NewInt(int64(promiseESDTValue)).Bytes(),
+					[]byte("success callback"),
+				)
+
+			requireESDTBalance(t, world, test.ParentAddress, promiseInitialESDTBalance-promiseESDTValue)
+			requireESDTBalance(t, world, test.ChildAddress, promiseESDTValue)
+
+			expectedData := txDataBuilder.NewBuilder()
+			expectedData.Func(core.BuiltInFunctionESDTTransfer).
+				Bytes(test.ESDTTestTokenName).
+				Int64(int64(promiseESDTValue)).
+				Str("receiveTokens")
+
+			outputAccount := verify.VmOutput.OutputAccounts[string(test.ChildAddress)]
+			require.NotNil(t, outputAccount)
+			require.Len(t, outputAccount.OutputTransfers, 1)
+			require.Equal(t, expectedData.ToBytes(), outputAccount.OutputTransfers[0].Data)
+			require.Equal(t, vm.DirectCall, outputAccount.OutputTransfers[0].CallType)
+			require.Zero(t, outputAccount.OutputTransfers[0].GasLimit)
+		})
+}
+
+func TestAsyncPromises_ESDTPayment_IntraShard_ErrorCallbackRefund(t *testing.T) {
+	runPromiseWithESDTTest(t, test.ChildAddress, 0, true, nil,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte("error callback"))
+
+			requireESDTBalance(t, world, test.ParentAddress, promiseInitialESDTBalance)
+			requireESDTBalance(t, world, test.ChildAddress, 0)
+		})
+}
+
+func TestAsyncPromises_ESDTPayment_IntraShard_FailureKeepsEarlierWrites(t *testing.T) {
+	earlierESDTValue := uint64(5)
+	writeAndTransferBeforePromise := func(host arwen.VMHost) {
+		_, err := host.Storage().SetStorage([]byte("key"), []byte("value"))
+		require.Nil(t, err)
+
+		transfer := &vmcommon.ESDTTransfer{
+			ESDTValue:     big.NewInt(0).SetUint64(earlierESDTValue),
+			ESDTTokenName: test.ESDTTestTokenName,
+			ESDTTokenType: uint32(core.Fungible),
+		}
+		_, err = host.Output().TransferESDT(test.UserAddress, test.ParentAddress, []*vmcommon.ESDTTransfer{transfer}, nil)
+		require.Nil(t, err)
+	}
+
+	runPromiseWithESDTTest(t, test.ChildAddress, 0, true, writeAndTransferBeforePromise,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte("error callback")).
+				Storage(test.CreateStoreEntry(test.ParentAddress).WithKey([]byte("key")).WithValue([]byte("value")))
+
+			requireESDTBalance(t, world, test.ParentAddress, promiseInitialESDTBalance-earlierESDTValue)
+			requireESDTBalance(t, world, test.UserAddress, earlierESDTValue)
+			requireESDTBalance(t, world, test.ChildAddress, 0)
+
+			// only the earlier transfer is in the output, the reverted payment is not
+			require.Len(t, verify.VmOutput.OutputAccounts[string(test.UserAddress)].OutputTransfers, 1)
+			childAccount, ok := verify.VmOutput.OutputAccounts[string(test.ChildAddress)]
+			if ok {
+				require.Empty(t, childAccount.OutputTransfers)
+			}
+		})
+}
+
+func TestAsyncPromises_ESDTPayment_CrossShard(t *testing.T) {
+	runPromiseWithESDTTest(t, promiseCrossShardAddress, 0, false, nil,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+
+			expectedData := txDataBuilder.NewBuilder()
+			expectedData.Func(core.BuiltInFunctionESDTTransfer).
+				Bytes(test.ESDTTestTokenName).
+				Int64(int64(promiseESDTValue)).
+				Str("receiveTokens")
+
+			outputAccount := verify.VmOutput.OutputAccounts[string(promiseCrossShardAddress)]
+			require.NotNil(t, outputAccount)
+			require.Len(t, outputAccount.OutputTransfers, 1)
+			require.Equal(t, expectedData.ToBytes(), outputAccount.OutputTransfers[0].Data)
+			require.Equal(t, vm.AsynchronousCall, outputAccount.OutputTransfers[0].CallType)
+			require.NotZero(t, outputAccount.OutputTransfers[0].GasLocked)
+
+			requireESDTBalance(t, world, test.ParentAddress, promiseInitialESDTBalance-promiseESDTValue)
+		})
+}
+
+func TestAsyncPromises_ESDTPayment_WithValue(t *testing.T) {
+	runPromiseWithESDTTest(t, test.ChildAddress, 10, false, nil,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ReturnCode(vmcommon.ExecutionFailed).
+				ReturnMessage(arwen.ErrTransferValueOnESDTCall.Error())
+
+			requireESDTBalance(t, world, test.ParentAddress, promiseInitialESDTBalance)
+		})
+}
+
+func TestAsyncPromises_ESDTPayment_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{"createAsyncCallWithESDT", "managedCreateAsyncCall"},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.AsyncCallESDTPaymentEnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -0,0 +1,172 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+)
+
+func TestBigFloat_InterestCalculation(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						// 1000000 * (1 + 0.05/12)^12
+						principal := managedType.NewBigIntFromInt64(1000000)
+						amount := elrondapi.BigFloatNewFromPartsWithHost(host, 0, 0, 0)
+						elrondapi.BigFloatSetBigIntWithHost(host, amount, principal)
+
+						rate := elrondapi.BigFloatNewFromPartsWithHost(host, 0, 5, -2)
+						months := elrondapi.BigFloatNewFromPartsWithHost(host, 12, 0, 0)
+						one := elrondapi.BigFloatNewFromPartsWithHost(host, 1, 0, 0)
+						factor := elrondapi.BigFloatNewFromPartsWithHost(host, 0, 0, 0)
+						elrondapi.BigFloatDivWithHost(host, factor, rate, months)
+						elrondapi.BigFloatAddWithHost(host, factor, factor, one)
+						elrondapi.BigFloatPowWithHost(host, factor, factor, 12)
+						elrondapi.BigFloatMulWithHost(host, amount, amount, factor)
+
+						result := managedType.NewBigIntFromInt64(0)
+						elrondapi.BigFloatFloorWithHost(host, amount, result)
+						output.Finish(managedType.GetBigIntOrCreate(result).Bytes())
+						elrondapi.BigFloatCeilWithHost(host, amount, result)
+						output.Finish(managedType.GetBigIntOrCreate(result).Bytes())
+
+						// round trip through a managed buffer
+						encoded := managedType.NewManagedBuffer()
+						elrondapi.ManagedBufferFromBigFloatWithHost(host, encoded, amount)
+						decoded := elrondapi.BigFloatNewFromPartsWithHost(host, 0, 0, 0)
+						elrondapi.ManagedBufferToBigFloatWithHost(host, encoded, decoded)
+						finishInt64(output, int64(elrondapi.BigFloatCmpWithHost(host, amount, decoded)))
+
+						elrondapi.BigFloatNegWithHost(host, decoded, decoded)
+						finishInt64(output, int64(elrondapi.BigFloatCmpWithHost(host, decoded, amount)))
+						elrondapi.BigFloatAbsWithHost(host, decoded, decoded)
+						finishInt64(output, int64(elrondapi.BigFloatCmpWithHost(host, amount, decoded)))
+
+						two := elrondapi.BigFloatNewFromPartsWithHost(host, 2, 0, 0)
+						elrondapi.BigFloatSqrtWithHost(host, two, two)
+						elrondapi.BigFloatSubWithHost(host, two, two, one)
+						elrondapi.BigFloatTruncateWithHost(host, two, result)
+						output.Finish(managedType.GetBigIntOrCreate(result).Bytes())
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			expected := expectedCompoundInterest()
+			verify.Ok().
+				ReturnData(
+					math.BigFloatFloor(expected).Bytes(),
+					math.BigFloatCeil(expected).Bytes(),
+					[]byte{},
+					big.NewInt(-1).Bytes(),
+					[]byte{},
+					[]byte{},
+				)
+		})
+}
+
+func TestBigFloat_DivisionByZero(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						one := elrondapi.BigFloatNewFromPartsWithHost(host, 1, 0, 0)
+						zero := elrondapi.BigFloatNewFromPartsWithHost(host, 0, 0, 0)
+						elrondapi.BigFloatDivWithHost(host, one, one, zero)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(math.ErrBigFloatDivisionByZero.Error())
+		})
+}
+
+func TestBigFloat_MissingHandle(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						elrondapi.BigFloatSqrtWithHost(host, 0, 42)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(arwen.ErrNoBigFloatUnderThisHandle.Error())
+		})
+}
+
+func expectedCompoundInterest() *big.Float {
+	rate, _ := math.BigFloatFromParts(0, 5, -2)
+	months, _ := math.BigFloatFromParts(12, 0, 0)
+	one, _ := math.BigFloatFromParts(1, 0, 0)
+	factor, _ := math.BigFloatDiv(rate, months)
+	factor, _ = math.BigFloatAdd(factor, one)
+	factor, _, _ = math.BigFloatPow(factor, 12)
+	amount, _ := math.BigFloatFromBigInt(big.NewInt(1000000))
+	amount, _ = math.BigFloatMul(amount, factor)
+	return amount
+}
+
+func TestBigFloat_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"bigFloatNewFromParts",
+			"bigFloatAdd",
+			"bigFloatSub",
+			"bigFloatMul",
+			"bigFloatDiv",
+			"bigFloatNeg",
+			"bigFloatAbs",
+			"bigFloatCmp",
+			"bigFloatSqrt",
+			"bigFloatPow",
+			"bigFloatTruncate",
+			"bigFloatFloor",
+			"bigFloatCeil",
+			"bigFloatSetBigInt",
+			"mBufferToBigFloat",
+			"mBufferFromBigFloat",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.BigFloatEnableEpoch = test.UnreachedEpochForTests
+		})
+}
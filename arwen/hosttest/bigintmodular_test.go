@@ -0,0 +1,222 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+)
+
+func TestBigIntModular_RSARoundTrip(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						// p = 61, q = 53
+						modulus := managedType.NewBigIntFromInt64(3233)
+						totient := managedType.NewBigIntFromInt64(3120)
+						publicExponent := managedType.NewBigIntFromInt64(17)
+						message := managedType.NewBigIntFromInt64(65)
+
+						gcd := managedType.NewBigIntFromInt64(0)
+						elrondapi.BigIntGCDWithHost(host, gcd, publicExponent, totient)
+						output.Finish(managedType.GetBigIntOrCreate(gcd).Bytes())
+
+						privateExponent := managedType.NewBigIntFromInt64(0)
+						elrondapi.BigIntModInverseWithHost(host, privateExponent, publicExponent, totient)
+						output.Finish(managedType.GetBigIntOrCreate(privateExponent).Bytes())
+
+						cipher := managedType.NewBigIntFromInt64(0)
+						elrondapi.BigIntModPowWithHost(host, cipher, message, publicExponent, modulus)
+						output.Finish(managedType.GetBigIntOrCreate(cipher).Bytes())
+
+						decrypted := managedType.NewBigIntFromInt64(0)
+						elrondapi.BigIntModPowWithHost(host, decrypted, cipher, privateExponent, modulus)
+						output.Finish(managedType.GetBigIntOrCreate(decrypted).Bytes())
+
+						// the managed equivalents work on unsigned big endian buffers
+						managedModulus := managedType.NewManagedBufferFromBytes(big.NewInt(3233).Bytes())
+						managedExponent := managedType.NewManagedBufferFromBytes(big.NewInt(2753).Bytes())
+						managedCipher := managedType.NewManagedBufferFromBytes(big.NewInt(2790).Bytes())
+						managedResult := managedType.NewManagedBuffer()
+						elrondapi.ManagedModPowWithHost(host, managedCipher, managedExponent, managedModulus, managedResult)
+						finishManagedBuffer(host, managedResult)
+
+						elrondapi.ManagedModInverseWithHost(host, managedCipher, managedModulus, managedResult)
+						finishManagedBuffer(host, managedResult)
+
+						elrondapi.ManagedGCDWithHost(host, managedModulus, managedCipher, managedResult)
+						finishManagedBuffer(host, managedResult)
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					big.NewInt(1).Bytes(),
+					big.NewInt(2753).Bytes(),
+					big.NewInt(2790).Bytes(),
+					big.NewInt(65).Bytes(),
+					big.NewInt(65).Bytes(),
+					big.NewInt(0).ModInverse(big.NewInt(2790), big.NewInt(3233)).Bytes(),
+					big.NewInt(1).Bytes(),
+				)
+		})
+}
+
+func TestBigIntModular_ExtendedGCDWithNegativeOperands(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						a := managedType.NewBigIntFromInt64(-240)
+						b := managedType.NewBigIntFromInt64(46)
+						gcd := managedType.NewBigIntFromInt64(0)
+						x := managedType.NewBigIntFromInt64(0)
+						y := managedType.NewBigIntFromInt64(0)
+						elrondapi.BigIntExtendedGCDWithHost(host, gcd, x, y, a, b)
+
+						// g = a*x + b*y
+						combination := big.NewInt(0).Mul(managedType.GetBigIntOrCreate(a), managedType.GetBigIntOrCreate(x))
+						combination.Add(combination, big.NewInt(0).Mul(managedType.GetBigIntOrCreate(b), managedType.GetBigIntOrCreate(y)))
+						output.Finish(managedType.GetBigIntOrCreate(gcd).Bytes())
+						output.Finish(combination.Bytes())
+
+						// the inverse is normalized into [0, modulus)
+						inverse := managedType.NewBigIntFromInt64(0)
+						elrondapi.BigIntModInverseWithHost(host, inverse, managedType.NewBigIntFromInt64(-3), managedType.NewBigIntFromInt64(7))
+						output.Finish(managedType.GetBigIntOrCreate(inverse).Bytes())
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					big.NewInt(2).Bytes(),
+					big.NewInt(2).Bytes(),
+					big.NewInt(2).Bytes(),
+				)
+		})
+}
+
+func TestBigIntModular_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		call     func(host arwen.VMHost)
+		expected error
+	}{
+		{
+			name: "no inverse",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				dest := managedType.NewBigIntFromInt64(0)
+				elrondapi.BigIntModInverseWithHost(host, dest, managedType.NewBigIntFromInt64(6), managedType.NewBigIntFromInt64(9))
+			},
+			expected: arwen.ErrNoModularInverse,
+		},
+		{
+			name: "zero modulus",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				dest := managedType.NewBigIntFromInt64(0)
+				two := managedType.NewBigIntFromInt64(2)
+				elrondapi.BigIntModPowWithHost(host, dest, two, two, managedType.NewBigIntFromInt64(0))
+			},
+			expected: arwen.ErrDivZero,
+		},
+		{
+			name: "negative exponent",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				dest := managedType.NewBigIntFromInt64(0)
+				two := managedType.NewBigIntFromInt64(2)
+				elrondapi.BigIntModPowWithHost(host, dest, two, managedType.NewBigIntFromInt64(-1), managedType.NewBigIntFromInt64(5))
+			},
+			expected: arwen.ErrBadLowerBounds,
+		},
+		{
+			name: "modulus too large",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				dest := managedType.NewBigIntFromInt64(0)
+				two := managedType.NewBigIntFromInt64(2)
+				modulus := big.NewInt(0).Lsh(big.NewInt(1), 1024*8)
+				elrondapi.BigIntModPowWithHost(host, dest, two, two, managedType.NewBigInt(modulus))
+			},
+			expected: arwen.ErrModPowOperandTooLarge,
+		},
+	}
+
+	for _, testCase := range testCases {
+		call := testCase.call
+		expected := testCase.expected
+		t.Run(testCase.name, func(t *testing.T) {
+			test.BuildMockInstanceCallTest(t).
+				WithContracts(
+					test.CreateMockContract(test.ParentAddress).
+						WithBalance(1000).
+						WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+							parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+								call(parentInstance.Host)
+								return parentInstance
+							})
+						}),
+				).
+				WithInput(test.CreateTestContractCallInputBuilder().
+					WithRecipientAddr(test.ParentAddress).
+					WithGasProvided(100000).
+					WithFunction("testFunction").
+					Build()).
+				AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+					verify.ExecutionFailed().
+						HasRuntimeErrors(expected.Error())
+				})
+		})
+	}
+}
+
+func TestBigIntModular_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"bigIntModPow",
+			"bigIntModInverse",
+			"bigIntGCD",
+			"bigIntExtendedGCD",
+			"managedModPow",
+			"managedModInverse",
+			"managedGCD",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.BigIntModularEnableEpoch = test.UnreachedEpochForTests
+		})
+}
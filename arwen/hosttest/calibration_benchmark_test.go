@@ -0,0 +1,132 @@
+package hosttest
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/calibration"
+	arwenHost "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/host"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/mock"
+	gasSchedules "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwenmandos/gasSchedules"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	testcommon "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/require"
+)
+
+// calibrationGasPerNanosecond is the target used to scale the measured times into gas costs
+const calibrationGasPerNanosecond = 1.0
+
+// calibrationOutlierRatio is the factor above which a current cost is reported as an outlier
+const calibrationOutlierRatio = 4.0
+
+func Test_RunGasCalibration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("not a short test")
+	}
+
+	runGasCalibration(t, 10000, 5)
+}
+
+func runGasCalibration(tb testing.TB, iterations int32, repetitions int) {
+	gasMap, err := gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
+	require.Nil(tb, err)
+
+	host, mockWorld := createCalibrationHost(tb, gasMap)
+	defer func() {
+		_ = host.Close()
+	}()
+
+	runner, err := calibration.NewRunner(host, mockWorld, iterations, repetitions)
+	require.Nil(tb, err)
+
+	baseline, err := runner.MeasureBaseline()
+	require.Nil(tb, err)
+
+	measurements, err := runner.MeasureAll(calibration.AllCases())
+	require.Nil(tb, err)
+
+	for _, measurement := range measurements {
+		fmt.Printf("%-50s %10.2f ns\n", measurement.Case.Name, measurement.NanosecondsPerEntry(baseline))
+	}
+
+	proposal, err := calibration.ProposeGasSchedule(gasMap, baseline, measurements, calibrationGasPerNanosecond, calibrationOutlierRatio)
+	require.Nil(tb, err)
+
+	_, err = config.CreateGasConfig(proposal.GasSchedule)
+	require.Nil(tb, err)
+
+	fmt.Printf("Outliers at %.2f gas/ns:\n%s", calibrationGasPerNanosecond, calibration.FormatOutliers(proposal.Outliers))
+}
+
+func BenchmarkGasCalibration_BigInt(b *testing.B) {
+	benchmarkCalibrationCases(b, calibration.BigIntCases())
+}
+
+func BenchmarkGasCalibration_ManagedBuffer(b *testing.B) {
+	benchmarkCalibrationCases(b, calibration.ManagedBufferCases())
+}
+
+func BenchmarkGasCalibration_Crypto(b *testing.B) {
+	benchmarkCalibrationCases(b, calibration.CryptoCases())
+}
+
+func BenchmarkGasCalibration_Storage(b *testing.B) {
+	benchmarkCalibrationCases(b, calibration.StorageCases())
+}
+
+func BenchmarkGasCalibration_Opcodes(b *testing.B) {
+	benchmarkCalibrationCases(b, calibration.OpcodeCases())
+}
+
+// benchmarkCalibrationCases runs b.N iterations of the loop of each case
+// within a single contract call. Because ns/op also covers the untimed first
+// execution of the runner, the timed execution is reported as ns/iteration.
+func benchmarkCalibrationCases(b *testing.B, cases []*calibration.Case) {
+	gasMap, err := gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
+	require.Nil(b, err)
+
+	host, mockWorld := createCalibrationHost(b, gasMap)
+	defer func() {
+		_ = host.Close()
+	}()
+
+	for _, c := range cases {
+		b.Run(c.Name, func(b *testing.B) {
+			iterations := int32(math.MaxInt32)
+			if b.N < math.MaxInt32 {
+				iterations = int32(b.N)
+			}
+
+			runner, err := calibration.NewRunner(host, mockWorld, iterations, 1)
+			require.Nil(b, err)
+
+			measurement, err := runner.Measure(c)
+			require.Nil(b, err)
+			b.ReportMetric(measurement.NanosecondsPerIteration(nil), "ns/iteration")
+		})
+	}
+}
+
+func createCalibrationHost(tb testing.TB, gasMap config.GasScheduleMap) (arwen.VMHost, *worldmock.MockWorld) {
+	mockWorld := worldmock.NewMockWorld()
+
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
+	host, err := arwenHost.NewArwenVM(mockWorld, &arwen.VMHostParameters{
+		VMType:                   testcommon.DefaultVMType,
+		BlockGasLimit:            uint64(1000),
+		GasSchedule:              gasMap,
+		BuiltInFuncContainer:     builtInFunctions.NewBuiltInFunctionContainer(),
+		ElrondProtectedKeyPrefix: []byte("ELROND"),
+		ESDTTransferParser:       esdtTransferParser,
+		EpochNotifier:            &mock.EpochNotifierStub{},
+		WasmerSIGSEGVPassthrough: false,
+	})
+	require.Nil(tb, err)
+
+	return host, mockWorld
+}
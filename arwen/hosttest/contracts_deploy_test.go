@@ -155,6 +155,54 @@ func runDeployFromSourceTest(t *testing.T, testConfig *deployFromSourceTestConfi
 		AndAssertResults(asserts)
 }
 
+// runDeployWithImportsTest deploys a contract importing the provided
+// functions, with the enable epochs of the host set as provided
+func runDeployWithImportsTest(
+	t *testing.T,
+	importNames []string,
+	setEnableEpochs func(*arwen.VMHostParameters),
+	asserts func(world *worldmock.MockWorld, verify *test.VMOutputVerifier),
+) {
+	testConfig := getDeployFromSourceTestConfig()
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(testConfig.deployedContractAddress).
+				WithConfig(testConfig).
+				WithMethods(contracts.InitMockMethod, func(instanceMock *mock.InstanceMock, config interface{}) {
+					for _, importName := range importNames {
+						instanceMock.AddMockImport(importName)
+					}
+				})).
+		WithCreateInput(test.CreateTestContractCreateInputBuilder().
+			WithCallerAddr(test.UserAddress).
+			WithContractCode(testConfig.deployedContractAddress).
+			WithGasProvided(testConfig.gasProvided).
+			WithArguments().
+			Build()).
+		WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+			world.AcctMap.CreateAccount(test.UserAddress, world)
+		}).
+		WithEnableEpochs(setEnableEpochs).
+		AndAssertResults(asserts)
+}
+
+// requireImportsGatedByEpoch checks that contracts importing the provided
+// functions cannot be deployed before the epoch set by disableImports
+func requireImportsGatedByEpoch(t *testing.T, importNames []string, disableImports func(*arwen.VMHostParameters)) {
+	for _, importName := range importNames {
+		runDeployWithImportsTest(t, []string{importName}, disableImports,
+			func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+				verify.ContractInvalid()
+			})
+	}
+
+	runDeployWithImportsTest(t, importNames, func(parameters *arwen.VMHostParameters) {},
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+}
+
 func TestUpdateFromSource_Success_EpochFlag_Callback(t *testing.T) {
 	testConfig := getUpdateFromSourceTestConfig()
 	updatedCode := testConfig.deployedContractAddress /* this is the actual mock code of the deployed contract */
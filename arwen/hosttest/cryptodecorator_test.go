@@ -0,0 +1,74 @@
+package hosttest
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/cryptoapi"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/decorator"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/factory"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCryptoDecorator_CountsSignatureChecks(t *testing.T) {
+	counting, err := decorator.NewCountingCrypto(factory.NewVMCrypto())
+	require.Nil(t, err)
+	keys, msgs, sigs := generateEd25519Signatures(3)
+
+	runSignatureTestWithCrypto(t, counting,
+		func(host arwen.VMHost) {
+			require.Equal(t, counting, host.Crypto())
+
+			managedType := host.ManagedTypes()
+			for i := range keys {
+				keyHandle := managedType.NewManagedBufferFromBytes(keys[i])
+				messageHandle := managedType.NewManagedBufferFromBytes(msgs[i])
+				sigHandle := managedType.NewManagedBufferFromBytes(sigs[i])
+				cryptoapi.ManagedVerifyEd25519WithHost(host, keyHandle, messageHandle, sigHandle)
+			}
+
+			keysHandle := newManagedVecOfBuffers(host, keys)
+			messagesHandle := newManagedVecOfBuffers(host, msgs)
+			sigsHandle := newManagedVecOfBuffers(host, sigs)
+			cryptoapi.ManagedVerifyEd25519BatchWithHost(host, keysHandle, messagesHandle, sigsHandle, managedType.NewBigIntFromInt64(0))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+
+	require.Equal(t, 3, counting.NumCalls(decorator.VerifyEd25519))
+	require.Equal(t, 1, counting.NumCalls(decorator.VerifyEd25519Batch))
+	require.Equal(t, 4, counting.NumSignatureChecks())
+}
+
+func TestCryptoDecorator_InjectedFaultFailsExecution(t *testing.T) {
+	faultInjecting, err := decorator.NewFaultInjectingCrypto(factory.NewVMCrypto())
+	require.Nil(t, err)
+	faultInjecting.FailMethodAfter(decorator.VerifyEd25519, 1, nil)
+	keys, msgs, sigs := generateEd25519Signatures(1)
+
+	runSignatureTestWithCrypto(t, faultInjecting,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			keyHandle := managedType.NewManagedBufferFromBytes(keys[0])
+			messageHandle := managedType.NewManagedBufferFromBytes(msgs[0])
+			sigHandle := managedType.NewManagedBufferFromBytes(sigs[0])
+
+			result := cryptoapi.ManagedVerifyEd25519WithHost(host, keyHandle, messageHandle, sigHandle)
+			require.Equal(t, int32(0), result)
+			cryptoapi.ManagedVerifyEd25519WithHost(host, keyHandle, messageHandle, sigHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(decorator.ErrInjectedFault.Error())
+		})
+}
+
+func TestCryptoDecorator_NilDecoratorUsesDefaultCrypto(t *testing.T) {
+	var counting *decorator.CountingCrypto
+	host := test.DefaultTestArwenWithCrypto(t, worldmock.NewMockWorld(), counting)
+	require.False(t, check.IfNil(host.Crypto()))
+}
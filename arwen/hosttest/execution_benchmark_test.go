@@ -165,3 +165,71 @@ func createERC20Key(accountName string) string {
 
 	return string(key)
 }
+
+// the recursion depth of the exec-dest-ctx-recursive contract, as in its tests
+const benchmarkRecursiveCalls = 6
+
+func BenchmarkExecution_ExecuteOnDestContext_Recursive(b *testing.B) {
+	code := testcommon.GetTestSCCode("exec-dest-ctx-recursive", "../../")
+	host, _ := testcommon.DefaultTestArwenForCall(b, code, big.NewInt(1000))
+	defer func() {
+		_ = host.Close()
+	}()
+
+	input := testcommon.CreateTestContractCallInputBuilder().
+		WithRecipientAddr(testcommon.ParentAddress).
+		WithFunction(callRecursive).
+		WithGasProvided(testcommon.GasProvided).
+		WithArguments([]byte{byte(benchmarkRecursiveCalls)}).
+		Build()
+
+	runBenchmarkCalls(b, host, input)
+}
+
+func BenchmarkExecution_ExecuteOnSameContext_MultipleChildren(b *testing.B) {
+	benchmarkMultipleChildren(b, "callChildrenDirectly_SameCtx")
+}
+
+func BenchmarkExecution_ExecuteOnDestContext_MultipleChildren(b *testing.B) {
+	benchmarkMultipleChildren(b, "callChildrenDirectly_DestCtx")
+}
+
+func benchmarkMultipleChildren(b *testing.B, function string) {
+	world := worldmock.NewMockWorld()
+	host := testcommon.DefaultTestArwen(b, world)
+	defer func() {
+		_ = host.Close()
+	}()
+
+	alphaCode := testcommon.GetTestSCCodeModule("exec-sync-ctx-multiple/alpha", "alpha", "../../")
+	alpha := testcommon.AddTestSmartContractToWorld(world, "alphaSC", alphaCode)
+	alpha.Balance = big.NewInt(100)
+
+	betaCode := testcommon.GetTestSCCodeModule("exec-sync-ctx-multiple/beta", "beta", "../../")
+	gammaCode := testcommon.GetTestSCCodeModule("exec-sync-ctx-multiple/gamma", "gamma", "../../")
+	deltaCode := testcommon.GetTestSCCodeModule("exec-sync-ctx-multiple/delta", "delta", "../../")
+
+	_ = testcommon.AddTestSmartContractToWorld(world, "betaSC", betaCode)
+	_ = testcommon.AddTestSmartContractToWorld(world, "gammaSC", gammaCode)
+	_ = testcommon.AddTestSmartContractToWorld(world, "deltaSC", deltaCode)
+
+	input := testcommon.DefaultTestContractCallInput()
+	input.Function = function
+	input.GasProvided = 1000000
+	input.RecipientAddr = alpha.Address
+
+	runBenchmarkCalls(b, host, input)
+}
+
+func runBenchmarkCalls(b *testing.B, host arwen.VMHost, input *vmcommon.ContractCallInput) {
+	// the first call compiles the contracts and fills the warm instance cache
+	vmOutput, err := host.RunSmartContractCall(input)
+	require.Nil(b, err)
+	require.Equal(b, vmcommon.Ok, vmOutput.ReturnCode)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = host.RunSmartContractCall(input)
+	}
+}
@@ -943,6 +943,44 @@ func buildRandomizer(host arwen.VMHost) io.Reader {
 	return randReader
 }
 
+func TestExecution_ManagedBuffers_SecureRandomness(t *testing.T) {
+	numberOfReps := 100
+	test.BuildInstanceCallTest(t).
+		WithContracts(
+			test.CreateInstanceContract(test.ParentAddress).
+				WithCode(test.GetTestSCCode("managed-buffers", "../../"))).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithGasProvided(100000).
+			WithFunction("mBufferSetRandomTest").
+			WithArguments([]byte{byte(numberOfReps)}).
+			Build()).
+		WithEnableEpochs(enableSecureRandomness).
+		AndAssertResults(func(host arwen.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
+			randReader := buildFrameRandomizer(host)
+
+			randomBuffer := make([]byte, numberOfReps)
+			for i := 0; i < numberOfReps; i++ {
+				_, _ = randReader.Read(randomBuffer)
+			}
+			verify.Ok().
+				ReturnData(randomBuffer)
+		})
+}
+
+func buildFrameRandomizer(host arwen.VMHost) io.Reader {
+	blockchainContext := host.Blockchain()
+	previousRandomSeed := blockchainContext.LastRandomSeed()
+	currentRandomSeed := blockchainContext.CurrentRandomSeed()
+	txHash := host.Runtime().GetCurrentTxHash()
+
+	blocksRandomSeed := append(previousRandomSeed, currentRandomSeed...)
+	randomSeed := append(blocksRandomSeed, txHash...)
+
+	// the contract reads the randomness of the first frame of the transaction
+	frameRandomSeed := arwenMath.DeriveFrameRandomSeed(randomSeed, 0, test.ParentAddress)
+	return arwenMath.NewChaCha20RandReader(frameRandomSeed)
+}
+
 func TestExecution_ManagedBuffers_SetByteSlice(t *testing.T) {
 	// mByteSetByteSlice not yet enabled
 	runTestMBufferSetByteSlice_Deploy(t, false, vmcommon.ContractInvalid)
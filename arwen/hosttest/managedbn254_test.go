@@ -0,0 +1,218 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/cryptoapi"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/bn254"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/stretchr/testify/require"
+)
+
+// the chfast1 vectors from the bn256Add and bn256ScalarMul precompile tests of go-ethereum
+var bn254AddFirstPoint = decodeHex("18b18acfb4c2c30276db5411368e7185b311dd124691610c5d3b74034e093dc9063c909c4720840cb5134cb9f59fa749755796819658d32efc0d288198f37266")
+var bn254AddSecondPoint = decodeHex("07c2b7f58a84bd6145f00c9c2bc0bb1a187f20ff2c92963a88019e7c6a014eed06614e20c147e940f2d70da3f74c9a17df361706a4485c742bd6788478fa17d7")
+var bn254AddResult = decodeHex("2243525c5efd4b9c3d3c45ac0ca3fe4dd85e830a4ce6b65fa1eeaee202839703301d1d33be6da8e509df21cc35964723180eed7532537db9ae5e7d48f195c915")
+var bn254MulPoint = decodeHex("2bd3e6d0f3b142924f5ca7b49ce5b9d54c4703d7ae5648e61d02268b1a0a9fb721611ce0a6af85915e2f1d70300909ce2e49dfad4a4619c8390cae66cefdb204")
+var bn254MulScalar = decodeHex("00000000000000000000000000000000000000000000000011138ce750fa15c2")
+var bn254MulResult = decodeHex("070a8d6a982153cae4be29d434e8faef8a47b274a053f5a4ee2a6c9c13c31e5c031b8ce914eba3a9ffb989f9cdd5b0f01943074bf4f0f315690ec3cec6981afc")
+
+// the generators of G1 and G2 and the negation of the G1 generator, as given in EIP-197
+var bn254G1Generator = decodeHex("00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002")
+var bn254G1MinusGenerator = decodeHex("000000000000000000000000000000000000000000000000000000000000000130644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd45")
+var bn254G2Generator = decodeHex("198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c21800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+	"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa")
+
+// a Groth16 instance with one public input, simulated with a known trapdoor
+var groth16VerifyingKey = decodeHex("0769bf9ac56bea3ff40232bcb1b6bd159315d84715b8e679f2d355961915abf02ab799bee0489429554fdb7c8d086475319e63b40b9c5b57cdf1ff3dd9fe2261" +
+	"0a09ccf561b55fd99d1c1208dee1162457b57ac5af3759d50671e510e428b2a12e539c423b302d13f4e5773c603948eaf5db5df8ae8a9a9113708390a06410d8" +
+	"19b763513924a736e4eebd0d78c91c1bc1d657fee4214057d21414011cfcc7632f8d9f9ab83727c77a2fec063cb7b6e5eb23044ccf535ad49d46d394fb6f6bf6" +
+	"2903ba015a9abde26a5d081e84551e63be0fd4516e46ee6d593edeba46362455224bdc5d4327fcf8ed702e01de1c2f1657a253ba75e32a89c390142aaa28b308" +
+	"03c8b7cda6b2dedb7aeeaf5fda464ad17036bea1c4e6f7adbaed1ebe0335e0d81d92fff52a265017eeccb372e37d7a7bd431800eca28dfd82e21e8054114233f" +
+	"228b515a17f28b89920873207477f8c7fc05582debaf3184febf1cfdedc5ce8812bb1156a9f6b360fcb2614e15d8a3ff07f2c699dc69ca830b20d2df91fe9cd3" +
+	"2b15dc62a5c9e36597914ddbbfde48806a8eabe45c8d3cccf9578ad08e058f9202a4fd764f52470e2fcfff325fb9692f55d6b8b077eefeaa04e07152b4d1fa94" +
+	"15514de6a136158ef7b2bc22bed59866743bc401edd63ae857d44f4c71edc28d095e28f5ba5d73440c0e504b624afabfedb9387320817b62e9168b6868d8952e" +
+	"1e28260f0ee971dec1e84cf81ff2776ad314d2cfb9ef81d4c970620c29b811f128fc8a72d4ff12654c3c39dab54eaef9638d28de738959779fcd3e7ac918b396")
+var groth16Proof = decodeHex("05e86f8cc8a7a4f10f56093465679f17f8b8c3fdb41469e408b529e030f52f3f2857bd14bbc09767bed8e913d3ccb42b2bc8738f715417dd6f020725d22bcd90" +
+	"227071bba5ff3b47ed8b504bb5b215bc701d7a3259b933bff1a4164eae499c2c0c51a367b61d3119677b29739ddccbb78002b5558d8f49ff16e299c1b41f8098" +
+	"08bb188b2a6187bb1e87834c85a6a917763d65b98febf2c45ea339dd77fac41518fd2fd13be8494c39e8a91325d1ef3ba7d1a205d10788e38bc9e09d9be87769" +
+	"19c81b3198e891bd74d2efc700e755352974e3b3f5dc2d8bc5e47275ad2b7b9e264efc09af9e6e801c82cae887ec8464f1bee86d079d5d61cd6026cb4033fde9")
+var groth16PublicInputs = decodeHex("000000000000000000000000000000000000000000000000000000000000002a")
+
+func concatBytes(parts ...[]byte) []byte {
+	result := make([]byte, 0)
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+	return result
+}
+
+func TestManagedBn254_G1Add(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			firstPointHandle := managedType.NewManagedBufferFromBytes(bn254AddFirstPoint)
+			secondPointHandle := managedType.NewManagedBufferFromBytes(bn254AddSecondPoint)
+			resultHandle := managedType.NewManagedBuffer()
+
+			result := cryptoapi.ManagedBn254G1AddWithHost(host, firstPointHandle, secondPointHandle, resultHandle)
+			finishInt64(host.Output(), int64(result))
+			finishManagedBuffer(host, resultHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{}, bn254AddResult)
+		})
+}
+
+func TestManagedBn254_G1Add_InvalidPoint(t *testing.T) {
+	notOnCurve := concatBytes(bn254G1Generator[:bn254.G1PointLength-1], []byte{3})
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			firstPointHandle := managedType.NewManagedBufferFromBytes(bn254G1Generator)
+			secondPointHandle := managedType.NewManagedBufferFromBytes(notOnCurve)
+			resultHandle := managedType.NewManagedBuffer()
+
+			cryptoapi.ManagedBn254G1AddWithHost(host, firstPointHandle, secondPointHandle, resultHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(bn254.ErrInvalidG1Point.Error())
+		})
+}
+
+func TestManagedBn254_G1ScalarMul(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			pointHandle := managedType.NewManagedBufferFromBytes(bn254MulPoint)
+			scalarHandle := managedType.NewManagedBufferFromBytes(bn254MulScalar)
+			resultHandle := managedType.NewManagedBuffer()
+
+			result := cryptoapi.ManagedBn254G1ScalarMulWithHost(host, pointHandle, scalarHandle, resultHandle)
+			finishInt64(host.Output(), int64(result))
+			finishManagedBuffer(host, resultHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{}, bn254MulResult)
+		})
+}
+
+func TestManagedBn254_PairingCheck(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			validHandle := managedType.NewManagedBufferFromBytes(concatBytes(
+				bn254G1Generator, bn254G2Generator,
+				bn254G1MinusGenerator, bn254G2Generator,
+			))
+			invalidHandle := managedType.NewManagedBufferFromBytes(concatBytes(bn254G1Generator, bn254G2Generator))
+
+			result := cryptoapi.ManagedBn254PairingCheckWithHost(host, validHandle)
+			finishInt64(host.Output(), int64(result))
+			result = cryptoapi.ManagedBn254PairingCheckWithHost(host, invalidHandle)
+			finishInt64(host.Output(), int64(result))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{}, big.NewInt(-1).Bytes())
+		})
+}
+
+func TestManagedBn254_PairingCheck_InvalidLength(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			pairsHandle := managedType.NewManagedBufferFromBytes(bn254G1Generator)
+
+			cryptoapi.ManagedBn254PairingCheckWithHost(host, pairsHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(bn254.ErrInvalidPairingInput.Error())
+		})
+}
+
+func TestManagedBn254_PairingCheck_GasScalesWithPairs(t *testing.T) {
+	pair := concatBytes(bn254G1Generator, bn254G2Generator)
+	minusPair := concatBytes(bn254G1MinusGenerator, bn254G2Generator)
+
+	var gasForOnePair, gasForTwoPairs, costPerPair uint64
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			metering := host.Metering()
+			onePairHandle := managedType.NewManagedBufferFromBytes(pair)
+			twoPairsHandle := managedType.NewManagedBufferFromBytes(concatBytes(pair, minusPair))
+
+			gasLeft := metering.GasLeft()
+			cryptoapi.ManagedBn254PairingCheckWithHost(host, onePairHandle)
+			gasForOnePair = gasLeft - metering.GasLeft()
+
+			gasLeft = metering.GasLeft()
+			cryptoapi.ManagedBn254PairingCheckWithHost(host, twoPairsHandle)
+			gasForTwoPairs = gasLeft - metering.GasLeft()
+
+			costPerPair = metering.GasSchedule().CryptoAPICost.Bn254PairingPerPair
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+
+	require.GreaterOrEqual(t, gasForTwoPairs-gasForOnePair, costPerPair)
+}
+
+func TestManagedBn254_VerifyGroth16(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			vkHandle := managedType.NewManagedBufferFromBytes(groth16VerifyingKey)
+			proofHandle := managedType.NewManagedBufferFromBytes(groth16Proof)
+			publicInputsHandle := managedType.NewManagedBufferFromBytes(groth16PublicInputs)
+
+			result := cryptoapi.ManagedVerifyGroth16WithHost(host, vkHandle, proofHandle, publicInputsHandle)
+			finishInt64(host.Output(), int64(result))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{})
+		})
+}
+
+func TestManagedBn254_VerifyGroth16_WrongPublicInput(t *testing.T) {
+	wrongInput := make([]byte, bn254.FieldElementLength)
+	wrongInput[bn254.FieldElementLength-1] = 43
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			vkHandle := managedType.NewManagedBufferFromBytes(groth16VerifyingKey)
+			proofHandle := managedType.NewManagedBufferFromBytes(groth16Proof)
+			publicInputsHandle := managedType.NewManagedBufferFromBytes(wrongInput)
+
+			cryptoapi.ManagedVerifyGroth16WithHost(host, vkHandle, proofHandle, publicInputsHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(bn254.ErrProofVerificationFailed.Error())
+		})
+}
+
+func TestManagedBn254_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"bn254G1Add",
+			"managedBn254G1Add",
+			"bn254G1ScalarMul",
+			"managedBn254G1ScalarMul",
+			"bn254PairingCheck",
+			"managedBn254PairingCheck",
+			"managedVerifyGroth16",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.BN254EnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -0,0 +1,290 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+)
+
+func TestManagedBufferStrings_CompareFindSplit(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						tokenID := managedType.NewManagedBufferFromBytes([]byte("NFT-ab12cd-01"))
+						other := managedType.NewManagedBufferFromBytes([]byte("NFT-ab12ce"))
+						finishInt64(output, int64(elrondapi.ManagedBufferCompareWithHost(host, tokenID, other)))
+						finishInt64(output, int64(elrondapi.ManagedBufferCompareWithHost(host, other, tokenID)))
+						finishInt64(output, int64(elrondapi.ManagedBufferCompareWithHost(host, tokenID, tokenID)))
+
+						separator := managedType.NewManagedBufferFromBytes([]byte("-"))
+						finishInt64(output, int64(elrondapi.ManagedBufferFindWithHost(host, tokenID, separator, 0)))
+						finishInt64(output, int64(elrondapi.ManagedBufferFindWithHost(host, tokenID, separator, 4)))
+						finishInt64(output, int64(elrondapi.ManagedBufferFindByteWithHost(host, tokenID, 'z', 0)))
+
+						pieces := managedType.NewManagedBuffer()
+						finishInt64(output, int64(elrondapi.ManagedBufferSplitWithHost(host, tokenID, separator, pieces)))
+						finishManagedVec(host, pieces)
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					big.NewInt(-1).Bytes(),
+					big.NewInt(1).Bytes(),
+					[]byte{},
+					big.NewInt(3).Bytes(),
+					big.NewInt(10).Bytes(),
+					big.NewInt(-1).Bytes(),
+					big.NewInt(3).Bytes(),
+					[]byte("NFT"),
+					[]byte("ab12cd"),
+					[]byte("01"),
+				)
+		})
+}
+
+func TestManagedBufferStrings_Decimals(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						amount := managedType.NewBigIntFromInt64(0)
+						source := managedType.NewManagedBufferFromBytes([]byte("-12.5"))
+						elrondapi.ManagedBufferParseDecimalWithHost(host, source, 3, amount)
+						output.Finish([]byte(managedType.GetBigIntOrCreate(amount).String()))
+
+						formatted := managedType.NewManagedBuffer()
+						elrondapi.ManagedBufferFormatDecimalWithHost(host, amount, 3, formatted)
+						finishManagedBuffer(host, formatted)
+						elrondapi.ManagedBufferFormatDecimalWithHost(host, amount, 6, formatted)
+						finishManagedBuffer(host, formatted)
+						elrondapi.ManagedBufferFormatDecimalWithHost(host, amount, 0, formatted)
+						finishManagedBuffer(host, formatted)
+
+						managedType.SetBytes(source, []byte("1000000"))
+						elrondapi.ManagedBufferParseDecimalWithHost(host, source, 0, amount)
+						output.Finish([]byte(managedType.GetBigIntOrCreate(amount).String()))
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					[]byte("-12500"),
+					[]byte("-12.500"),
+					[]byte("-0.012500"),
+					[]byte("-12500"),
+					[]byte("1000000"),
+				)
+		})
+}
+
+func TestManagedBufferStrings_Encodings(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						source := managedType.NewManagedBufferFromBytes([]byte("arwen"))
+						encoded := managedType.NewManagedBuffer()
+						decoded := managedType.NewManagedBuffer()
+						elrondapi.ManagedBufferToBase64WithHost(host, source, encoded)
+						finishManagedBuffer(host, encoded)
+						elrondapi.ManagedBufferFromBase64WithHost(host, encoded, decoded)
+						finishManagedBuffer(host, decoded)
+
+						managedType.SetBytes(encoded, []byte("cafe"))
+						elrondapi.ManagedBufferFromHexWithHost(host, encoded, decoded)
+						finishManagedBuffer(host, decoded)
+
+						elrondapi.ManagedBufferTopEncodeU64WithHost(host, encoded, 256)
+						finishManagedBuffer(host, encoded)
+						finishInt64(output, elrondapi.ManagedBufferTopDecodeU64WithHost(host, encoded))
+						elrondapi.ManagedBufferTopEncodeU64WithHost(host, encoded, 0)
+						finishManagedBuffer(host, encoded)
+
+						// a nested u64 followed by a signed and an unsigned nested big int
+						serialized := managedType.NewManagedBuffer()
+						elrondapi.ManagedBufferNestedEncodeU64WithHost(host, serialized, 5)
+						elrondapi.ManagedBufferNestedEncodeBigIntWithHost(host, "mBufferNestedEncodeBigInt", serialized, managedType.NewBigIntFromInt64(-1), true)
+						elrondapi.ManagedBufferNestedEncodeBigIntWithHost(host, "mBufferNestedEncodeBigUint", serialized, managedType.NewBigIntFromInt64(1000), false)
+						finishManagedBuffer(host, serialized)
+
+						value := managedType.NewBigIntFromInt64(0)
+						position := elrondapi.ManagedBufferNestedDecodeBigIntWithHost(host, "mBufferNestedDecodeBigInt", serialized, 8, value, true)
+						finishInt64(output, int64(position))
+						output.Finish([]byte(managedType.GetBigIntOrCreate(value).String()))
+						position = elrondapi.ManagedBufferNestedDecodeBigIntWithHost(host, "mBufferNestedDecodeBigUint", serialized, position, value, false)
+						finishInt64(output, int64(position))
+						output.Finish([]byte(managedType.GetBigIntOrCreate(value).String()))
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					[]byte("YXJ3ZW4="),
+					[]byte("arwen"),
+					[]byte{0xca, 0xfe},
+					[]byte{1, 0},
+					big.NewInt(256).Bytes(),
+					[]byte{},
+					[]byte{0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 1, 0xff, 0, 0, 0, 2, 0x03, 0xe8},
+					big.NewInt(13).Bytes(),
+					[]byte("-1"),
+					big.NewInt(19).Bytes(),
+					[]byte("1000"),
+				)
+		})
+}
+
+func TestManagedBufferStrings_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		call     func(host arwen.VMHost)
+		expected error
+	}{
+		{
+			name: "too many fractional digits",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				source := managedType.NewManagedBufferFromBytes([]byte("1.2345"))
+				elrondapi.ManagedBufferParseDecimalWithHost(host, source, 2, managedType.NewBigIntFromInt64(0))
+			},
+			expected: arwen.ErrInvalidDecimalString,
+		},
+		{
+			name: "not a number",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				source := managedType.NewManagedBufferFromBytes([]byte("12a"))
+				elrondapi.ManagedBufferParseDecimalWithHost(host, source, 2, managedType.NewBigIntFromInt64(0))
+			},
+			expected: arwen.ErrInvalidDecimalString,
+		},
+		{
+			name: "empty separator",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				source := managedType.NewManagedBufferFromBytes([]byte("abc"))
+				elrondapi.ManagedBufferSplitWithHost(host, source, managedType.NewManagedBuffer(), managedType.NewManagedBuffer())
+			},
+			expected: arwen.ErrEmptySeparator,
+		},
+		{
+			name: "invalid hex",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				source := managedType.NewManagedBufferFromBytes([]byte("xyz"))
+				elrondapi.ManagedBufferFromHexWithHost(host, source, managedType.NewManagedBuffer())
+			},
+			expected: arwen.ErrInvalidEncodedData,
+		},
+		{
+			name: "truncated nested big int",
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				source := managedType.NewManagedBufferFromBytes([]byte{0, 0, 0, 4, 1})
+				elrondapi.ManagedBufferNestedDecodeBigIntWithHost(host, "mBufferNestedDecodeBigUint", source, 0, managedType.NewBigIntFromInt64(0), false)
+			},
+			expected: arwen.ErrInvalidEncodedData,
+		},
+	}
+
+	for _, testCase := range testCases {
+		call := testCase.call
+		expected := testCase.expected
+		t.Run(testCase.name, func(t *testing.T) {
+			test.BuildMockInstanceCallTest(t).
+				WithContracts(
+					test.CreateMockContract(test.ParentAddress).
+						WithBalance(1000).
+						WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+							parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+								call(parentInstance.Host)
+								return parentInstance
+							})
+						}),
+				).
+				WithInput(test.CreateTestContractCallInputBuilder().
+					WithRecipientAddr(test.ParentAddress).
+					WithGasProvided(100000).
+					WithFunction("testFunction").
+					Build()).
+				AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+					verify.ExecutionFailed().
+						HasRuntimeErrors(expected.Error())
+				})
+		})
+	}
+}
+
+func TestManagedBufferStrings_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"mBufferCompare",
+			"mBufferFind",
+			"mBufferFindByte",
+			"mBufferSplit",
+			"mBufferParseDecimal",
+			"mBufferFormatDecimal",
+			"mBufferToBase64",
+			"mBufferFromBase64",
+			"mBufferFromHex",
+			"mBufferTopEncodeU64",
+			"mBufferTopDecodeU64",
+			"mBufferNestedEncodeU64",
+			"mBufferNestedEncodeBigUint",
+			"mBufferNestedEncodeBigInt",
+			"mBufferNestedDecodeBigUint",
+			"mBufferNestedDecodeBigInt",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.ManagedBufferStringsEnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -0,0 +1,174 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/cryptoapi"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/hashing"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/stretchr/testify/require"
+)
+
+type managedHashTestCase struct {
+	name        string
+	managedHash func(host arwen.VMHost, inputHandle int32, outputHandle int32) int32
+	hash        func(data []byte) ([]byte, error)
+	costPerByte func(host arwen.VMHost) uint64
+}
+
+func managedHashTestCases() []managedHashTestCase {
+	hasher := hashing.NewHasher()
+	return []managedHashTestCase{
+		{
+			name:        "managedSha512",
+			managedHash: cryptoapi.ManagedSha512WithHost,
+			hash:        hasher.Sha512,
+			costPerByte: func(host arwen.VMHost) uint64 {
+				return host.Metering().GasSchedule().CryptoAPICost.SHA512PerByte
+			},
+		},
+		{
+			name:        "managedSha3256",
+			managedHash: cryptoapi.ManagedSha3256WithHost,
+			hash:        hasher.Sha3256,
+			costPerByte: func(host arwen.VMHost) uint64 {
+				return host.Metering().GasSchedule().CryptoAPICost.SHA3256PerByte
+			},
+		},
+		{
+			name:        "managedBlake2b256",
+			managedHash: cryptoapi.ManagedBlake2b256WithHost,
+			hash:        hasher.Blake2b256,
+			costPerByte: func(host arwen.VMHost) uint64 {
+				return host.Metering().GasSchedule().CryptoAPICost.Blake2b256PerByte
+			},
+		},
+		{
+			name:        "managedBlake2b512",
+			managedHash: cryptoapi.ManagedBlake2b512WithHost,
+			hash:        hasher.Blake2b512,
+			costPerByte: func(host arwen.VMHost) uint64 {
+				return host.Metering().GasSchedule().CryptoAPICost.Blake2b512PerByte
+			},
+		},
+		{
+			name:        "managedBlake2s256",
+			managedHash: cryptoapi.ManagedBlake2s256WithHost,
+			hash:        hasher.Blake2s256,
+			costPerByte: func(host arwen.VMHost) uint64 {
+				return host.Metering().GasSchedule().CryptoAPICost.Blake2s256PerByte
+			},
+		},
+	}
+}
+
+func TestManagedHashing_Result(t *testing.T) {
+	input := []byte("abc")
+	for _, testCase := range managedHashTestCases() {
+		t.Run(testCase.name, func(t *testing.T) {
+			expected, err := testCase.hash(input)
+			require.Nil(t, err)
+
+			test.BuildMockInstanceCallTest(t).
+				WithContracts(
+					test.CreateMockContract(test.ParentAddress).
+						WithBalance(1000).
+						WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+							parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+								host := parentInstance.Host
+								managedType := host.ManagedTypes()
+
+								inputHandle := managedType.NewManagedBufferFromBytes(input)
+								outputHandle := managedType.NewManagedBuffer()
+								result := testCase.managedHash(host, inputHandle, outputHandle)
+								finishInt64(host.Output(), int64(result))
+								finishManagedBuffer(host, outputHandle)
+
+								return parentInstance
+							})
+						}),
+				).
+				WithInput(test.CreateTestContractCallInputBuilder().
+					WithRecipientAddr(test.ParentAddress).
+					WithGasProvided(100000).
+					WithFunction("testFunction").
+					Build()).
+				AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+					verify.Ok().
+						ReturnData([]byte{}, expected)
+				})
+		})
+	}
+}
+
+func TestManagedHashing_GasScalesWithInputLength(t *testing.T) {
+	inputLength := 100
+	for _, testCase := range managedHashTestCases() {
+		t.Run(testCase.name, func(t *testing.T) {
+			var expectedGasDifference uint64
+			test.BuildMockInstanceCallTest(t).
+				WithContracts(
+					test.CreateMockContract(test.ParentAddress).
+						WithBalance(1000).
+						WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+							parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+								host := parentInstance.Host
+								managedType := host.ManagedTypes()
+								metering := host.Metering()
+
+								emptyHandle := managedType.NewManagedBuffer()
+								longHandle := managedType.NewManagedBufferFromBytes(make([]byte, inputLength))
+								outputHandle := managedType.NewManagedBufferFromBytes(make([]byte, 64))
+
+								gasLeft := metering.GasLeft()
+								testCase.managedHash(host, emptyHandle, outputHandle)
+								emptyInputGas := gasLeft - metering.GasLeft()
+
+								gasLeft = metering.GasLeft()
+								testCase.managedHash(host, longHandle, outputHandle)
+								longInputGas := gasLeft - metering.GasLeft()
+
+								costPerByte := testCase.costPerByte(host) + metering.GasSchedule().BaseOperationCost.DataCopyPerByte
+								expectedGasDifference = uint64(inputLength) * costPerByte
+								finishInt64(host.Output(), int64(longInputGas-emptyInputGas))
+
+								return parentInstance
+							})
+						}),
+				).
+				WithInput(test.CreateTestContractCallInputBuilder().
+					WithRecipientAddr(test.ParentAddress).
+					WithGasProvided(100000).
+					WithFunction("testFunction").
+					Build()).
+				AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+					require.NotZero(t, expectedGasDifference)
+					verify.Ok().
+						ReturnData(big.NewInt(int64(expectedGasDifference)).Bytes())
+				})
+		})
+	}
+}
+
+func TestManagedHashing_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"sha512",
+			"managedSha512",
+			"sha3256",
+			"managedSha3256",
+			"blake2b256",
+			"managedBlake2b256",
+			"blake2b512",
+			"managedBlake2b512",
+			"blake2s256",
+			"managedBlake2s256",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.ExtendedHashingEnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -0,0 +1,131 @@
+package hosttest
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+)
+
+func TestManagedMap_PutGetRemoveKeys(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						mMap := elrondapi.ManagedMapNewWithHost(host)
+						keyB := managedType.NewManagedBufferFromBytes([]byte("b"))
+						keyA := managedType.NewManagedBufferFromBytes([]byte("a"))
+						value1 := managedType.NewManagedBufferFromBytes([]byte("one"))
+						value2 := managedType.NewManagedBufferFromBytes([]byte("two"))
+						result := managedType.NewManagedBuffer()
+
+						elrondapi.ManagedMapPutWithHost(host, mMap, keyB, value1)
+						elrondapi.ManagedMapPutWithHost(host, mMap, keyA, value2)
+						finishInt64(output, int64(elrondapi.ManagedMapLenWithHost(host, mMap)))
+
+						elrondapi.ManagedMapGetWithHost(host, mMap, keyB, result)
+						finishManagedBuffer(host, result)
+
+						keys := managedType.NewManagedBuffer()
+						finishInt64(output, int64(elrondapi.ManagedMapKeysWithHost(host, mMap, keys)))
+						finishManagedVec(host, keys)
+
+						elrondapi.ManagedMapRemoveWithHost(host, mMap, keyA, result)
+						finishManagedBuffer(host, result)
+						finishInt64(output, int64(elrondapi.ManagedMapContainsWithHost(host, mMap, keyA)))
+						finishInt64(output, int64(elrondapi.ManagedMapContainsWithHost(host, mMap, keyB)))
+
+						elrondapi.ManagedMapGetWithHost(host, mMap, keyA, result)
+						finishManagedBuffer(host, result)
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					[]byte{2},
+					[]byte("one"),
+					[]byte{2}, []byte("a"), []byte("b"),
+					[]byte("two"),
+					[]byte{}, []byte{1},
+					[]byte{},
+				)
+		})
+}
+
+func TestManagedMap_InvalidHandle(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						key := host.ManagedTypes().NewManagedBuffer()
+						elrondapi.ManagedMapContainsWithHost(host, 42, key)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(arwen.ErrNoManagedMapUnderThisHandle.Error())
+		})
+}
+
+func finishManagedBuffer(host arwen.VMHost, mBufferHandle int32) {
+	data, err := host.ManagedTypes().GetBytes(mBufferHandle)
+	if err != nil {
+		arwen.WithFaultAndHost(host, err, true)
+		return
+	}
+	host.Output().Finish(data)
+}
+
+func finishManagedVec(host arwen.VMHost, managedVecHandle int32) {
+	items, _, err := host.ManagedTypes().ReadManagedVecOfManagedBuffers(managedVecHandle)
+	if err != nil {
+		arwen.WithFaultAndHost(host, err, true)
+		return
+	}
+	for _, item := range items {
+		host.Output().Finish(item)
+	}
+}
+
+func TestManagedMap_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"mMapNew",
+			"mMapPut",
+			"mMapGet",
+			"mMapContains",
+			"mMapRemove",
+			"mMapLen",
+			"mMapKeys",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.ManagedMapEnableEpoch = test.UnreachedEpochForTests
+		})
+}
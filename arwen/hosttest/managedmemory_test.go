@@ -0,0 +1,212 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagedMemory_FreeReleasesHandles(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						bigIntHandle := managedType.NewBigIntFromInt64(42)
+						mBufferHandle := managedType.NewManagedBufferFromBytes([]byte("abc"))
+						mMapHandle := managedType.NewManagedMap()
+						numHandles, numBytes := managedType.GetResidentMemory()
+						finishInt64(output, int64(numHandles))
+						finishInt64(output, int64(numBytes))
+
+						finishInt64(output, int64(elrondapi.BigIntFreeWithHost(host, bigIntHandle)))
+						finishInt64(output, int64(elrondapi.ManagedBufferFreeWithHost(host, mBufferHandle)))
+						finishInt64(output, int64(elrondapi.ManagedMapFreeWithHost(host, mMapHandle)))
+						numHandles, numBytes = managedType.GetResidentMemory()
+						finishInt64(output, int64(numHandles))
+						finishInt64(output, int64(numBytes))
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		WithEnableEpochs(enableManagedMemoryLimits).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					big.NewInt(3).Bytes(),
+					big.NewInt(4).Bytes(),
+					[]byte{},
+					[]byte{},
+					[]byte{},
+					[]byte{},
+					[]byte{},
+				)
+		})
+}
+
+func TestManagedMemory_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		setup    func(parameters *arwen.VMHostParameters)
+		call     func(host arwen.VMHost)
+		expected error
+	}{
+		{
+			name:  "free missing big int",
+			setup: func(parameters *arwen.VMHostParameters) {},
+			call: func(host arwen.VMHost) {
+				elrondapi.BigIntFreeWithHost(host, 123)
+			},
+			expected: arwen.ErrNoBigIntUnderThisHandle,
+		},
+		{
+			name:  "free managed buffer twice",
+			setup: func(parameters *arwen.VMHostParameters) {},
+			call: func(host arwen.VMHost) {
+				mBufferHandle := host.ManagedTypes().NewManagedBuffer()
+				elrondapi.ManagedBufferFreeWithHost(host, mBufferHandle)
+				elrondapi.ManagedBufferFreeWithHost(host, mBufferHandle)
+			},
+			expected: arwen.ErrNoManagedBufferUnderThisHandle,
+		},
+		{
+			name: "too many handles",
+			setup: func(parameters *arwen.VMHostParameters) {
+				parameters.MaxManagedHandles = 2
+			},
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				for i := 0; i < 3; i++ {
+					managedType.NewBigIntFromInt64(int64(i))
+				}
+			},
+			expected: arwen.ErrTooManyManagedHandles,
+		},
+		{
+			name: "managed memory exhausted",
+			setup: func(parameters *arwen.VMHostParameters) {
+				parameters.MaxManagedBytes = 16
+			},
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				mBufferHandle := managedType.NewManagedBufferFromBytes(make([]byte, 10))
+				_ = managedType.AppendBytes(mBufferHandle, make([]byte, 10))
+			},
+			expected: arwen.ErrManagedMemoryExhausted,
+		},
+		{
+			name: "managed memory exhausted by big ints",
+			setup: func(parameters *arwen.VMHostParameters) {
+				parameters.MaxManagedBytes = 16
+			},
+			call: func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				bigIntHandle := managedType.NewBigIntFromInt64(1)
+				bigInt, _ := managedType.GetBigInt(bigIntHandle)
+				bigInt.Lsh(bigInt, 200)
+				_ = managedType.NewManagedBuffer()
+			},
+			expected: arwen.ErrManagedMemoryExhausted,
+		},
+	}
+
+	for _, testCase := range testCases {
+		setup := testCase.setup
+		call := testCase.call
+		expected := testCase.expected
+		t.Run(testCase.name, func(t *testing.T) {
+			test.BuildMockInstanceCallTest(t).
+				WithContracts(
+					test.CreateMockContract(test.ParentAddress).
+						WithBalance(1000).
+						WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+							parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+								call(parentInstance.Host)
+								return parentInstance
+							})
+						}),
+				).
+				WithInput(test.CreateTestContractCallInputBuilder().
+					WithRecipientAddr(test.ParentAddress).
+					WithGasProvided(100000).
+					WithFunction("testFunction").
+					Build()).
+				WithEnableEpochs(func(parameters *arwen.VMHostParameters) {
+					enableManagedMemoryLimits(parameters)
+					setup(parameters)
+				}).
+				AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+					verify.ExecutionFailed().
+						HasRuntimeErrors(expected.Error())
+				})
+		})
+	}
+}
+
+func TestManagedMemory_NoLimitsBeforeEnableEpoch(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						managedType := parentInstance.Host.ManagedTypes()
+						for i := 0; i < 3; i++ {
+							managedType.NewBigIntFromInt64(int64(i))
+						}
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		WithEnableEpochs(func(parameters *arwen.VMHostParameters) {
+			parameters.MaxManagedHandles = 2
+		}).
+		WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {
+			host.Metering().GasSchedule().ManagedMemoryAPICost.ManagedHandleAllocation = 1000
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			// neither the cap nor the allocation gas apply
+			verify.Ok()
+			require.Greater(t, verify.VmOutput.GasRemaining, uint64(100000-1000))
+		})
+}
+
+func enableManagedMemoryLimits(parameters *arwen.VMHostParameters) {
+	parameters.ManagedMemoryLimitsEnableEpoch = 0
+}
+
+func TestManagedMemory_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"bigIntFree",
+			"mBufferFree",
+			"bigFloatFree",
+			"mMapFree",
+			"freeEC",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.ManagedMemoryFreeEnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -0,0 +1,124 @@
+package hosttest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/cryptoapi"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/hashing"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/merkle"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createMerkleTree(t *testing.T, hashAlgo int32, numLeaves int) (*merkle.Tree, [][]byte) {
+	hasher := hashing.NewHasher()
+	leaves := make([][]byte, numLeaves)
+	for i := range leaves {
+		leaves[i], _ = hasher.Keccak256([]byte(fmt.Sprintf("airdrop recipient %d", i)))
+	}
+
+	tree, err := merkle.NewTree(hasher, hashAlgo, leaves)
+	require.Nil(t, err)
+	return tree, leaves
+}
+
+func verifyMerkleProof(host arwen.VMHost, root []byte, leaf []byte, proof [][]byte, index []byte, hashAlgo int32) int32 {
+	managedType := host.ManagedTypes()
+	rootHandle := managedType.NewManagedBufferFromBytes(root)
+	leafHandle := managedType.NewManagedBufferFromBytes(leaf)
+	proofHandle := newManagedVecOfBuffers(host, proof)
+	indexHandle := managedType.NewManagedBufferFromBytes(index)
+
+	return cryptoapi.ManagedVerifyMerkleProofWithHost(host, rootHandle, leafHandle, proofHandle, indexHandle, hashAlgo)
+}
+
+func TestManagedMerkle_VerifyMerkleProof(t *testing.T) {
+	hashAlgorithms := []int32{merkle.Sha256, merkle.Keccak256, merkle.Sha256 | merkle.SortedPairs, merkle.Keccak256 | merkle.SortedPairs}
+	for _, hashAlgo := range hashAlgorithms {
+		tree, leaves := createMerkleTree(t, hashAlgo, 11)
+		proof, index, err := tree.Proof(6)
+		require.Nil(t, err)
+
+		runSignatureTest(t,
+			func(host arwen.VMHost) {
+				result := verifyMerkleProof(host, tree.Root(), leaves[6], proof, index, hashAlgo)
+				finishInt64(host.Output(), int64(result))
+			},
+			func(verify *test.VMOutputVerifier) {
+				verify.Ok().
+					ReturnData([]byte{})
+			})
+	}
+}
+
+func TestManagedMerkle_VerifyMerkleProof_WrongLeaf(t *testing.T) {
+	tree, leaves := createMerkleTree(t, merkle.Keccak256|merkle.SortedPairs, 11)
+	proof, index, err := tree.Proof(6)
+	require.Nil(t, err)
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			verifyMerkleProof(host, tree.Root(), leaves[7], proof, index, merkle.Keccak256|merkle.SortedPairs)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(merkle.ErrProofVerificationFailed.Error())
+		})
+}
+
+func TestManagedMerkle_VerifyMerkleProof_InvalidHashAlgorithm(t *testing.T) {
+	tree, leaves := createMerkleTree(t, merkle.Sha256, 4)
+	proof, index, err := tree.Proof(1)
+	require.Nil(t, err)
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			verifyMerkleProof(host, tree.Root(), leaves[1], proof, index, 7)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(merkle.ErrInvalidHashAlgorithm.Error())
+		})
+}
+
+func TestManagedMerkle_VerifyMerkleProof_GasScalesWithDepth(t *testing.T) {
+	shallowTree, shallowLeaves := createMerkleTree(t, merkle.Sha256, 2)
+	shallowProof, shallowIndex, err := shallowTree.Proof(1)
+	require.Nil(t, err)
+	deepTree, deepLeaves := createMerkleTree(t, merkle.Sha256, 16)
+	deepProof, deepIndex, err := deepTree.Proof(9)
+	require.Nil(t, err)
+
+	var gasForShallowProof, gasForDeepProof, costPerStep uint64
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			metering := host.Metering()
+
+			gasLeft := metering.GasLeft()
+			verifyMerkleProof(host, shallowTree.Root(), shallowLeaves[1], shallowProof, shallowIndex, merkle.Sha256)
+			gasForShallowProof = gasLeft - metering.GasLeft()
+
+			gasLeft = metering.GasLeft()
+			verifyMerkleProof(host, deepTree.Root(), deepLeaves[9], deepProof, deepIndex, merkle.Sha256)
+			gasForDeepProof = gasLeft - metering.GasLeft()
+
+			costPerStep = metering.GasSchedule().CryptoAPICost.MerkleProofPerStep
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+
+	require.GreaterOrEqual(t, gasForDeepProof-gasForShallowProof, 3*costPerStep)
+}
+
+func TestManagedMerkle_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"managedVerifyMerkleProof",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.MerkleProofEnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -0,0 +1,103 @@
+package hosttest
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	arwenMath "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/math"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagedRandomness_FrameRandomSeed(t *testing.T) {
+	var txRandomSeed []byte
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+
+						blockchain := host.Blockchain()
+						txRandomSeed = append(txRandomSeed, blockchain.LastRandomSeed()...)
+						txRandomSeed = append(txRandomSeed, blockchain.CurrentRandomSeed()...)
+						txRandomSeed = append(txRandomSeed, host.Runtime().GetCurrentTxHash()...)
+
+						seed := managedType.NewManagedBuffer()
+						elrondapi.ManagedGetFrameRandomSeedWithHost(host, seed)
+						finishManagedBuffer(host, seed)
+
+						random := make([]byte, 16)
+						_, _ = managedType.GetRandReader().Read(random)
+						host.Output().Finish(random)
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		WithEnableEpochs(enableSecureRandomness).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			frameRandomSeed := arwenMath.DeriveFrameRandomSeed(txRandomSeed, 0, test.ParentAddress)
+			random := make([]byte, 16)
+			_, _ = arwenMath.NewChaCha20RandReader(frameRandomSeed).Read(random)
+
+			verify.Ok().
+				ReturnData(frameRandomSeed, random)
+		})
+}
+
+// runRandomnessMigrationTest returns the randomness read by a contract, together
+// with the randomness the same transaction reads from the legacy generator
+func runRandomnessMigrationTest(t *testing.T, setEnableEpochs func(*arwen.VMHostParameters)) ([]byte, []byte) {
+	var randomBytes, legacyRandomBytes []byte
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("readRandomness", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						randomBytes = make([]byte, 32)
+						_, _ = host.ManagedTypes().GetRandReader().Read(randomBytes)
+						legacyRandomBytes = make([]byte, 32)
+						_, _ = buildRandomizer(host).Read(legacyRandomBytes)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("readRandomness").
+			Build()).
+		WithEnableEpochs(setEnableEpochs).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+
+	return randomBytes, legacyRandomBytes
+}
+
+func TestExecution_SecureRandomnessMigration(t *testing.T) {
+	// before the epoch, contracts keep reading the randomness they always did
+	randomBytes, legacyRandomBytes := runRandomnessMigrationTest(t, nil)
+	require.Equal(t, legacyRandomBytes, randomBytes)
+
+	// afterwards, the same transaction reads other random bytes
+	randomBytes, legacyRandomBytes = runRandomnessMigrationTest(t, enableSecureRandomness)
+	require.NotEqual(t, legacyRandomBytes, randomBytes)
+}
+
+func enableSecureRandomness(parameters *arwen.VMHostParameters) {
+	parameters.SecureRandomnessEnableEpoch = 0
+}
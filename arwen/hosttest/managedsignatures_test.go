@@ -0,0 +1,456 @@
+package hosttest
+
+import (
+	libed25519 "crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/cryptoapi"
+	gasSchedules "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwenmandos/gasSchedules"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	elrondSigning "github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	herumiBLS "github.com/herumi/bls-go-binary/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeHex(value string) []byte {
+	bytes, _ := hex.DecodeString(value)
+	return bytes
+}
+
+// the ecrecover vector from the precompile tests of go-ethereum
+var recoverMessageHash = decodeHex("456e9aea5e197a1f1af7a3e85a3212fa4049a3ba34c2289b4c860fc0b0c64ef3")
+var recoverR = decodeHex("9242685bf161793cc25603c231bc2f568eb630ea16aa137d2664ac8038825608")
+var recoverS = decodeHex("4f8ae3bd7535248d0bd448298cc2e2071e56992d0774dc340c368ae950852ada")
+var recoveredKey = decodeHex("04f57c1d4c961024e998eaec4b6bebec90e788ef5ade22e636ce76111b60db107d4c3404b9908a2f357c84ccb48cf412be41d09574a9291c9c7eb5173ccf2a339f")
+
+// the P-256 / SHA-256 example of RFC 6979, appendix A.2.5, over the message "sample"
+var secp256r1Key = decodeHex("0460fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb67903fe1008b8bc99a41ae9e95628bc64f2f1b20c2d7e9f5177a3c294d4462299")
+var secp256r1Sig = decodeHex("3046022100efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716022100f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8")
+
+func runSignatureTest(t *testing.T, testFunction func(host arwen.VMHost), assertResults func(verify *test.VMOutputVerifier)) {
+	runSignatureTestWithCrypto(t, nil, testFunction, assertResults)
+}
+
+func runSignatureTestWithCrypto(t *testing.T, vmCrypto crypto.VMCrypto, testFunction func(host arwen.VMHost), assertResults func(verify *test.VMOutputVerifier)) {
+	test.BuildMockInstanceCallTest(t).
+		WithCrypto(vmCrypto).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						testFunction(parentInstance.Host)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(10000000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			assertResults(verify)
+		})
+}
+
+func TestManagedSignatures_RecoverSecp256k1(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			messageHashHandle := managedType.NewManagedBufferFromBytes(recoverMessageHash)
+			rHandle := managedType.NewManagedBufferFromBytes(recoverR)
+			sHandle := managedType.NewManagedBufferFromBytes(recoverS)
+			resultHandle := managedType.NewManagedBuffer()
+
+			result := cryptoapi.ManagedRecoverSecp256k1WithHost(host, messageHashHandle, rHandle, sHandle, 28, resultHandle)
+			finishInt64(host.Output(), int64(result))
+			finishManagedBuffer(host, resultHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{}, recoveredKey)
+		})
+}
+
+func TestManagedSignatures_RecoverSecp256k1_InvalidRecoveryID(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			messageHashHandle := managedType.NewManagedBufferFromBytes(recoverMessageHash)
+			rHandle := managedType.NewManagedBufferFromBytes(recoverR)
+			sHandle := managedType.NewManagedBufferFromBytes(recoverS)
+			resultHandle := managedType.NewManagedBuffer()
+
+			cryptoapi.ManagedRecoverSecp256k1WithHost(host, messageHashHandle, rHandle, sHandle, 30, resultHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(signing.ErrInvalidRecoveryID.Error())
+		})
+}
+
+func TestManagedSignatures_VerifySecp256r1(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			keyHandle := managedType.NewManagedBufferFromBytes(secp256r1Key)
+			messageHandle := managedType.NewManagedBufferFromBytes([]byte("sample"))
+			sigHandle := managedType.NewManagedBufferFromBytes(secp256r1Sig)
+
+			result := cryptoapi.ManagedVerifySecp256r1WithHost(host, keyHandle, messageHandle, sigHandle)
+			finishInt64(host.Output(), int64(result))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{})
+		})
+}
+
+func TestManagedSignatures_VerifySecp256r1_InvalidSignature(t *testing.T) {
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			keyHandle := managedType.NewManagedBufferFromBytes(secp256r1Key)
+			messageHandle := managedType.NewManagedBufferFromBytes([]byte("other message"))
+			sigHandle := managedType.NewManagedBufferFromBytes(secp256r1Sig)
+
+			result := cryptoapi.ManagedVerifySecp256r1WithHost(host, keyHandle, messageHandle, sigHandle)
+			finishInt64(host.Output(), int64(result))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(signing.ErrInvalidSignature.Error())
+		})
+}
+
+func generateBLSSignatures(t *testing.T, numSigners int, msgs [][]byte) ([][]byte, []byte) {
+	keyGenerator := elrondSigning.NewKeyGenerator(mcl.NewSuiteBLS12())
+	signer := singlesig.NewBlsSigner()
+
+	keys := make([][]byte, 0, numSigners)
+	aggregated := &herumiBLS.Sign{}
+	for i := 0; i < numSigners; i++ {
+		privateKey, publicKey := keyGenerator.GeneratePair()
+		key, err := publicKey.ToByteArray()
+		require.Nil(t, err)
+		keys = append(keys, key)
+
+		sig, err := signer.Sign(privateKey, msgs[i])
+		require.Nil(t, err)
+		signature := &herumiBLS.Sign{}
+		require.Nil(t, signature.Deserialize(sig))
+		aggregated.Add(signature)
+	}
+
+	return keys, aggregated.Serialize()
+}
+
+func newManagedVecOfBuffers(host arwen.VMHost, data [][]byte) int32 {
+	managedType := host.ManagedTypes()
+	vecHandle := managedType.NewManagedBuffer()
+	managedType.WriteManagedVecOfManagedBuffers(data, vecHandle)
+	return vecHandle
+}
+
+func TestManagedSignatures_VerifyAggregatedBLS(t *testing.T) {
+	msg := []byte("message")
+	keys, sig := generateBLSSignatures(t, 3, [][]byte{msg, msg, msg})
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			keysHandle := newManagedVecOfBuffers(host, keys)
+			messageHandle := managedType.NewManagedBufferFromBytes(msg)
+			sigHandle := managedType.NewManagedBufferFromBytes(sig)
+
+			result := cryptoapi.ManagedVerifyAggregatedBLSWithHost(host, keysHandle, messageHandle, sigHandle)
+			finishInt64(host.Output(), int64(result))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{})
+		})
+}
+
+func TestManagedSignatures_VerifyAggregatedBLS_DuplicatedKey(t *testing.T) {
+	msg := []byte("message")
+	keys, sig := generateBLSSignatures(t, 2, [][]byte{msg, msg})
+	keys = append(keys, keys[0])
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			keysHandle := newManagedVecOfBuffers(host, keys)
+			messageHandle := managedType.NewManagedBufferFromBytes(msg)
+			sigHandle := managedType.NewManagedBufferFromBytes(sig)
+
+			cryptoapi.ManagedVerifyAggregatedBLSWithHost(host, keysHandle, messageHandle, sigHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(signing.ErrDuplicatedPublicKey.Error())
+		})
+}
+
+func TestManagedSignatures_VerifyAggregatedDistinctMessagesBLS(t *testing.T) {
+	msgs := [][]byte{[]byte("first"), []byte("second"), []byte("third")}
+	keys, sig := generateBLSSignatures(t, 3, msgs)
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			keysHandle := newManagedVecOfBuffers(host, keys)
+			messagesHandle := newManagedVecOfBuffers(host, msgs)
+			sigHandle := host.ManagedTypes().NewManagedBufferFromBytes(sig)
+
+			result := cryptoapi.ManagedVerifyAggregatedDistinctMessagesBLSWithHost(host, keysHandle, messagesHandle, sigHandle)
+			finishInt64(host.Output(), int64(result))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{})
+		})
+}
+
+func TestManagedSignatures_VerifyAggregatedDistinctMessagesBLS_Mismatch(t *testing.T) {
+	msgs := [][]byte{[]byte("first"), []byte("second"), []byte("third")}
+	keys, sig := generateBLSSignatures(t, 3, msgs)
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			keysHandle := newManagedVecOfBuffers(host, keys)
+			messagesHandle := newManagedVecOfBuffers(host, msgs[:2])
+			sigHandle := host.ManagedTypes().NewManagedBufferFromBytes(sig)
+
+			cryptoapi.ManagedVerifyAggregatedDistinctMessagesBLSWithHost(host, keysHandle, messagesHandle, sigHandle)
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(signing.ErrKeysAndMessagesMismatch.Error())
+		})
+}
+
+func TestManagedSignatures_VerifyAggregatedBLS_GasScalesWithSigners(t *testing.T) {
+	msg := []byte("message")
+	gasUsedForSigners := func(numSigners int) uint64 {
+		msgs := make([][]byte, numSigners)
+		for i := range msgs {
+			msgs[i] = msg
+		}
+		keys, sig := generateBLSSignatures(t, numSigners, msgs)
+
+		var gasUsed uint64
+		runSignatureTest(t,
+			func(host arwen.VMHost) {
+				managedType := host.ManagedTypes()
+				keysHandle := newManagedVecOfBuffers(host, keys)
+				messageHandle := managedType.NewManagedBufferFromBytes(msg)
+				sigHandle := managedType.NewManagedBufferFromBytes(sig)
+
+				gasLeft := host.Metering().GasLeft()
+				cryptoapi.ManagedVerifyAggregatedBLSWithHost(host, keysHandle, messageHandle, sigHandle)
+				gasUsed = gasLeft - host.Metering().GasLeft()
+			},
+			func(verify *test.VMOutputVerifier) {
+				verify.Ok()
+			})
+		return gasUsed
+	}
+
+	gasForOneSigner := gasUsedForSigners(1)
+	gasForThreeSigners := gasUsedForSigners(3)
+	require.Greater(t, gasForThreeSigners, gasForOneSigner)
+}
+
+func generateEd25519Signatures(numSigners int) ([][]byte, [][]byte, [][]byte) {
+	keys := make([][]byte, numSigners)
+	msgs := make([][]byte, numSigners)
+	sigs := make([][]byte, numSigners)
+	for i := 0; i < numSigners; i++ {
+		seed := make([]byte, libed25519.SeedSize)
+		seed[0] = byte(i)
+		privateKey := libed25519.NewKeyFromSeed(seed)
+
+		keys[i] = privateKey.Public().(libed25519.PublicKey)
+		msgs[i] = []byte(fmt.Sprintf("price %d", i))
+		sigs[i] = libed25519.Sign(privateKey, msgs[i])
+	}
+	return keys, msgs, sigs
+}
+
+func TestManagedSignatures_VerifyEd25519Batch(t *testing.T) {
+	keys, msgs, sigs := generateEd25519Signatures(5)
+	invalidSigs := append([][]byte{}, sigs...)
+	invalidSigs[3] = sigs[2]
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			keysHandle := newManagedVecOfBuffers(host, keys)
+			messagesHandle := newManagedVecOfBuffers(host, msgs)
+			sigsHandle := newManagedVecOfBuffers(host, sigs)
+			invalidSigsHandle := newManagedVecOfBuffers(host, invalidSigs)
+			invalidIndexHandle := managedType.NewBigIntFromInt64(42)
+
+			result := cryptoapi.ManagedVerifyEd25519BatchWithHost(host, keysHandle, messagesHandle, sigsHandle, invalidIndexHandle)
+			require.Equal(t, int32(0), result)
+			finishInt64(host.Output(), managedType.GetBigIntOrCreate(invalidIndexHandle).Int64())
+
+			result = cryptoapi.ManagedVerifyEd25519BatchWithHost(host, keysHandle, messagesHandle, invalidSigsHandle, invalidIndexHandle)
+			require.Equal(t, int32(-1), result)
+			finishInt64(host.Output(), managedType.GetBigIntOrCreate(invalidIndexHandle).Int64())
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{42}, []byte{3})
+		})
+}
+
+func TestManagedSignatures_VerifyEd25519Batch_FirstSignatureInvalid(t *testing.T) {
+	keys, msgs, sigs := generateEd25519Signatures(3)
+	invalidSigs := append([][]byte{}, sigs...)
+	invalidSigs[0] = sigs[1]
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			managedType := host.ManagedTypes()
+			keysHandle := newManagedVecOfBuffers(host, keys)
+			messagesHandle := newManagedVecOfBuffers(host, msgs)
+			sigsHandle := newManagedVecOfBuffers(host, invalidSigs)
+			invalidIndexHandle := managedType.NewBigIntFromInt64(42)
+
+			result := cryptoapi.ManagedVerifyEd25519BatchWithHost(host, keysHandle, messagesHandle, sigsHandle, invalidIndexHandle)
+			require.Equal(t, int32(-1), result)
+			finishInt64(host.Output(), managedType.GetBigIntOrCreate(invalidIndexHandle).Int64())
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{})
+		})
+}
+
+func TestManagedSignatures_VerifyEd25519Batch_Mismatch(t *testing.T) {
+	keys, msgs, sigs := generateEd25519Signatures(3)
+
+	runSignatureTest(t,
+		func(host arwen.VMHost) {
+			keysHandle := newManagedVecOfBuffers(host, keys)
+			messagesHandle := newManagedVecOfBuffers(host, msgs)
+			sigsHandle := newManagedVecOfBuffers(host, sigs[:2])
+
+			cryptoapi.ManagedVerifyEd25519BatchWithHost(host, keysHandle, messagesHandle, sigsHandle, host.ManagedTypes().NewBigIntFromInt64(0))
+		},
+		func(verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(signing.ErrKeysAndSignaturesMismatch.Error())
+		})
+}
+
+// runEd25519BatchGasTest measures the gas used by a batch verification with the V4 gas schedule
+func runEd25519BatchGasTest(t *testing.T, keys [][]byte, msgs [][]byte, sigs [][]byte) (uint64, *config.GasCost) {
+	gasSchedule, err := gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
+	require.Nil(t, err)
+
+	var batchGas uint64
+	var gasCost *config.GasCost
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						metering := host.Metering()
+						metering.SetGasSchedule(gasSchedule)
+						gasCost = metering.GasSchedule()
+
+						keysHandle := newManagedVecOfBuffers(host, keys)
+						messagesHandle := newManagedVecOfBuffers(host, msgs)
+						sigsHandle := newManagedVecOfBuffers(host, sigs)
+
+						gasLeft := metering.GasLeft()
+						cryptoapi.ManagedVerifyEd25519BatchWithHost(host, keysHandle, messagesHandle, sigsHandle, managedType.NewBigIntFromInt64(0))
+						batchGas = gasLeft - metering.GasLeft()
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+
+	return batchGas, gasCost
+}
+
+func TestManagedSignatures_VerifyEd25519Batch_CheaperThanSingleVerify(t *testing.T) {
+	numSigners := 8
+	keys, msgs, sigs := generateEd25519Signatures(numSigners)
+
+	batchGas, gasCost := runEd25519BatchGasTest(t, keys, msgs, sigs)
+	require.Less(t, batchGas, uint64(numSigners)*gasCost.CryptoAPICost.VerifyEd25519)
+
+	verificationGas := gasCost.CryptoAPICost.VerifyEd25519Batch + uint64(numSigners)*gasCost.CryptoAPICost.Ed25519BatchPerSig
+	require.GreaterOrEqual(t, batchGas, verificationGas)
+}
+
+func TestManagedSignatures_VerifyEd25519Batch_InvalidSignatureChargesSingleVerifies(t *testing.T) {
+	numSigners := 8
+	keys, msgs, sigs := generateEd25519Signatures(numSigners)
+	invalidSigs := append([][]byte{}, sigs...)
+	invalidSigs[3] = sigs[2]
+
+	validBatchGas, _ := runEd25519BatchGasTest(t, keys, msgs, sigs)
+	invalidBatchGas, gasCost := runEd25519BatchGasTest(t, keys, msgs, invalidSigs)
+	require.Equal(t, validBatchGas+4*gasCost.CryptoAPICost.VerifyEd25519, invalidBatchGas)
+}
+
+func TestManagedSignatures_Secp256ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"recoverSecp256k1",
+			"managedRecoverSecp256k1",
+			"verifySecp256r1",
+			"managedVerifySecp256r1",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.Secp256ExtensionsEnableEpoch = test.UnreachedEpochForTests
+		})
+}
+
+func TestManagedSignatures_AggregatedBLSImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"managedVerifyAggregatedBLS",
+			"managedVerifyAggregatedDistinctMessagesBLS",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.AggregatedBLSEnableEpoch = test.UnreachedEpochForTests
+		})
+}
+
+func TestManagedSignatures_Ed25519BatchImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"managedVerifyEd25519Batch",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.Ed25519BatchEnableEpoch = test.UnreachedEpochForTests
+		})
+}
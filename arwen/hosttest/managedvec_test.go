@@ -0,0 +1,167 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+)
+
+func TestManagedVec_Handles(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						mVec := elrondapi.ManagedVecNewWithHost(host)
+						for _, item := range []string{"a", "b", "c", "d"} {
+							elrondapi.ManagedVecPushWithHost(host, mVec, managedType.NewManagedBufferFromBytes([]byte(item)))
+						}
+						finishInt64(output, int64(elrondapi.ManagedVecLenWithHost(host, mVec)))
+						finishManagedBuffer(host, elrondapi.ManagedVecGetWithHost(host, mVec, 2))
+
+						elrondapi.ManagedVecSetWithHost(host, mVec, 0, managedType.NewManagedBufferFromBytes([]byte("z")))
+						elrondapi.ManagedVecRemoveWithHost(host, mVec, 1)
+						finishManagedVec(host, mVec)
+
+						slice := managedType.NewManagedBuffer()
+						elrondapi.ManagedVecSliceWithHost(host, mVec, 1, 3, slice)
+						elrondapi.ManagedVecConcatWithHost(host, slice, mVec)
+						finishManagedVec(host, slice)
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					[]byte{4},
+					[]byte("c"),
+					[]byte("z"), []byte("c"), []byte("d"),
+					[]byte("c"), []byte("d"), []byte("z"), []byte("c"), []byte("d"),
+				)
+		})
+}
+
+func TestManagedVec_BigIntsAndU64(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+
+						bigInts := elrondapi.ManagedVecNewWithHost(host)
+						value := managedType.NewBigIntFromInt64(7)
+						elrondapi.ManagedVecPushBigIntWithHost(host, bigInts, value)
+						// the vec holds a copy of the pushed big int
+						managedType.GetBigIntOrCreate(value).SetInt64(8)
+						elrondapi.ManagedVecPushBigIntWithHost(host, bigInts, value)
+						elrondapi.ManagedVecSetBigIntWithHost(host, bigInts, 1, managedType.NewBigIntFromInt64(9))
+
+						result := managedType.NewBigIntFromInt64(0)
+						for i := int32(0); i < elrondapi.ManagedVecLenWithHost(host, bigInts); i++ {
+							elrondapi.ManagedVecGetBigIntWithHost(host, bigInts, i, result)
+							output.Finish(managedType.GetBigIntOrCreate(result).Bytes())
+						}
+
+						u64s := elrondapi.ManagedVecNewWithHost(host)
+						for _, item := range []int64{10, 20, 30} {
+							elrondapi.ManagedVecPushU64WithHost(host, u64s, item)
+						}
+						elrondapi.ManagedVecSetU64WithHost(host, u64s, 2, 40)
+						elrondapi.ManagedVecRemoveU64WithHost(host, u64s, 0)
+						finishInt64(output, int64(elrondapi.ManagedVecLenU64WithHost(host, u64s)))
+
+						slice := managedType.NewManagedBuffer()
+						elrondapi.ManagedVecSliceU64WithHost(host, u64s, 1, 2, slice)
+						finishInt64(output, elrondapi.ManagedVecGetU64WithHost(host, slice, 0))
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					big.NewInt(7).Bytes(), big.NewInt(9).Bytes(),
+					[]byte{2},
+					[]byte{40},
+				)
+		})
+}
+
+func TestManagedVec_IndexOutOfBounds(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						mVec := elrondapi.ManagedVecNewWithHost(host)
+						elrondapi.ManagedVecPushU64WithHost(host, mVec, 1)
+						elrondapi.ManagedVecGetU64WithHost(host, mVec, 1)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(arwen.ErrBadBounds.Error())
+		})
+}
+
+func TestManagedVec_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"mVecNew",
+			"mVecPush",
+			"mVecGet",
+			"mVecSet",
+			"mVecRemove",
+			"mVecLen",
+			"mVecSlice",
+			"mVecConcat",
+			"mVecPushBigInt",
+			"mVecGetBigInt",
+			"mVecSetBigInt",
+			"mVecPushU64",
+			"mVecGetU64",
+			"mVecSetU64",
+			"mVecRemoveU64",
+			"mVecLenU64",
+			"mVecSliceU64",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.ManagedVecEnableEpoch = test.UnreachedEpochForTests
+		})
+}
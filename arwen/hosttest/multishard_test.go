@@ -0,0 +1,246 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/txDataBuilder"
+	"github.com/stretchr/testify/require"
+)
+
+var multiShardChildKey = []byte("childKey........................")
+var multiShardCallbackKey = []byte("callbackKey.....................")
+
+const multiShardParentBalance = 1000
+const multiShardValueToChild = 10
+
+func multiShardParentMethods(parentInstance *mock.InstanceMock, config interface{}) {
+	parentInstance.AddMockMethod("performAsyncCall", func() *mock.InstanceMock {
+		host := parentInstance.Host
+		instance := mock.GetMockInstance(host)
+
+		callData := txDataBuilder.NewBuilder()
+		callData.Func("receiveAsync")
+		callData.Bytes(host.Runtime().Arguments()[0])
+
+		value := big.NewInt(multiShardValueToChild).Bytes()
+		err := host.Runtime().ExecuteAsyncCall(test.ChildAddress, callData.ToBytes(), value)
+		require.Nil(instance.T, err)
+
+		return instance
+	})
+	parentInstance.AddMockMethod("callBack", func() *mock.InstanceMock {
+		host := parentInstance.Host
+		arguments := host.Runtime().Arguments()
+		_, _ = host.Storage().SetStorage(multiShardCallbackKey, arguments[len(arguments)-1])
+		return parentInstance
+	})
+}
+
+func multiShardChildMethods(childInstance *mock.InstanceMock, config interface{}) {
+	childInstance.AddMockMethod("receiveAsync", func() *mock.InstanceMock {
+		host := childInstance.Host
+		instance := mock.GetMockInstance(host)
+		argument := host.Runtime().Arguments()[0]
+		if string(argument) == "fail" {
+			host.Runtime().SignalUserError("child failed")
+			return instance
+		}
+
+		_, _ = host.Storage().SetStorage(multiShardChildKey, argument)
+		host.Output().Finish([]byte("child result"))
+		return instance
+	})
+}
+
+func buildMultiShardAsyncCallTest(t *testing.T, childArgument string) *test.MultiShardMockInstancesTestTemplate {
+	return test.BuildMultiShardMockInstanceCallTest(t, 2).
+		WithContracts(
+			test.CreateMockContractOnShard(test.ParentAddress, 0).
+				WithBalance(multiShardParentBalance).
+				WithMethods(multiShardParentMethods),
+			test.CreateMockContractOnShard(test.ChildAddress, 1).
+				WithBalance(0).
+				WithMethods(multiShardChildMethods),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(test.GasProvided).
+			WithFunction("performAsyncCall").
+			WithArguments([]byte(childArgument)).
+			Build())
+}
+
+func requireStorage(t *testing.T, sim *worldmock.MultiShardSimulator, address []byte, key []byte, expected []byte) {
+	account := sim.GetAccount(address)
+	require.NotNil(t, account)
+	require.Equal(t, expected, account.StorageValue(string(key)))
+}
+
+func TestMultiShard_AsyncCall_CrossShard(t *testing.T) {
+	buildMultiShardAsyncCallTest(t, "child data").
+		AndAssertResults(func(sim *worldmock.MultiShardSimulator, verify *test.VMOutputVerifier) {
+			verify.Ok()
+
+			require.Equal(t, uint64(2), sim.CurrentRound())
+			require.Len(t, sim.Executions, 3)
+			require.Equal(t, uint32(1), sim.Executions[1].ShardID)
+			require.Equal(t, vm.AsynchronousCall, sim.Executions[1].SCR.CallType)
+			require.Equal(t, uint32(0), sim.Executions[2].ShardID)
+			require.Equal(t, vm.AsynchronousCallBack, sim.Executions[2].SCR.CallType)
+			for _, execution := range sim.Executions {
+				require.True(t, execution.Succeeded())
+			}
+
+			requireStorage(t, sim, test.ChildAddress, multiShardChildKey, []byte("child data"))
+			requireStorage(t, sim, test.ParentAddress, multiShardCallbackKey, []byte("child result"))
+			require.Equal(t, big.NewInt(multiShardValueToChild), sim.GetAccount(test.ChildAddress).Balance)
+			require.Equal(t, big.NewInt(multiShardParentBalance-multiShardValueToChild), sim.GetAccount(test.ParentAddress).Balance)
+		})
+}
+
+func TestMultiShard_AsyncCall_CrossShard_ChildFails(t *testing.T) {
+	buildMultiShardAsyncCallTest(t, "fail").
+		AndAssertResults(func(sim *worldmock.MultiShardSimulator, verify *test.VMOutputVerifier) {
+			verify.Ok()
+
+			require.Len(t, sim.Executions, 3)
+			require.False(t, sim.Executions[1].Succeeded())
+			require.True(t, sim.Executions[2].Succeeded())
+			require.True(t, sim.Executions[2].Input.ReturnCallAfterError)
+
+			requireStorage(t, sim, test.ChildAddress, multiShardChildKey, []byte{})
+			requireStorage(t, sim, test.ParentAddress, multiShardCallbackKey, []byte("child failed"))
+			require.Equal(t, big.NewInt(0), sim.GetAccount(test.ChildAddress).Balance)
+			require.Equal(t, big.NewInt(multiShardParentBalance), sim.GetAccount(test.ParentAddress).Balance)
+		})
+}
+
+func TestMultiShard_AsyncCall_PausedShard(t *testing.T) {
+	buildMultiShardAsyncCallTest(t, "child data").
+		WithDelivery(func(sim *worldmock.MultiShardSimulator) {
+			sim.PauseShard(0)
+			numRounds, err := sim.RunUntilQuiescent(test.DefaultMaxRoundsForMultiShardTests)
+			require.Nil(t, err)
+			require.Equal(t, 1, numRounds)
+
+			requireStorage(t, sim, test.ChildAddress, multiShardChildKey, []byte("child data"))
+			requireStorage(t, sim, test.ParentAddress, multiShardCallbackKey, []byte{})
+			pendingCallbacks := sim.PendingResults(0)
+			require.Len(t, pendingCallbacks, 1)
+			require.Equal(t, vm.AsynchronousCallBack, pendingCallbacks[0].CallType)
+			require.Equal(t, test.ChildAddress, pendingCallbacks[0].Sender)
+
+			delivered, err := sim.DeliverNext(0)
+			require.Nil(t, err)
+			require.True(t, delivered)
+			require.Equal(t, 0, sim.NumPendingResults())
+		}).
+		AndAssertResults(func(sim *worldmock.MultiShardSimulator, verify *test.VMOutputVerifier) {
+			verify.Ok()
+			requireStorage(t, sim, test.ParentAddress, multiShardCallbackKey, []byte("child result"))
+		})
+}
+
+func runMultiShardPromiseWithESDTTest(
+	t *testing.T,
+	childFails bool,
+	assertResults func(sim *worldmock.MultiShardSimulator, verify *test.VMOutputVerifier),
+) {
+	test.BuildMultiShardMockInstanceCallTest(t, 2).
+		WithContracts(
+			test.CreateMockContractOnShard(test.ParentAddress, 0).
+				WithBalance(multiShardParentBalance).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						createPromiseWithESDT(parentInstance.Host, test.ChildAddress, 0)
+						return mock.GetMockInstance(parentInstance.Host)
+					})
+					parentInstance.AddMockMethod("onSuccess", func() *mock.InstanceMock {
+						_, _ = parentInstance.Host.Storage().SetStorage(multiShardCallbackKey, []byte("success"))
+						return parentInstance
+					})
+					parentInstance.AddMockMethod("onError", func() *mock.InstanceMock {
+						_, _ = parentInstance.Host.Storage().SetStorage(multiShardCallbackKey, []byte("error"))
+						return parentInstance
+					})
+				}),
+			test.CreateMockContractOnShard(test.ChildAddress, 1).
+				WithBalance(0).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("receiveTokens", func() *mock.InstanceMock {
+						if childFails {
+							childInstance.Host.Runtime().SignalUserError("tokens refused")
+						}
+						return mock.GetMockInstance(childInstance.Host)
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(promiseGasProvided).
+			WithFunction("testFunction").
+			Build()).
+		WithSetup(func(sim *worldmock.MultiShardSimulator) {
+			parentAccount := sim.GetAccount(test.ParentAddress)
+			_ = parentAccount.SetTokenBalanceUint64(test.ESDTTestTokenName, 0, promiseInitialESDTBalance)
+		}).
+		AndAssertResults(assertResults)
+}
+
+func TestMultiShard_PromiseWithESDT_Success(t *testing.T) {
+	runMultiShardPromiseWithESDTTest(t, false,
+		func(sim *worldmock.MultiShardSimulator, verify *test.VMOutputVerifier) {
+			verify.Ok()
+
+			parentBalance, _ := sim.GetAccount(test.ParentAddress).GetTokenBalanceUint64(test.ESDTTestTokenName, 0)
+			require.Equal(t, promiseInitialESDTBalance-promiseESDTValue, parentBalance)
+			childBalance, _ := sim.GetAccount(test.ChildAddress).GetTokenBalanceUint64(test.ESDTTestTokenName, 0)
+			require.Equal(t, promiseESDTValue, childBalance)
+
+			requireStorage(t, sim, test.ParentAddress, multiShardCallbackKey, []byte("success"))
+		})
+}
+
+func TestMultiShard_PromiseWithESDT_ErrorCallbackRefund(t *testing.T) {
+	runMultiShardPromiseWithESDTTest(t, true,
+		func(sim *worldmock.MultiShardSimulator, verify *test.VMOutputVerifier) {
+			verify.Ok()
+
+			parentBalance, _ := sim.GetAccount(test.ParentAddress).GetTokenBalanceUint64(test.ESDTTestTokenName, 0)
+			require.Equal(t, promiseInitialESDTBalance, parentBalance)
+			childBalance, _ := sim.GetAccount(test.ChildAddress).GetTokenBalanceUint64(test.ESDTTestTokenName, 0)
+			require.Equal(t, uint64(0), childBalance)
+
+			lastExecution := sim.Executions[len(sim.Executions)-1]
+			require.Equal(t, vmcommon.Ok, lastExecution.VMOutput.ReturnCode)
+			requireStorage(t, sim, test.ParentAddress, multiShardCallbackKey, []byte("error"))
+		})
+}
+
+func TestMultiShard_AsyncCall_ShardOrder(t *testing.T) {
+	buildMultiShardAsyncCallTest(t, "child data").
+		WithDelivery(func(sim *worldmock.MultiShardSimulator) {
+			sim.SetShardOrder(1)
+			numRounds, err := sim.RunUntilQuiescent(test.DefaultMaxRoundsForMultiShardTests)
+			require.Nil(t, err)
+			require.Equal(t, 1, numRounds)
+			require.Len(t, sim.PendingResults(0), 1)
+
+			sim.SetShardOrder(1, 0)
+			numDelivered, err := sim.RunRound()
+			require.Nil(t, err)
+			require.Equal(t, 1, numDelivered)
+			require.Equal(t, 0, sim.NumPendingResults())
+		}).
+		AndAssertResults(func(sim *worldmock.MultiShardSimulator, verify *test.VMOutputVerifier) {
+			verify.Ok()
+			require.Equal(t, uint64(2), sim.CurrentRound())
+			requireStorage(t, sim, test.ParentAddress, multiShardCallbackKey, []byte("child result"))
+		})
+}
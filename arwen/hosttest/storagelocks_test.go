@@ -0,0 +1,202 @@
+package hosttest
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+)
+
+func setCurrentBlock(_ arwen.VMHost, world *worldmock.MockWorld) {
+	world.CurrentBlockInfo = &worldmock.BlockInfo{
+		BlockTimestamp: 100,
+		BlockNonce:     5,
+		BlockRound:     7,
+	}
+}
+
+func TestStorageLocks_NonceRoundAndTimestamp(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedType := host.ManagedTypes()
+						output := host.Output()
+						vesting := managedType.NewManagedBufferFromBytes([]byte("vesting"))
+						vote := managedType.NewManagedBufferFromBytes([]byte("vote"))
+
+						elrondapi.ManagedSetStorageLockOfTypeWithHost(host, "test", arwen.NonceLock, vesting, 10)
+						elrondapi.ManagedSetStorageLockOfTypeWithHost(host, "test", arwen.RoundLock, vote, 3)
+						elrondapi.SetStorageLockOfTypeWithTypedArgs(host, "test", arwen.TimestampLock, []byte("vote"), 200)
+
+						finishInt64(output, elrondapi.ManagedGetStorageLockOfTypeWithHost(host, "test", arwen.NonceLock, vesting))
+						finishInt64(output, int64(elrondapi.ManagedIsStorageLockedOfTypeWithHost(host, "test", arwen.NonceLock, vesting)))
+						finishInt64(output, int64(elrondapi.ManagedIsStorageLockedOfTypeWithHost(host, "test", arwen.RoundLock, vote)))
+						finishInt64(output, int64(elrondapi.ManagedIsStorageLockedOfTypeWithHost(host, "test", arwen.TimestampLock, vote)))
+
+						for _, lockType := range []arwen.StorageLockType{arwen.TimestampLock, arwen.NonceLock, arwen.RoundLock} {
+							finishStorageLocks(host, lockType)
+						}
+
+						elrondapi.ManagedSetStorageLockOfTypeWithHost(host, "test", arwen.NonceLock, vesting, 0)
+						finishStorageLocks(host, arwen.NonceLock)
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		WithSetup(setCurrentBlock).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(
+					[]byte{10}, []byte{1}, []byte{}, []byte{1},
+					// timestamp locks
+					[]byte{1}, []byte("vote"),
+					// nonce locks
+					[]byte{1}, []byte("vesting"),
+					// the round lock has expired
+					[]byte{},
+					// after clearing the nonce lock
+					[]byte{},
+				)
+		})
+}
+
+func TestStorageLocks_ExpiredLocksArePruned(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						elrondapi.SetStorageLockOfTypeWithTypedArgs(host, "test", arwen.NonceLock, []byte("vesting"), 10)
+						elrondapi.SetStorageLockOfTypeWithTypedArgs(host, "test", arwen.RoundLock, []byte("vote"), 20)
+						elrondapi.SetStorageLockOfTypeWithTypedArgs(host, "test", arwen.TimestampLock, []byte("claim"), 300)
+						elrondapi.SetStorageLockWithTypedArgs(host, []byte("legacy"), 400)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {
+			setCurrentBlock(host, world)
+			// a nonce lock which expired before the current block
+			accountHandler, _ := world.GetUserAccount(test.ParentAddress)
+			(accountHandler.(*worldmock.Account)).Storage[arwen.StorageLocksKey] = storageLockRegistryEntry(arwen.NonceLock, 5, []byte("old"))
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			registry := storageLockRegistryEntry(arwen.NonceLock, 10, []byte("vesting"))
+			registry = append(registry, storageLockRegistryEntry(arwen.RoundLock, 20, []byte("vote"))...)
+			registry = append(registry, storageLockRegistryEntry(arwen.TimestampLock, 300, []byte("claim"))...)
+
+			verify.Ok().
+				Storage(
+					test.CreateStoreEntry(test.ParentAddress).WithKey(arwen.CustomStorageKey(arwen.NonceLockKeyPrefix, []byte("vesting"))).WithValue([]byte{10}),
+					test.CreateStoreEntry(test.ParentAddress).WithKey(arwen.CustomStorageKey(arwen.RoundLockKeyPrefix, []byte("vote"))).WithValue([]byte{20}),
+					test.CreateStoreEntry(test.ParentAddress).WithKey(arwen.CustomStorageKey(arwen.TimeLockKeyPrefix, []byte("claim"))).WithValue(big.NewInt(300).Bytes()),
+					test.CreateStoreEntry(test.ParentAddress).WithKey(arwen.CustomStorageKey(arwen.TimeLockKeyPrefix, []byte("legacy"))).WithValue(big.NewInt(400).Bytes()),
+					test.CreateStoreEntry(test.ParentAddress).WithKey([]byte(arwen.StorageLocksKey)).WithValue(registry),
+				)
+		})
+}
+
+func TestStorageLocks_InvalidLockType(t *testing.T) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						keys := host.ManagedTypes().NewManagedBuffer()
+						elrondapi.ManagedGetStorageLocksWithHost(host, 42, keys)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(100000).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(arwen.ErrInvalidStorageLockType.Error())
+		})
+}
+
+func storageLockRegistryEntry(lockType arwen.StorageLockType, deadline int64, key []byte) []byte {
+	entry := make([]byte, 13)
+	entry[0] = byte(lockType)
+	binary.BigEndian.PutUint64(entry[1:9], uint64(deadline))
+	binary.BigEndian.PutUint32(entry[9:], uint32(len(key)))
+	return append(entry, key...)
+}
+
+func finishInt64(output arwen.OutputContext, value int64) {
+	output.Finish(big.NewInt(value).Bytes())
+}
+
+func finishStorageLocks(host arwen.VMHost, lockType arwen.StorageLockType) {
+	managedType := host.ManagedTypes()
+	keysHandle := managedType.NewManagedBuffer()
+	numLocks := elrondapi.ManagedGetStorageLocksWithHost(host, int32(lockType), keysHandle)
+	finishInt64(host.Output(), int64(numLocks))
+
+	keys, _, err := managedType.ReadManagedVecOfManagedBuffers(keysHandle)
+	if err != nil {
+		arwen.WithFaultAndHost(host, err, true)
+		return
+	}
+	for _, key := range keys {
+		host.Output().Finish(key)
+	}
+}
+
+func TestStorageLocks_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"setStorageLockByNonce",
+			"getStorageLockByNonce",
+			"isStorageLockedByNonce",
+			"clearStorageLockByNonce",
+			"setStorageLockByRound",
+			"getStorageLockByRound",
+			"isStorageLockedByRound",
+			"clearStorageLockByRound",
+			"managedSetStorageLock",
+			"managedGetStorageLock",
+			"managedIsStorageLocked",
+			"managedClearStorageLock",
+			"managedSetStorageLockByNonce",
+			"managedGetStorageLockByNonce",
+			"managedIsStorageLockedByNonce",
+			"managedClearStorageLockByNonce",
+			"managedSetStorageLockByRound",
+			"managedGetStorageLockByRound",
+			"managedIsStorageLockedByRound",
+			"managedClearStorageLockByRound",
+			"managedGetStorageLocks",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.ExtendedStorageLocksEnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -0,0 +1,200 @@
+package hosttest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/elrondapi"
+	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	test "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/testcommon"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const txContextGasPrice = 3
+const txContextGasProvided = 1000000
+const txContextChildGasProvided = 100000
+
+func finishTxContext(host arwen.VMHost) {
+	managedType := host.ManagedTypes()
+
+	finishInt64(host.Output(), elrondapi.GetGasPriceWithHost(host))
+	finishInt64(host.Output(), elrondapi.GetGasProvidedWithHost(host))
+	finishInt64(host.Output(), elrondapi.GetCallerNonceWithHost(host))
+
+	resultHandle := managedType.NewManagedBuffer()
+	elrondapi.ManagedGetOriginalCallerWithHost(host, resultHandle)
+	finishManagedBuffer(host, resultHandle)
+}
+
+func runTxContextTest(
+	t *testing.T,
+	setEnableEpochs func(*arwen.VMHostParameters),
+	assertResults func(verify *test.VMOutputVerifier),
+) {
+	test.BuildMockInstanceCallTest(t).
+		WithEnableEpochs(setEnableEpochs).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						finishTxContext(host)
+						elrondapi.ExecuteOnDestContextWithTypedArgs(
+							host,
+							txContextChildGasProvided,
+							big.NewInt(0),
+							[]byte("txContext"),
+							test.ChildAddress,
+							nil)
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(1000).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("txContext", func() *mock.InstanceMock {
+						finishTxContext(childInstance.Host)
+						return childInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasPrice(txContextGasPrice).
+			WithGasProvided(txContextGasProvided).
+			WithFunction("testFunction").
+			Build()).
+		WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {
+			world.AcctMap.CreateAccount(test.UserAddress, world).Nonce = 7
+			world.AcctMap.GetAccount(test.ParentAddress).Nonce = 5
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			assertResults(verify)
+		})
+}
+
+func TestTxContext_ExecuteOnDestContext(t *testing.T) {
+	runTxContextTest(t, nil, func(verify *test.VMOutputVerifier) {
+		verify.Ok().
+			ReturnData(
+				big.NewInt(txContextGasPrice).Bytes(),
+				big.NewInt(txContextGasProvided).Bytes(),
+				[]byte{7},
+				test.UserAddress,
+				big.NewInt(txContextGasPrice).Bytes(),
+				big.NewInt(txContextChildGasProvided).Bytes(),
+				[]byte{5},
+				test.UserAddress,
+			)
+	})
+}
+
+func TestTxContext_NestedCallsGasPriceBeforeEnableEpoch(t *testing.T) {
+	disableNestedCallsGasPrice := func(parameters *arwen.VMHostParameters) {
+		parameters.NestedCallsGasPriceEnableEpoch = test.UnreachedEpochForTests
+	}
+	runTxContextTest(t, disableNestedCallsGasPrice, func(verify *test.VMOutputVerifier) {
+		verify.Ok().
+			ReturnData(
+				big.NewInt(txContextGasPrice).Bytes(),
+				big.NewInt(txContextGasProvided).Bytes(),
+				[]byte{7},
+				test.UserAddress,
+				[]byte{},
+				big.NewInt(txContextChildGasProvided).Bytes(),
+				[]byte{5},
+				test.UserAddress,
+			)
+	})
+}
+
+func runOriginalCallerTest(
+	t *testing.T,
+	input *vmcommon.ContractCallInput,
+	assertResults func(verify *test.VMOutputVerifier),
+) {
+	test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						resultHandle := host.ManagedTypes().NewManagedBuffer()
+						elrondapi.ManagedGetOriginalCallerWithHost(host, resultHandle)
+						finishManagedBuffer(host, resultHandle)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(input).
+		WithSetup(func(host arwen.VMHost, world *worldmock.MockWorld) {}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			assertResults(verify)
+		})
+}
+
+func TestTxContext_OriginalCallerOfRelayedTx(t *testing.T) {
+	// the inner transaction of a relayed transaction reaches the VM as a direct
+	// call from its sender, not from the relayer
+	input := test.CreateTestContractCallInputBuilder().
+		WithRecipientAddr(test.ParentAddress).
+		WithCallerAddr(test.UserAddress).
+		WithCurrentTxHash([]byte("inner tx hash")).
+		WithOriginalTxHash([]byte("relayed tx hash")).
+		WithGasProvided(txContextGasProvided).
+		WithFunction("testFunction").
+		Build()
+
+	runOriginalCallerTest(t, input, func(verify *test.VMOutputVerifier) {
+		verify.Ok().
+			ReturnData(test.UserAddress)
+	})
+}
+
+func TestTxContext_OriginalCallerOfCallFromContract(t *testing.T) {
+	input := test.CreateTestContractCallInputBuilder().
+		WithRecipientAddr(test.ParentAddress).
+		WithCallerAddr(test.MakeTestSCAddress("crossShardCaller")).
+		WithGasProvided(txContextGasProvided).
+		WithFunction("testFunction").
+		Build()
+
+	runOriginalCallerTest(t, input, func(verify *test.VMOutputVerifier) {
+		verify.ExecutionFailed().
+			HasRuntimeErrors(arwen.ErrOriginalCallerNotAvailable.Error())
+	})
+}
+
+func TestTxContext_OriginalCallerOfAsyncCall(t *testing.T) {
+	input := test.CreateTestContractCallInputBuilder().
+		WithRecipientAddr(test.ParentAddress).
+		WithCallerAddr(test.UserAddress).
+		WithCallType(vm.AsynchronousCall).
+		WithGasProvided(txContextGasProvided).
+		WithFunction("testFunction").
+		Build()
+
+	runOriginalCallerTest(t, input, func(verify *test.VMOutputVerifier) {
+		verify.ExecutionFailed().
+			HasRuntimeErrors(arwen.ErrOriginalCallerNotAvailable.Error())
+	})
+}
+
+func TestTxContext_ImportsGatedByEpoch(t *testing.T) {
+	requireImportsGatedByEpoch(t,
+		[]string{
+			"getGasPrice",
+			"getGasProvided",
+			"getCallerNonce",
+			"getOriginalCaller",
+			"managedGetOriginalCaller",
+		},
+		func(parameters *arwen.VMHostParameters) {
+			parameters.TxContextEnableEpoch = test.UnreachedEpochForTests
+		})
+}
@@ -57,6 +57,26 @@ type VMHost interface {
 	FixOOGReturnCodeEnabled() bool
 	FixFailExecutionEnabled() bool
 	CreateNFTOnExecByCallerEnabled() bool
+	SecureRandomnessEnabled() bool
+	AsyncCallESDTPaymentEnabled() bool
+	ExtendedStorageLocksEnabled() bool
+	ManagedMapEnabled() bool
+	ManagedVecEnabled() bool
+	BigFloatEnabled() bool
+	BigIntModularEnabled() bool
+	ManagedBufferStringsEnabled() bool
+	ManagedMemoryFreeEnabled() bool
+	ExtendedHashingEnabled() bool
+	Secp256ExtensionsEnabled() bool
+	AggregatedBLSEnabled() bool
+	BN254Enabled() bool
+	Ed25519BatchEnabled() bool
+	MerkleProofEnabled() bool
+	AccountInfoEnabled() bool
+	TxContextEnabled() bool
+	FixAsyncCallbackResolutionEnabled() bool
+	NestedCallsGasPriceEnabled() bool
+	ManagedMemoryLimitsEnabled() bool
 	Reset()
 }
 
@@ -82,11 +102,16 @@ type BlockchainContext interface {
 	LastRandomSeed() []byte
 	IncreaseNonce(addr []byte)
 	GetCodeHash(addr []byte) []byte
+	GetCodeMetadata(addr []byte) []byte
 	GetCode(addr []byte) ([]byte, error)
 	GetCodeSize(addr []byte) (int32, error)
 	BlockHash(number int64) []byte
 	GetOwnerAddress() ([]byte, error)
+	GetAccountOwner(addr []byte) []byte
+	GetUsername(addr []byte) []byte
 	GetShardOfAddress(addr []byte) uint32
+	NumberOfShards() uint32
+	SelfShardID() uint32
 	IsSmartContract(addr []byte) bool
 	IsPayable(sndAddress, rcvAddress []byte) (bool, error)
 	SaveCompiledCode(codeHash []byte, code []byte)
@@ -117,6 +142,7 @@ type RuntimeContext interface {
 	Arguments() [][]byte
 	GetCurrentTxHash() []byte
 	GetOriginalTxHash() []byte
+	GetOriginalCallerAddr() ([]byte, error)
 	ExtractCodeUpgradeFromArgs() ([]byte, []byte, error)
 	SignalUserError(message string)
 	FailExecution(err error)
@@ -164,6 +190,7 @@ type ManagedTypesContext interface {
 	StateStack
 
 	GetRandReader() io.Reader
+	GetFrameRandomSeed() []byte
 	ConsumeGasForThisBigIntNumberOfBytes(byteLen *big.Int)
 	ConsumeGasForThisIntNumberOfBytes(byteLen int)
 	ConsumeGasForBytes(bytes []byte)
@@ -173,6 +200,10 @@ type ManagedTypesContext interface {
 	GetBigIntOrCreate(handle int32) *big.Int
 	GetBigInt(id int32) (*big.Int, error)
 	GetTwoBigInt(handle1 int32, handle2 int32) (*big.Int, *big.Int, error)
+	GetBigFloat(handle int32) (*big.Float, error)
+	GetTwoBigFloats(handle1 int32, handle2 int32) (*big.Float, *big.Float, error)
+	PutBigFloat(handle int32, value *big.Float) error
+	NewBigFloat(value *big.Float) (int32, error)
 	PutEllipticCurve(ec *elliptic.CurveParams) int32
 	GetEllipticCurve(handle int32) (*elliptic.CurveParams, error)
 	GetEllipticCurveSizeOfField(ecHandle int32) int32
@@ -191,6 +222,19 @@ type ManagedTypesContext interface {
 	InsertSlice(mBufferHandle int32, startPosition int32, slice []byte) ([]byte, error)
 	ReadManagedVecOfManagedBuffers(managedVecHandle int32) ([][]byte, uint64, error)
 	WriteManagedVecOfManagedBuffers(data [][]byte, destinationHandle int32)
+	NewManagedMap() int32
+	ManagedMapPut(mMapHandle int32, key []byte, value []byte) error
+	ManagedMapGet(mMapHandle int32, key []byte) ([]byte, error)
+	ManagedMapContains(mMapHandle int32, key []byte) (bool, error)
+	ManagedMapRemove(mMapHandle int32, key []byte) ([]byte, error)
+	ManagedMapLen(mMapHandle int32) int32
+	ManagedMapKeys(mMapHandle int32) ([][]byte, error)
+	FreeBigInt(handle int32) error
+	FreeBigFloat(handle int32) error
+	FreeEllipticCurve(handle int32) error
+	FreeManagedBuffer(mBufferHandle int32) error
+	FreeManagedMap(mMapHandle int32) error
+	GetResidentMemory() (uint64, uint64)
 }
 
 // OutputContext defines the functionality needed for interacting with the output context
@@ -292,6 +336,9 @@ type StorageContext interface {
 	SetStorage(key []byte, value []byte) (StorageStatus, error)
 	SetProtectedStorage(key []byte, value []byte) (StorageStatus, error)
 	UseGasForStorageLoad(tracedFunctionName string, blockChainLoadCost uint64, usedCache bool)
+	GetStorageAccesses() []StorageAccess
+	GetReadSet() []StorageAccess
+	GetWriteSet() []StorageAccess
 	DisableUseDifferentGasCostFlag()
 	IsUseDifferentGasCostFlagSet() bool
 }
@@ -121,6 +121,11 @@ func (b *BlockchainContextMock) GetCodeHash(addr []byte) []byte {
 	return addr
 }
 
+// GetCodeMetadata -
+func (b *BlockchainContextMock) GetCodeMetadata(_ []byte) []byte {
+	return []byte{0, 0}
+}
+
 // GetCode -
 func (b *BlockchainContextMock) GetCode(addr []byte) ([]byte, error) {
 	return addr, nil
@@ -141,11 +146,31 @@ func (b *BlockchainContextMock) GetOwnerAddress() ([]byte, error) {
 	return bytes.Repeat([]byte{1}, 32), nil
 }
 
+// GetAccountOwner -
+func (b *BlockchainContextMock) GetAccountOwner(_ []byte) []byte {
+	return bytes.Repeat([]byte{1}, 32)
+}
+
+// GetUsername -
+func (b *BlockchainContextMock) GetUsername(_ []byte) []byte {
+	return nil
+}
+
 // GetShardOfAddress -
 func (b *BlockchainContextMock) GetShardOfAddress(_ []byte) uint32 {
 	return 0
 }
 
+// NumberOfShards -
+func (b *BlockchainContextMock) NumberOfShards() uint32 {
+	return 1
+}
+
+// SelfShardID -
+func (b *BlockchainContextMock) SelfShardID() uint32 {
+	return 0
+}
+
 // IsSmartContract -
 func (b *BlockchainContextMock) IsSmartContract(_ []byte) bool {
 	return true
@@ -190,6 +190,39 @@ func (f *DebugFacade) CreateAccount(request CreateAccountRequest) (*CreateAccoun
 	return response, err
 }
 
+// GetAccount returns a test account, with its storage grouped by storage mappers
+func (f *DebugFacade) GetAccount(request GetAccountRequest) (*GetAccountResponse, error) {
+	log.Debug("Debugf.GetAccount()")
+
+	err := request.digest()
+	if err != nil {
+		return nil, err
+	}
+
+	database := f.loadDatabase(request.DatabasePath)
+	world, err := database.loadWorld(request.World)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = world.vm.Close()
+	}()
+
+	response, err := world.getAccount(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.storeOutcome(request.Outcome, response)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpOutcome(&response)
+
+	return response, err
+}
+
 func dumpOutcome(outcome interface{}) {
 	data, err := json.MarshalIndent(outcome, "", "\t")
 	if err != nil {
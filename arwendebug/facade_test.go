@@ -23,6 +23,23 @@ func TestFacade_CreateAccount(t *testing.T) {
 	require.True(t, context.accountExists(newDummyAddress("alice").raw))
 }
 
+func TestFacade_GetAccount(t *testing.T) {
+	context := newTestContext(t)
+	alice := newDummyAddress("alice")
+	context.createAccount(alice.hex, "42")
+
+	response := context.getAccount(alice.hex)
+	require.Equal(t, alice.raw, response.Account.Address)
+	require.Equal(t, int64(42), response.Account.Balance.Int64())
+	require.Len(t, response.Storage, 0)
+
+	_, err := context.facade.GetAccount(GetAccountRequest{
+		RequestBase: context.createRequestBase(),
+		AddressHex:  newDummyAddress("bob").hex,
+	})
+	require.Equal(t, ErrAccountDoesntExist, err)
+}
+
 func TestFacade_RunContract_Counter(t *testing.T) {
 	context := newTestContext(t)
 
@@ -43,6 +60,11 @@ func TestFacade_RunContract_Counter(t *testing.T) {
 	require.Nil(t, err)
 	require.NotNil(t, state)
 	require.Equal(t, []byte{2}, state["COUNTER"])
+
+	storage := context.getAccount(contractAddressHex).Storage
+	require.Len(t, storage, 1)
+	require.Equal(t, "str:COUNTER", storage[0].Name)
+	require.Equal(t, "single", storage[0].Kind)
 }
 
 func TestFacade_RunContract_ERC20(t *testing.T) {
@@ -1,8 +1,10 @@
 package arwendebug
 
 import (
+	"io/ioutil"
 	"math/big"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/storagemapper"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
 )
 
@@ -43,3 +45,49 @@ func (request *CreateAccountRequest) digest() error {
 type CreateAccountResponse struct {
 	Account *worldmock.Account
 }
+
+// GetAccountRequest is a CLI / REST request message
+type GetAccountRequest struct {
+	RequestBase
+	AddressHex string
+	Address    []byte
+	ABIPath    string
+	ABI        *storagemapper.ABI
+}
+
+func (request *GetAccountRequest) digest() error {
+	err := request.RequestBase.digest()
+	if err != nil {
+		return err
+	}
+
+	if len(request.AddressHex) == 0 {
+		return NewRequestError("empty account address")
+	}
+
+	request.Address, err = fromHex(request.AddressHex)
+	if err != nil {
+		return NewRequestErrorMessageInner("invalid account address", err)
+	}
+
+	if len(request.ABIPath) > 0 {
+		abiJSON, err := ioutil.ReadFile(request.ABIPath)
+		if err != nil {
+			return err
+		}
+
+		request.ABI, err = storagemapper.ParseABI(abiJSON)
+		if err != nil {
+			return NewRequestErrorMessageInner("invalid contract ABI", err)
+		}
+	}
+
+	return nil
+}
+
+// GetAccountResponse is a CLI / REST response message. The storage of the
+// account is also given grouped by storage mappers, for readability.
+type GetAccountResponse struct {
+	Account *worldmock.Account
+	Storage []*storagemapper.MapperView
+}
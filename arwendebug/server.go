@@ -28,6 +28,7 @@ func (server *DebugServer) Start() error {
 	router := gin.Default()
 
 	router.POST("/account", server.handleCreateAccount)
+	router.POST("/account/get", server.handleGetAccount)
 	router.POST("/deploy", server.handleDeploy)
 	router.POST("/upgrade", server.handleUpgrade)
 	router.POST("/run", server.handleRun)
@@ -54,6 +55,24 @@ func (server *DebugServer) handleCreateAccount(ginContext *gin.Context) {
 	returnOkResponse(ginContext, response)
 }
 
+func (server *DebugServer) handleGetAccount(ginContext *gin.Context) {
+	request := GetAccountRequest{}
+
+	err := ginContext.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleGetAccount.ShouldBindJSON", err)
+		return
+	}
+
+	response, err := server.facade.GetAccount(request)
+	if err != nil {
+		returnBadRequest(ginContext, "handleGetAccount.GetAccount", err)
+		return
+	}
+
+	returnOkResponse(ginContext, response)
+}
+
 func (server *DebugServer) handleDeploy(ginContext *gin.Context) {
 	request := DeployRequest{}
 
@@ -45,6 +45,21 @@ func (context *testContext) createAccount(address string, balance string) {
 	require.NotNil(t, response)
 }
 
+func (context *testContext) getAccount(address string) *GetAccountResponse {
+	request := GetAccountRequest{
+		RequestBase: context.createRequestBase(),
+		AddressHex:  address,
+	}
+
+	response, err := context.facade.GetAccount(request)
+
+	t := context.t
+	require.Nil(t, err)
+	require.NotNil(t, response)
+
+	return response
+}
+
 func (context *testContext) accountExists(address []byte) bool {
 	world := context.loadWorld()
 	account, err := world.blockchainHook.GetUserAccount(address)
@@ -6,6 +6,8 @@ import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/host"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/storagemapper"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
@@ -140,6 +142,21 @@ func (w *world) createAccount(request CreateAccountRequest) *CreateAccountRespon
 	return &CreateAccountResponse{Account: &account}
 }
 
+func (w *world) getAccount(request GetAccountRequest) (*GetAccountResponse, error) {
+	log.Trace("w.getAccount()", "request", prettyJson(request))
+
+	account := w.blockchainHook.AcctMap.GetAccount(request.Address)
+	if account == nil {
+		return nil, ErrAccountDoesntExist
+	}
+
+	mappers := storagemapper.Decode(account.Storage, request.ABI)
+	return &GetAccountResponse{
+		Account: account,
+		Storage: storagemapper.Views(mappers, &er.ExprReconstructor{}),
+	}, nil
+}
+
 func (w *world) toDataModel() *worldDataModel {
 	accounts := w.blockchainHook.AcctMap.Clone()
 	for _, account := range accounts {
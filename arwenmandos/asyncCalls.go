@@ -0,0 +1,475 @@
+package arwenmandos
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var asyncCallStatusNames = map[arwen.AsyncCallStatus]string{
+	arwen.AsyncCallPending:  mj.AsyncCallStatusPending,
+	arwen.AsyncCallResolved: mj.AsyncCallStatusResolved,
+	arwen.AsyncCallRejected: mj.AsyncCallStatusRejected,
+}
+
+// isAsyncCallsStorageKey returns true for the keys where the host saves
+// the pending async calls of a transaction, i.e. <txHash>ARWEN@ASYNC.
+func isAsyncCallsStorageKey(key string) bool {
+	return strings.HasSuffix(key, arwen.AsyncDataPrefix)
+}
+
+func asyncCallStatusName(status arwen.AsyncCallStatus) string {
+	name, found := asyncCallStatusNames[status]
+	if !found {
+		return fmt.Sprintf("%d", status)
+	}
+	return name
+}
+
+func asyncCallStatusFromName(name string) (arwen.AsyncCallStatus, error) {
+	for status, statusName := range asyncCallStatusNames {
+		if statusName == name {
+			return status, nil
+		}
+	}
+	return arwen.AsyncCallPending, fmt.Errorf("unknown async call status: %s", name)
+}
+
+// decodeAccountAsyncCalls unmarshals all async call contexts saved in the account storage, by tx hash.
+func decodeAccountAsyncCalls(account *worldmock.Account) (map[string]*arwen.AsyncContextInfo, error) {
+	asyncCalls := make(map[string]*arwen.AsyncContextInfo)
+	for storageKey, storageValue := range account.Storage {
+		if !isAsyncCallsStorageKey(storageKey) || len(storageValue) == 0 {
+			continue
+		}
+
+		asyncInfo := &arwen.AsyncContextInfo{}
+		err := json.Unmarshal(storageValue, asyncInfo)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode async calls saved under key %s: %w",
+				hex.EncodeToString([]byte(storageKey)), err)
+		}
+
+		txHash := strings.TrimSuffix(storageKey, arwen.AsyncDataPrefix)
+		asyncCalls[txHash] = asyncInfo
+	}
+
+	return asyncCalls, nil
+}
+
+// writeMandosAsyncCallsToStorage encodes the async calls from a "setState" step
+// the same way the host saves them.
+func writeMandosAsyncCallsToStorage(asyncCalls []*mj.AsyncCallsInfo, storage map[string][]byte) error {
+	for _, mandosInfo := range asyncCalls {
+		asyncInfo := &arwen.AsyncContextInfo{
+			CallerAddr:      mandosInfo.CallerAddr.Value,
+			ReturnData:      mandosInfo.ReturnData.Value,
+			AsyncContextMap: make(map[string]*arwen.AsyncContext),
+		}
+
+		for _, mandosContext := range mandosInfo.Contexts {
+			asyncContext := &arwen.AsyncContext{
+				Callback: string(mandosContext.Callback.Value),
+			}
+			for _, mandosCall := range mandosContext.Calls {
+				asyncCall, err := convertMandosAsyncCall(mandosCall)
+				if err != nil {
+					return err
+				}
+				asyncContext.AsyncCalls = append(asyncContext.AsyncCalls, asyncCall)
+			}
+			asyncInfo.AsyncContextMap[string(mandosContext.Identifier.Value)] = asyncContext
+		}
+
+		data, err := json.Marshal(asyncInfo)
+		if err != nil {
+			return err
+		}
+		storageKey := arwen.CustomStorageKey(arwen.AsyncDataPrefix, mandosInfo.TxHash.Value)
+		storage[string(storageKey)] = data
+	}
+
+	return nil
+}
+
+func convertMandosAsyncCall(mandosCall *mj.AsyncCall) (*arwen.AsyncGeneratedCall, error) {
+	status, err := asyncCallStatusFromName(mandosCall.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var esdtTransfers []*vmcommon.ESDTTransfer
+	for _, esdtValue := range mandosCall.ESDTValue {
+		esdtTransfer := &vmcommon.ESDTTransfer{
+			ESDTTokenName:  esdtValue.TokenIdentifier.Value,
+			ESDTValue:      esdtValue.Value.Value,
+			ESDTTokenNonce: esdtValue.Nonce.Value,
+			ESDTTokenType:  uint32(core.Fungible),
+		}
+		if esdtTransfer.ESDTTokenNonce != 0 {
+			esdtTransfer.ESDTTokenType = uint32(core.NonFungible)
+		}
+		esdtTransfers = append(esdtTransfers, esdtTransfer)
+	}
+
+	return &arwen.AsyncGeneratedCall{
+		Status:          status,
+		Destination:     mandosCall.Destination.Value,
+		Data:            mandosCall.Data.Value,
+		GasLimit:        mandosCall.GasLimit.Value,
+		GasLocked:       mandosCall.GasLocked.Value,
+		ValueBytes:      mandosCall.Value.Value.Bytes(),
+		ESDTTransfers:   esdtTransfers,
+		SuccessCallback: string(mandosCall.SuccessCallback.Value),
+		ErrorCallback:   string(mandosCall.ErrorCallback.Value),
+		ProvidedGas:     mandosCall.ProvidedGas.Value,
+	}, nil
+}
+
+// convertAsyncCallsToMandosFormat produces the decoded view of the async calls, used by "dumpState".
+// Transactions and contexts are sorted, so that the output is deterministic.
+func (ae *ArwenTestExecutor) convertAsyncCallsToMandosFormat(account *worldmock.Account) ([]*mj.AsyncCallsInfo, error) {
+	asyncCalls, err := decodeAccountAsyncCalls(account)
+	if err != nil {
+		return nil, err
+	}
+
+	var txHashes []string
+	for txHash := range asyncCalls {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	var mandosAsyncCalls []*mj.AsyncCallsInfo
+	for _, txHash := range txHashes {
+		asyncInfo := asyncCalls[txHash]
+
+		var identifiers []string
+		for identifier := range asyncInfo.AsyncContextMap {
+			identifiers = append(identifiers, identifier)
+		}
+		sort.Strings(identifiers)
+
+		var mandosContexts []*mj.AsyncContext
+		for _, identifier := range identifiers {
+			asyncContext := asyncInfo.AsyncContextMap[identifier]
+			var mandosCalls []*mj.AsyncCall
+			for _, asyncCall := range asyncContext.AsyncCalls {
+				mandosCalls = append(mandosCalls, ae.convertAsyncCallToMandosFormat(asyncCall))
+			}
+
+			mandosContexts = append(mandosContexts, &mj.AsyncContext{
+				Identifier: ae.bytesToMandosFormat([]byte(identifier), er.NoHint),
+				Callback:   ae.bytesToMandosFormat([]byte(asyncContext.Callback), er.StrHint),
+				Calls:      mandosCalls,
+			})
+		}
+
+		mandosAsyncCalls = append(mandosAsyncCalls, &mj.AsyncCallsInfo{
+			TxHash:     ae.bytesToMandosFormat([]byte(txHash), er.NoHint),
+			CallerAddr: ae.bytesToMandosFormat(asyncInfo.CallerAddr, er.AddressHint),
+			ReturnData: ae.bytesToMandosFormat(asyncInfo.ReturnData, er.NoHint),
+			Contexts:   mandosContexts,
+		})
+	}
+
+	return mandosAsyncCalls, nil
+}
+
+func (ae *ArwenTestExecutor) convertAsyncCallToMandosFormat(asyncCall *arwen.AsyncGeneratedCall) *mj.AsyncCall {
+	value := big.NewInt(0).SetBytes(asyncCall.ValueBytes)
+
+	var esdtValues []*mj.ESDTTxData
+	for _, esdtTransfer := range asyncCall.ESDTTransfers {
+		esdtValues = append(esdtValues, &mj.ESDTTxData{
+			TokenIdentifier: ae.bytesToMandosFormat(esdtTransfer.ESDTTokenName, er.StrHint),
+			Nonce: mj.JSONUint64{
+				Value:    esdtTransfer.ESDTTokenNonce,
+				Original: ae.exprReconstructor.ReconstructFromUint64(esdtTransfer.ESDTTokenNonce),
+			},
+			Value: mj.JSONBigInt{
+				Value:    esdtTransfer.ESDTValue,
+				Original: ae.exprReconstructor.ReconstructFromBigInt(esdtTransfer.ESDTValue),
+			},
+		})
+	}
+
+	return &mj.AsyncCall{
+		Destination: ae.bytesToMandosFormat(asyncCall.Destination, er.AddressHint),
+		Status:      asyncCallStatusName(asyncCall.Status),
+		Data:        ae.bytesToMandosFormat(asyncCall.Data, er.StrHint),
+		GasLimit: mj.JSONUint64{
+			Value:    asyncCall.GasLimit,
+			Original: ae.exprReconstructor.ReconstructFromUint64(asyncCall.GasLimit),
+		},
+		GasLocked: mj.JSONUint64{
+			Value:    asyncCall.GasLocked,
+			Original: ae.exprReconstructor.ReconstructFromUint64(asyncCall.GasLocked),
+		},
+		ProvidedGas: mj.JSONUint64{
+			Value:    asyncCall.ProvidedGas,
+			Original: ae.exprReconstructor.ReconstructFromUint64(asyncCall.ProvidedGas),
+		},
+		Value: mj.JSONBigInt{
+			Value:    value,
+			Original: ae.exprReconstructor.ReconstructFromBigInt(value),
+		},
+		ESDTValue:       esdtValues,
+		SuccessCallback: ae.bytesToMandosFormat([]byte(asyncCall.SuccessCallback), er.StrHint),
+		ErrorCallback:   ae.bytesToMandosFormat([]byte(asyncCall.ErrorCallback), er.StrHint),
+	}
+}
+
+// bytesToMandosFormat only reconstructs non-empty values, and only into expressions that can be parsed back
+func (ae *ArwenTestExecutor) bytesToMandosFormat(value []byte, hint er.ExprReconstructorHint) mj.JSONBytesFromString {
+	if len(value) == 0 {
+		return mj.JSONBytesEmpty()
+	}
+
+	var original string
+	if hint == er.NoHint {
+		if isPrintableAsString(value) {
+			original = "str:" + string(value)
+		} else {
+			original = "0x" + hex.EncodeToString(value)
+		}
+	} else {
+		original = ae.exprReconstructor.Reconstruct(value, hint)
+	}
+
+	return mj.JSONBytesFromString{
+		Value:    value,
+		Original: original,
+	}
+}
+
+func isPrintableAsString(value []byte) bool {
+	for _, b := range value {
+		if b < 32 || b > 126 {
+			return false
+		}
+	}
+	return true
+}
+
+func (ae *ArwenTestExecutor) checkAccountAsyncCalls(baseErrMsg string, expectedAcct *mj.CheckAccount, matchingAcct *worldmock.Account) error {
+	if !expectedAcct.ExplicitAsyncCalls || expectedAcct.IgnoreAsyncCalls {
+		return nil
+	}
+
+	accountAsyncCalls, err := decodeAccountAsyncCalls(matchingAcct)
+	if err != nil {
+		return fmt.Errorf("%s account \"%s\": %w", baseErrMsg, expectedAcct.Address.Original, err)
+	}
+
+	var errs []error
+	expectedTxHashes := make(map[string]bool)
+	for _, expectedInfo := range expectedAcct.CheckAsyncCalls {
+		txHash := string(expectedInfo.TxHash.Value)
+		expectedTxHashes[txHash] = true
+
+		asyncInfo, found := accountAsyncCalls[txHash]
+		if !found {
+			errs = append(errs, fmt.Errorf("async calls for tx %s expected but not found",
+				expectedInfo.TxHash.Original))
+			continue
+		}
+
+		errs = append(errs, ae.checkAsyncCallsInfo(expectedInfo, asyncInfo)...)
+	}
+
+	if !expectedAcct.MoreAsyncCallsAllowed {
+		for txHash := range accountAsyncCalls {
+			if !expectedTxHashes[txHash] {
+				errs = append(errs, fmt.Errorf("unexpected async calls for tx %s",
+					ae.bytesToMandosFormat([]byte(txHash), er.NoHint).Original))
+			}
+		}
+	}
+
+	errorString := makeErrorString(errs)
+	if len(errorString) > 0 {
+		return fmt.Errorf("%s async calls mismatch for account \"%s\":%s",
+			baseErrMsg,
+			expectedAcct.Address.Original,
+			errorString)
+	}
+
+	return nil
+}
+
+func (ae *ArwenTestExecutor) checkAsyncCallsInfo(expectedInfo *mj.CheckAsyncCallsInfo, asyncInfo *arwen.AsyncContextInfo) []error {
+	var errs []error
+	txHash := expectedInfo.TxHash.Original
+
+	if !expectedInfo.CallerAddr.IsUnspecified() && !expectedInfo.CallerAddr.Check(asyncInfo.CallerAddr) {
+		errs = append(errs, fmt.Errorf("for tx %s: bad caller address. Want: %s. Have: \"%s\"",
+			txHash,
+			oj.JSONString(expectedInfo.CallerAddr.Original),
+			ae.exprReconstructor.Reconstruct(asyncInfo.CallerAddr, er.AddressHint)))
+	}
+
+	if !expectedInfo.ReturnData.IsUnspecified() && !expectedInfo.ReturnData.Check(asyncInfo.ReturnData) {
+		errs = append(errs, fmt.Errorf("for tx %s: bad return data. Want: %s. Have: \"%s\"",
+			txHash,
+			oj.JSONString(expectedInfo.ReturnData.Original),
+			ae.exprReconstructor.Reconstruct(asyncInfo.ReturnData, er.NoHint)))
+	}
+
+	expectedIdentifiers := make(map[string]bool)
+	for _, expectedContext := range expectedInfo.Contexts {
+		identifier := string(expectedContext.Identifier.Value)
+		expectedIdentifiers[identifier] = true
+
+		asyncContext, found := asyncInfo.AsyncContextMap[identifier]
+		if !found {
+			errs = append(errs, fmt.Errorf("for tx %s: async context %s expected but not found",
+				txHash,
+				expectedContext.Identifier.Original))
+			continue
+		}
+
+		errs = append(errs, ae.checkAsyncContext(txHash, expectedContext, asyncContext)...)
+	}
+
+	if !expectedInfo.MoreContextsAllowed {
+		for identifier := range asyncInfo.AsyncContextMap {
+			if !expectedIdentifiers[identifier] {
+				errs = append(errs, fmt.Errorf("for tx %s: unexpected async context %s",
+					txHash,
+					ae.bytesToMandosFormat([]byte(identifier), er.NoHint).Original))
+			}
+		}
+	}
+
+	return errs
+}
+
+// checkAsyncContext matches each expected call with a different saved call, regardless of order.
+func (ae *ArwenTestExecutor) checkAsyncContext(
+	txHash string,
+	expectedContext *mj.CheckAsyncContext,
+	asyncContext *arwen.AsyncContext,
+) []error {
+	var errs []error
+	identifier := expectedContext.Identifier.Original
+
+	if !expectedContext.Callback.IsUnspecified() && !expectedContext.Callback.Check([]byte(asyncContext.Callback)) {
+		errs = append(errs, fmt.Errorf("for tx %s, context %s: bad callback. Want: %s. Have: \"%s\"",
+			txHash,
+			identifier,
+			oj.JSONString(expectedContext.Callback.Original),
+			asyncContext.Callback))
+	}
+
+	if expectedContext.IgnoreCalls {
+		return errs
+	}
+
+	matched := make([]bool, len(asyncContext.AsyncCalls))
+	for expectedIndex, expectedCall := range expectedContext.Calls {
+		matchIndex := -1
+		for callIndex, asyncCall := range asyncContext.AsyncCalls {
+			if !matched[callIndex] && asyncCallMatches(expectedCall, asyncCall) {
+				matchIndex = callIndex
+				break
+			}
+		}
+		if matchIndex < 0 {
+			errs = append(errs, fmt.Errorf("for tx %s, context %s: no async call matches expected call #%d. Want: %s. Have: %s",
+				txHash,
+				identifier,
+				expectedIndex,
+				checkAsyncCallPretty(expectedCall),
+				ae.asyncCallsPretty(asyncContext.AsyncCalls)))
+			continue
+		}
+		matched[matchIndex] = true
+	}
+
+	if !expectedContext.MoreCallsAllowed && len(expectedContext.Calls) != len(asyncContext.AsyncCalls) {
+		errs = append(errs, fmt.Errorf("for tx %s, context %s: wrong number of async calls. Want: %d. Have: %d",
+			txHash,
+			identifier,
+			len(expectedContext.Calls),
+			len(asyncContext.AsyncCalls)))
+	}
+
+	return errs
+}
+
+func asyncCallMatches(expectedCall *mj.CheckAsyncCall, asyncCall *arwen.AsyncGeneratedCall) bool {
+	checkBytes := func(check mj.JSONCheckBytes, value []byte) bool {
+		return check.IsUnspecified() || check.Check(value)
+	}
+	checkUint64 := func(check mj.JSONCheckUint64, value uint64) bool {
+		return check.IsUnspecified() || check.Check(value)
+	}
+
+	return checkBytes(expectedCall.Destination, asyncCall.Destination) &&
+		checkBytes(expectedCall.Status, []byte(asyncCallStatusName(asyncCall.Status))) &&
+		checkBytes(expectedCall.Data, asyncCall.Data) &&
+		checkUint64(expectedCall.GasLimit, asyncCall.GasLimit) &&
+		checkUint64(expectedCall.GasLocked, asyncCall.GasLocked) &&
+		checkUint64(expectedCall.ProvidedGas, asyncCall.ProvidedGas) &&
+		(expectedCall.Value.IsUnspecified() ||
+			expectedCall.Value.Check(big.NewInt(0).SetBytes(asyncCall.ValueBytes))) &&
+		checkBytes(expectedCall.SuccessCallback, []byte(asyncCall.SuccessCallback)) &&
+		checkBytes(expectedCall.ErrorCallback, []byte(asyncCall.ErrorCallback))
+}
+
+func checkAsyncCallPretty(expectedCall *mj.CheckAsyncCall) string {
+	var fields []string
+	addField := func(name string, original string, isUnspecified bool) {
+		if !isUnspecified {
+			fields = append(fields, fmt.Sprintf("%s: \"%s\"", name, original))
+		}
+	}
+	addBytesField := func(name string, check mj.JSONCheckBytes) {
+		if !check.IsUnspecified() {
+			fields = append(fields, fmt.Sprintf("%s: %s", name, oj.JSONString(check.Original)))
+		}
+	}
+
+	addBytesField("destination", expectedCall.Destination)
+	addBytesField("status", expectedCall.Status)
+	addBytesField("data", expectedCall.Data)
+	addField("gasLimit", expectedCall.GasLimit.Original, expectedCall.GasLimit.IsUnspecified())
+	addField("gasLocked", expectedCall.GasLocked.Original, expectedCall.GasLocked.IsUnspecified())
+	addField("providedGas", expectedCall.ProvidedGas.Original, expectedCall.ProvidedGas.IsUnspecified())
+	addField("value", expectedCall.Value.Original, expectedCall.Value.IsUnspecified())
+	addBytesField("successCallback", expectedCall.SuccessCallback)
+	addBytesField("errorCallback", expectedCall.ErrorCallback)
+
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+func (ae *ArwenTestExecutor) asyncCallsPretty(asyncCalls []*arwen.AsyncGeneratedCall) string {
+	var calls []string
+	for _, asyncCall := range asyncCalls {
+		calls = append(calls, fmt.Sprintf(
+			"{destination: \"%s\", status: \"%s\", data: \"%s\", gasLimit: \"%d\", gasLocked: \"%d\", providedGas: \"%d\", value: \"%s\", successCallback: \"%s\", errorCallback: \"%s\"}",
+			ae.exprReconstructor.Reconstruct(asyncCall.Destination, er.AddressHint),
+			asyncCallStatusName(asyncCall.Status),
+			ae.exprReconstructor.Reconstruct(asyncCall.Data, er.StrHint),
+			asyncCall.GasLimit,
+			asyncCall.GasLocked,
+			asyncCall.ProvidedGas,
+			big.NewInt(0).SetBytes(asyncCall.ValueBytes),
+			asyncCall.SuccessCallback,
+			asyncCall.ErrorCallback))
+	}
+
+	return "[" + strings.Join(calls, ", ") + "]"
+}
@@ -93,6 +93,7 @@ const (
     DelegateExecution    = 160000
     AsyncCallStep        = 200000
     AsyncCallbackGasLock = 2000000
+    CreateAsyncCallESDT  = 100000
     ExecuteReadOnly      = 160000
     CreateContract       = 300000
     GetReturnData        = 100
@@ -100,6 +101,13 @@ const (
     GetReturnDataSize    = 100
     CleanReturnData      = 100
     DeleteFromReturnData = 100    
+    GetAccountInfo       = 7000
+    GetCallerNonce       = 100
+    GetNumberOfShards    = 100
+    GetSelfShardID       = 100
+    GetGasPrice          = 100
+    GetGasProvided       = 100
+    GetOriginalCaller    = 100
 
 [EthAPICost]
     UseGas              = 100
@@ -155,6 +163,10 @@ const (
     BigIntMul                = 6000
     BigIntSqrt               = 6000
     BigIntPow                = 6000
+    BigIntModPow             = 10000
+    BigIntModPowPerWordMul   = 80
+    BigIntModInverse         = 6000
+    BigIntGCD                = 6000
     BigIntLog                = 6000
     BigIntTDiv               = 6000
     BigIntTMod               = 6000
@@ -181,6 +193,24 @@ const (
     BigIntGetExternalBalance    = 10000
     CopyPerByteForTooBig        = 1000
 
+[BigFloatAPICost]
+    BigFloatNewFromParts         = 2000
+    BigFloatAdd                  = 2000
+    BigFloatSub                  = 2000
+    BigFloatMul                  = 2000
+    BigFloatDiv                  = 4000
+    BigFloatNeg                  = 1000
+    BigFloatCmp                  = 1000
+    BigFloatAbs                  = 1000
+    BigFloatSqrt                 = 5000
+    BigFloatPow                  = 2000
+    BigFloatTruncate             = 1000
+    BigFloatFloor                = 1000
+    BigFloatCeil                 = 1000
+    BigFloatSetBigInt            = 2000
+    MBufferToBigFloat            = 2000
+    MBufferFromBigFloat          = 2000
+
 [CryptoAPICost]
     SHA256                 = 1000000
     Keccak256              = 1000000
@@ -199,6 +229,31 @@ const (
     UnmarshalCompressedECC = 270000
     GenerateKeyECC         = 7000000
     EncodeDERSig           = 1000000
+    SHA512                 = 1000000
+    SHA512PerByte          = 100
+    SHA3256                = 1000000
+    SHA3256PerByte         = 150
+    Blake2b256             = 1000000
+    Blake2b256PerByte      = 80
+    Blake2b512             = 1000000
+    Blake2b512PerByte      = 80
+    Blake2s256             = 1000000
+    Blake2s256PerByte      = 120
+    RecoverSecp256k1       = 2000000
+    VerifySecp256r1        = 2000000
+    VerifyAggregatedBLS    = 5000000
+    AggregatedBLSPerKey    = 100000
+    AggregatedBLSPerMsg    = 2500000
+    Bn254G1Add             = 110000
+    Bn254G1ScalarMul       = 2300000
+    Bn254PairingCheck      = 13500000
+    Bn254PairingPerPair    = 8000000
+    VerifyGroth16          = 46000000
+    Groth16PerInput        = 1500000
+    VerifyEd25519Batch     = 1900000
+    Ed25519BatchPerSig     = 1200000
+    VerifyMerkleProof      = 1000000
+    MerkleProofPerStep     = 400000
 
 [ManagedBufferAPICost]
     MBufferNew                   = 2000
@@ -219,6 +274,48 @@ const (
     MBufferGetArgument           = 1000
     MBufferFinish                = 1000
     MBufferSetRandom             = 6000
+    MBufferCompare               = 2000
+    MBufferFind                  = 2000
+    MBufferSplit                 = 5000
+    MBufferParseDecimal          = 5000
+    MBufferFormatDecimal         = 5000
+    MBufferToBase64              = 2000
+    MBufferFromBase64            = 2000
+    MBufferFromHex               = 2000
+    MBufferTopEncodeU64          = 1000
+    MBufferTopDecodeU64          = 1000
+    MBufferNestedEncode          = 2000
+    MBufferNestedDecode          = 2000
+
+[ManagedMapAPICost]
+    MMapNew                      = 2000
+    MMapPut                      = 2000
+    MMapGet                      = 2000
+    MMapContains                 = 1000
+    MMapRemove                   = 2000
+    MMapLen                      = 1000
+    MMapKeys                     = 2000
+
+[ManagedVecAPICost]
+    MVecNew                      = 2000
+    MVecPush                     = 2000
+    MVecGet                      = 1000
+    MVecSet                      = 2000
+    MVecRemove                   = 2000
+    MVecLen                      = 1000
+    MVecSlice                    = 2000
+    MVecConcat                   = 2000
+    MVecPushBigInt               = 3000
+    MVecGetBigInt                = 2000
+    MVecSetBigInt                = 3000
+    MVecPushU64                  = 2000
+    MVecGetU64                   = 1000
+    MVecSetU64                   = 2000
+
+[ManagedMemoryAPICost]
+    ManagedFree                  = 500
+    ManagedHandleAllocation      = 100
+    ManagedByteAllocation        = 10
 
 [WASMOpcodeCost]
     Unreachable = 1
@@ -759,6 +856,7 @@ const (
     DelegateExecution    = 100000
     AsyncCallStep        = 100000
     AsyncCallbackGasLock = 4000000
+    CreateAsyncCallESDT  = 100000
     ExecuteReadOnly      = 160000
     CreateContract       = 300000
     GetReturnData        = 100
@@ -767,6 +865,13 @@ const (
     CleanReturnData      = 100
     DeleteFromReturnData = 100
     GetOriginalTxHash    = 10000
+    GetAccountInfo       = 7000
+    GetCallerNonce       = 100
+    GetNumberOfShards    = 100
+    GetSelfShardID       = 100
+    GetGasPrice          = 100
+    GetGasProvided       = 100
+    GetOriginalCaller    = 100
 
 [EthAPICost]
     UseGas              = 100
@@ -822,6 +927,10 @@ const (
     BigIntMul                = 6000
     BigIntSqrt               = 6000
     BigIntPow                = 6000
+    BigIntModPow             = 10000
+    BigIntModPowPerWordMul   = 80
+    BigIntModInverse         = 6000
+    BigIntGCD                = 6000
     BigIntLog                = 6000
     BigIntTDiv               = 6000
     BigIntTMod               = 6000
@@ -848,6 +957,24 @@ const (
     BigIntGetExternalBalance    = 10000
     CopyPerByteForTooBig        = 1000
 
+[BigFloatAPICost]
+    BigFloatNewFromParts         = 2000
+    BigFloatAdd                  = 2000
+    BigFloatSub                  = 2000
+    BigFloatMul                  = 2000
+    BigFloatDiv                  = 4000
+    BigFloatNeg                  = 1000
+    BigFloatCmp                  = 1000
+    BigFloatAbs                  = 1000
+    BigFloatSqrt                 = 5000
+    BigFloatPow                  = 2000
+    BigFloatTruncate             = 1000
+    BigFloatFloor                = 1000
+    BigFloatCeil                 = 1000
+    BigFloatSetBigInt            = 2000
+    MBufferToBigFloat            = 2000
+    MBufferFromBigFloat          = 2000
+
 [CryptoAPICost]
     SHA256                 = 1000000
     Keccak256              = 1000000
@@ -866,6 +993,31 @@ const (
     UnmarshalCompressedECC = 270000
     GenerateKeyECC         = 7000000
     EncodeDERSig           = 10000000
+    SHA512                 = 1000000
+    SHA512PerByte          = 100
+    SHA3256                = 1000000
+    SHA3256PerByte         = 150
+    Blake2b256             = 1000000
+    Blake2b256PerByte      = 80
+    Blake2b512             = 1000000
+    Blake2b512PerByte      = 80
+    Blake2s256             = 1000000
+    Blake2s256PerByte      = 120
+    RecoverSecp256k1       = 2000000
+    VerifySecp256r1        = 2000000
+    VerifyAggregatedBLS    = 5000000
+    AggregatedBLSPerKey    = 100000
+    AggregatedBLSPerMsg    = 2500000
+    Bn254G1Add             = 110000
+    Bn254G1ScalarMul       = 2300000
+    Bn254PairingCheck      = 13500000
+    Bn254PairingPerPair    = 8000000
+    VerifyGroth16          = 46000000
+    Groth16PerInput        = 1500000
+    VerifyEd25519Batch     = 1900000
+    Ed25519BatchPerSig     = 1200000
+    VerifyMerkleProof      = 1000000
+    MerkleProofPerStep     = 400000
 
 [ManagedBufferAPICost]
     MBufferNew                   = 2000
@@ -886,6 +1038,48 @@ const (
     MBufferGetArgument           = 1000
     MBufferFinish                = 1000
     MBufferSetRandom             = 6000
+    MBufferCompare               = 2000
+    MBufferFind                  = 2000
+    MBufferSplit                 = 5000
+    MBufferParseDecimal          = 5000
+    MBufferFormatDecimal         = 5000
+    MBufferToBase64              = 2000
+    MBufferFromBase64            = 2000
+    MBufferFromHex               = 2000
+    MBufferTopEncodeU64          = 1000
+    MBufferTopDecodeU64          = 1000
+    MBufferNestedEncode          = 2000
+    MBufferNestedDecode          = 2000
+
+[ManagedMapAPICost]
+    MMapNew                      = 2000
+    MMapPut                      = 2000
+    MMapGet                      = 2000
+    MMapContains                 = 1000
+    MMapRemove                   = 2000
+    MMapLen                      = 1000
+    MMapKeys                     = 2000
+
+[ManagedVecAPICost]
+    MVecNew                      = 2000
+    MVecPush                     = 2000
+    MVecGet                      = 1000
+    MVecSet                      = 2000
+    MVecRemove                   = 2000
+    MVecLen                      = 1000
+    MVecSlice                    = 2000
+    MVecConcat                   = 2000
+    MVecPushBigInt               = 3000
+    MVecGetBigInt                = 2000
+    MVecSetBigInt                = 3000
+    MVecPushU64                  = 2000
+    MVecGetU64                   = 1000
+    MVecSetU64                   = 2000
+
+[ManagedMemoryAPICost]
+    ManagedFree                  = 500
+    ManagedHandleAllocation      = 100
+    ManagedByteAllocation        = 10
 
 [WASMOpcodeCost]
     Unreachable = 5
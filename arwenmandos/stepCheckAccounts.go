@@ -128,6 +128,11 @@ func (ae *ArwenTestExecutor) checkAccounts(baseErrMsg string, checkAccounts *mj.
 		if err != nil {
 			return err
 		}
+
+		err = ae.checkAccountAsyncCalls(baseErrMsg, expectedAcct, matchingAcct)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -156,6 +161,10 @@ func (ae *ArwenTestExecutor) checkAccountStorage(baseErrMsg string, expectedAcct
 		if strings.HasPrefix(k, core.ElrondProtectedKeyPrefix) {
 			continue
 		}
+		// async calls are checked separately, when specified
+		if expectedAcct.ExplicitAsyncCalls && isAsyncCallsStorageKey(k) {
+			continue
+		}
 
 		want, specified := expectedStorage[k]
 		if !specified {
@@ -10,6 +10,7 @@ import (
 	mjwrite "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/json/write"
 	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
 	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/storagemapper"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -18,25 +19,28 @@ import (
 const includeElrondProtectedStorage = false
 
 func (ae *ArwenTestExecutor) convertMockAccountToMandosFormat(account *worldmock.Account) (*mj.Account, error) {
-	var storageKeys []string
-	for storageKey := range account.Storage {
-		storageKeys = append(storageKeys, storageKey)
+	storage := make(map[string][]byte)
+	for storageKey, storageValue := range account.Storage {
+		includeKey := includeElrondProtectedStorage || !strings.HasPrefix(storageKey, core.ElrondProtectedKeyPrefix)
+		// async calls are shown decoded, in their own section
+		includeKey = includeKey && !isAsyncCallsStorageKey(storageKey)
+		if includeKey && len(storageValue) > 0 {
+			storage[storageKey] = storageValue
+		}
 	}
 
-	sort.Strings(storageKeys)
+	// keys are grouped by storage mapper, in the logical order of each mapper
 	var storageKvps []*mj.StorageKeyValuePair
-	for _, storageKey := range storageKeys {
-		storageValue := account.Storage[storageKey]
-		includeKey := includeElrondProtectedStorage || !strings.HasPrefix(storageKey, core.ElrondProtectedKeyPrefix)
-		if includeKey && len(storageValue) > 0 {
+	for _, mapper := range storagemapper.Decode(storage, nil) {
+		for _, storageKey := range mapper.StorageKeys {
 			storageKvps = append(storageKvps, &mj.StorageKeyValuePair{
 				Key: mj.JSONBytesFromString{
-					Value:    []byte(storageKey),
-					Original: ae.exprReconstructor.Reconstruct([]byte(storageKey), er.NoHint),
+					Value:    storageKey.Key,
+					Original: mapper.KeyExpression(storageKey, &ae.exprReconstructor),
 				},
 				Value: mj.JSONBytesFromTree{
-					Value:    storageValue,
-					Original: &oj.OJsonString{Value: ae.exprReconstructor.Reconstruct(storageValue, er.NoHint)},
+					Value:    storageKey.Value,
+					Original: &oj.OJsonString{Value: mapper.ValueExpression(storageKey, &ae.exprReconstructor)},
 				},
 			})
 		}
@@ -138,6 +142,11 @@ func (ae *ArwenTestExecutor) convertMockAccountToMandosFormat(account *worldmock
 		})
 	}
 
+	asyncCalls, err := ae.convertAsyncCallsToMandosFormat(account)
+	if err != nil {
+		return nil, err
+	}
+
 	return &mj.Account{
 		Address: mj.JSONBytesFromString{
 			Value:    account.Address,
@@ -151,8 +160,9 @@ func (ae *ArwenTestExecutor) convertMockAccountToMandosFormat(account *worldmock
 			Value:    account.Balance,
 			Original: ae.exprReconstructor.ReconstructFromBigInt(account.Balance),
 		},
-		Storage:  storageKvps,
-		ESDTData: mandosESDT,
+		Storage:    storageKvps,
+		ESDTData:   mandosESDT,
+		AsyncCalls: asyncCalls,
 		Owner: mj.JSONBytesFromString{
 			Value:    account.OwnerAddress,
 			Original: ae.exprReconstructor.Reconstruct(account.OwnerAddress, er.AddressHint),
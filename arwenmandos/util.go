@@ -25,6 +25,11 @@ func convertAccount(testAcct *mj.Account, world *worldmock.MockWorld) (*worldmoc
 		return nil, err
 	}
 
+	err = writeMandosAsyncCallsToStorage(testAcct.AsyncCalls, storage)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(testAcct.Address.Value) != 32 {
 		return nil, errors.New("bad test: account address should be 32 bytes long")
 	}
@@ -114,6 +114,13 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 		Destination: &args.AccountNonce,
 	}
 
+	// For get-account
+	flagABIPath := cli.StringFlag{
+		Name:        "abi-path",
+		Usage:       "contract ABI, used to decode the storage",
+		Destination: &args.ABIPath,
+	}
+
 	app.Flags = []cli.Flag{}
 
 	app.Authors = []cli.Author{
@@ -232,6 +239,21 @@ func initializeCLI(facade *arwendebug.DebugFacade) *cli.App {
 				flagAccountNonce,
 			},
 		},
+		{
+			Name:        "get-account",
+			Description: "get account, with decoded storage",
+			Action: func(context *cli.Context) error {
+				_, err := facade.GetAccount(args.toGetAccountRequest())
+				return err
+			},
+			Flags: []cli.Flag{
+				flagOutcome,
+				flagWorld,
+				flagDatabase,
+				flagAccountAddress,
+				flagABIPath,
+			},
+		},
 	}
 
 	return app
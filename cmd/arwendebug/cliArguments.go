@@ -27,6 +27,7 @@ type cliArguments struct {
 	AccountAddress string
 	AccountBalance string
 	AccountNonce   uint64
+	ABIPath        string
 }
 
 func (args *cliArguments) toDeployRequest() arwendebug.DeployRequest {
@@ -99,3 +100,12 @@ func (args *cliArguments) toCreateAccountRequest() arwendebug.CreateAccountReque
 	request.Nonce = args.AccountNonce
 	return *request
 }
+
+func (args *cliArguments) toGetAccountRequest() arwendebug.GetAccountRequest {
+	request := &arwendebug.GetAccountRequest{}
+	args.populateRequestBase(&request.RequestBase)
+
+	request.AddressHex = args.AccountAddress
+	request.ABIPath = args.ABIPath
+	return *request
+}
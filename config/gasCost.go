@@ -5,9 +5,13 @@ import "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/wasmer"
 type GasCost struct {
 	BaseOperationCost    BaseOperationCost
 	BigIntAPICost        BigIntAPICost
+	BigFloatAPICost      BigFloatAPICost
 	EthAPICost           EthAPICost
 	ElrondAPICost        ElrondAPICost
 	ManagedBufferAPICost ManagedBufferAPICost
+	ManagedMapAPICost    ManagedMapAPICost
+	ManagedVecAPICost    ManagedVecAPICost
+	ManagedMemoryAPICost ManagedMemoryAPICost
 	CryptoAPICost        CryptoAPICost
 	WASMOpcodeCost       WASMOpcodeCost
 }
@@ -59,12 +63,20 @@ type ElrondAPICost struct {
 	ExecuteReadOnly      uint64
 	AsyncCallStep        uint64
 	AsyncCallbackGasLock uint64
+	CreateAsyncCallESDT  uint64
 	CreateContract       uint64
 	GetReturnData        uint64
 	GetNumReturnData     uint64
 	GetReturnDataSize    uint64
 	CleanReturnData      uint64
 	DeleteFromReturnData uint64
+	GetAccountInfo       uint64
+	GetCallerNonce       uint64
+	GetNumberOfShards    uint64
+	GetSelfShardID       uint64
+	GetGasPrice          uint64
+	GetGasProvided       uint64
+	GetOriginalCaller    uint64
 }
 
 type EthAPICost struct {
@@ -119,6 +131,10 @@ type BigIntAPICost struct {
 	BigIntMul                  uint64
 	BigIntSqrt                 uint64
 	BigIntPow                  uint64
+	BigIntModPow               uint64
+	BigIntModPowPerWordMul     uint64
+	BigIntModInverse           uint64
+	BigIntGCD                  uint64
 	BigIntLog                  uint64
 	BigIntTDiv                 uint64
 	BigIntTMod                 uint64
@@ -145,6 +161,25 @@ type BigIntAPICost struct {
 	CopyPerByteForTooBig       uint64
 }
 
+type BigFloatAPICost struct {
+	BigFloatNewFromParts uint64
+	BigFloatAdd          uint64
+	BigFloatSub          uint64
+	BigFloatMul          uint64
+	BigFloatDiv          uint64
+	BigFloatNeg          uint64
+	BigFloatCmp          uint64
+	BigFloatAbs          uint64
+	BigFloatSqrt         uint64
+	BigFloatPow          uint64
+	BigFloatTruncate     uint64
+	BigFloatFloor        uint64
+	BigFloatCeil         uint64
+	BigFloatSetBigInt    uint64
+	MBufferToBigFloat    uint64
+	MBufferFromBigFloat  uint64
+}
+
 type CryptoAPICost struct {
 	SHA256                 uint64
 	Keccak256              uint64
@@ -163,6 +198,31 @@ type CryptoAPICost struct {
 	UnmarshalCompressedECC uint64
 	GenerateKeyECC         uint64
 	EncodeDERSig           uint64
+	SHA512                 uint64
+	SHA512PerByte          uint64
+	SHA3256                uint64
+	SHA3256PerByte         uint64
+	Blake2b256             uint64
+	Blake2b256PerByte      uint64
+	Blake2b512             uint64
+	Blake2b512PerByte      uint64
+	Blake2s256             uint64
+	Blake2s256PerByte      uint64
+	RecoverSecp256k1       uint64
+	VerifySecp256r1        uint64
+	VerifyAggregatedBLS    uint64
+	AggregatedBLSPerKey    uint64
+	AggregatedBLSPerMsg    uint64
+	Bn254G1Add             uint64
+	Bn254G1ScalarMul       uint64
+	Bn254PairingCheck      uint64
+	Bn254PairingPerPair    uint64
+	VerifyGroth16          uint64
+	Groth16PerInput        uint64
+	VerifyEd25519Batch     uint64
+	Ed25519BatchPerSig     uint64
+	VerifyMerkleProof      uint64
+	MerkleProofPerStep     uint64
 }
 
 type ManagedBufferAPICost struct {
@@ -184,6 +244,53 @@ type ManagedBufferAPICost struct {
 	MBufferGetArgument        uint64
 	MBufferFinish             uint64
 	MBufferSetRandom          uint64
+	MBufferCompare            uint64
+	MBufferFind               uint64
+	MBufferSplit              uint64
+	MBufferParseDecimal       uint64
+	MBufferFormatDecimal      uint64
+	MBufferToBase64           uint64
+	MBufferFromBase64         uint64
+	MBufferFromHex            uint64
+	MBufferTopEncodeU64       uint64
+	MBufferTopDecodeU64       uint64
+	MBufferNestedEncode       uint64
+	MBufferNestedDecode       uint64
+}
+
+type ManagedMapAPICost struct {
+	MMapNew      uint64
+	MMapPut      uint64
+	MMapGet      uint64
+	MMapContains uint64
+	MMapRemove   uint64
+	MMapLen      uint64
+	MMapKeys     uint64
+}
+
+type ManagedVecAPICost struct {
+	MVecNew        uint64
+	MVecPush       uint64
+	MVecGet        uint64
+	MVecSet        uint64
+	MVecRemove     uint64
+	MVecLen        uint64
+	MVecSlice      uint64
+	MVecConcat     uint64
+	MVecPushBigInt uint64
+	MVecGetBigInt  uint64
+	MVecSetBigInt  uint64
+	MVecPushU64    uint64
+	MVecGetU64     uint64
+	MVecSetU64     uint64
+}
+
+// ManagedMemoryAPICost holds the costs of the resident memory of the managed
+// types, charged whenever it grows above its highest level in the transaction
+type ManagedMemoryAPICost struct {
+	ManagedFree             uint64
+	ManagedHandleAllocation uint64
+	ManagedByteAllocation   uint64
 }
 
 type WASMOpcodeCost struct {
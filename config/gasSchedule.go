@@ -59,6 +59,17 @@ func CreateGasConfig(gasMap GasScheduleMap) (*GasCost, error) {
 		return nil, err
 	}
 
+	bigFloatOps := &BigFloatAPICost{}
+	err = mapstructure.Decode(gasMap["BigFloatAPICost"], bigFloatOps)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkForZeroUint64Fields(*bigFloatOps)
+	if err != nil {
+		return nil, err
+	}
+
 	cryptOps := &CryptoAPICost{}
 	err = mapstructure.Decode(gasMap["CryptoAPICost"], cryptOps)
 	if err != nil {
@@ -81,6 +92,39 @@ func CreateGasConfig(gasMap GasScheduleMap) (*GasCost, error) {
 		return nil, err
 	}
 
+	MMapOps := &ManagedMapAPICost{}
+	err = mapstructure.Decode(gasMap["ManagedMapAPICost"], MMapOps)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkForZeroUint64Fields(*MMapOps)
+	if err != nil {
+		return nil, err
+	}
+
+	MVecOps := &ManagedVecAPICost{}
+	err = mapstructure.Decode(gasMap["ManagedVecAPICost"], MVecOps)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkForZeroUint64Fields(*MVecOps)
+	if err != nil {
+		return nil, err
+	}
+
+	MMemoryOps := &ManagedMemoryAPICost{}
+	err = mapstructure.Decode(gasMap["ManagedMemoryAPICost"], MMemoryOps)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkForZeroUint64Fields(*MMemoryOps)
+	if err != nil {
+		return nil, err
+	}
+
 	opcodeCosts := &WASMOpcodeCost{}
 	err = mapstructure.Decode(gasMap["WASMOpcodeCost"], opcodeCosts)
 	if err != nil {
@@ -95,10 +139,14 @@ func CreateGasConfig(gasMap GasScheduleMap) (*GasCost, error) {
 	gasCost := &GasCost{
 		BaseOperationCost:    *baseOps,
 		BigIntAPICost:        *bigIntOps,
+		BigFloatAPICost:      *bigFloatOps,
 		EthAPICost:           *ethOps,
 		ElrondAPICost:        *elrondOps,
 		CryptoAPICost:        *cryptOps,
 		ManagedBufferAPICost: *MBufferOps,
+		ManagedMapAPICost:    *MMapOps,
+		ManagedVecAPICost:    *MVecOps,
+		ManagedMemoryAPICost: *MMemoryOps,
 		WASMOpcodeCost:       *opcodeCosts,
 	}
 
@@ -133,8 +181,12 @@ func FillGasMap(gasMap GasScheduleMap, value, asyncCallbackGasLock uint64) GasSc
 	gasMap["ElrondAPICost"] = FillGasMap_ElrondAPICosts(value, asyncCallbackGasLock)
 	gasMap["EthAPICost"] = FillGasMap_EthereumAPICosts(value)
 	gasMap["BigIntAPICost"] = FillGasMap_BigIntAPICosts(value)
+	gasMap["BigFloatAPICost"] = FillGasMap_BigFloatAPICosts(value)
 	gasMap["CryptoAPICost"] = FillGasMap_CryptoAPICosts(value)
 	gasMap["ManagedBufferAPICost"] = FillGasMap_ManagedBufferAPICosts(value)
+	gasMap["ManagedMapAPICost"] = FillGasMap_ManagedMapAPICosts(value)
+	gasMap["ManagedVecAPICost"] = FillGasMap_ManagedVecAPICosts(value)
+	gasMap["ManagedMemoryAPICost"] = FillGasMap_ManagedMemoryAPICosts(value)
 	gasMap["WASMOpcodeCost"] = FillGasMap_WASMOpcodeValues(value)
 
 	return gasMap
@@ -213,12 +265,20 @@ func FillGasMap_ElrondAPICosts(value, asyncCallbackGasLock uint64) map[string]ui
 	gasMap["ExecuteReadOnly"] = value
 	gasMap["AsyncCallStep"] = value
 	gasMap["AsyncCallbackGasLock"] = asyncCallbackGasLock
+	gasMap["CreateAsyncCallESDT"] = value
 	gasMap["CreateContract"] = value
 	gasMap["GetReturnData"] = value
 	gasMap["GetNumReturnData"] = value
 	gasMap["GetReturnDataSize"] = value
 	gasMap["CleanReturnData"] = value
 	gasMap["DeleteFromReturnData"] = value
+	gasMap["GetAccountInfo"] = value
+	gasMap["GetCallerNonce"] = value
+	gasMap["GetNumberOfShards"] = value
+	gasMap["GetSelfShardID"] = value
+	gasMap["GetGasPrice"] = value
+	gasMap["GetGasProvided"] = value
+	gasMap["GetOriginalCaller"] = value
 
 	return gasMap
 }
@@ -279,6 +339,10 @@ func FillGasMap_BigIntAPICosts(value uint64) map[string]uint64 {
 	gasMap["BigIntMul"] = value
 	gasMap["BigIntSqrt"] = value
 	gasMap["BigIntPow"] = value
+	gasMap["BigIntModPow"] = value
+	gasMap["BigIntModPowPerWordMul"] = value
+	gasMap["BigIntModInverse"] = value
+	gasMap["BigIntGCD"] = value
 	gasMap["BigIntLog"] = value
 	gasMap["BigIntTDiv"] = value
 	gasMap["BigIntTMod"] = value
@@ -307,6 +371,28 @@ func FillGasMap_BigIntAPICosts(value uint64) map[string]uint64 {
 	return gasMap
 }
 
+func FillGasMap_BigFloatAPICosts(value uint64) map[string]uint64 {
+	gasMap := make(map[string]uint64)
+	gasMap["BigFloatNewFromParts"] = value
+	gasMap["BigFloatAdd"] = value
+	gasMap["BigFloatSub"] = value
+	gasMap["BigFloatMul"] = value
+	gasMap["BigFloatDiv"] = value
+	gasMap["BigFloatNeg"] = value
+	gasMap["BigFloatCmp"] = value
+	gasMap["BigFloatAbs"] = value
+	gasMap["BigFloatSqrt"] = value
+	gasMap["BigFloatPow"] = value
+	gasMap["BigFloatTruncate"] = value
+	gasMap["BigFloatFloor"] = value
+	gasMap["BigFloatCeil"] = value
+	gasMap["BigFloatSetBigInt"] = value
+	gasMap["MBufferToBigFloat"] = value
+	gasMap["MBufferFromBigFloat"] = value
+
+	return gasMap
+}
+
 func FillGasMap_CryptoAPICosts(value uint64) map[string]uint64 {
 	gasMap := make(map[string]uint64)
 	gasMap["SHA256"] = value
@@ -326,6 +412,31 @@ func FillGasMap_CryptoAPICosts(value uint64) map[string]uint64 {
 	gasMap["UnmarshalCompressedECC"] = value
 	gasMap["GenerateKeyECC"] = value
 	gasMap["EncodeDERSig"] = value
+	gasMap["SHA512"] = value
+	gasMap["SHA512PerByte"] = value
+	gasMap["SHA3256"] = value
+	gasMap["SHA3256PerByte"] = value
+	gasMap["Blake2b256"] = value
+	gasMap["Blake2b256PerByte"] = value
+	gasMap["Blake2b512"] = value
+	gasMap["Blake2b512PerByte"] = value
+	gasMap["Blake2s256"] = value
+	gasMap["Blake2s256PerByte"] = value
+	gasMap["RecoverSecp256k1"] = value
+	gasMap["VerifySecp256r1"] = value
+	gasMap["VerifyAggregatedBLS"] = value
+	gasMap["AggregatedBLSPerKey"] = value
+	gasMap["AggregatedBLSPerMsg"] = value
+	gasMap["Bn254G1Add"] = value
+	gasMap["Bn254G1ScalarMul"] = value
+	gasMap["Bn254PairingCheck"] = value
+	gasMap["Bn254PairingPerPair"] = value
+	gasMap["VerifyGroth16"] = value
+	gasMap["Groth16PerInput"] = value
+	gasMap["VerifyEd25519Batch"] = value
+	gasMap["Ed25519BatchPerSig"] = value
+	gasMap["VerifyMerkleProof"] = value
+	gasMap["MerkleProofPerStep"] = value
 
 	return gasMap
 }
@@ -350,6 +461,60 @@ func FillGasMap_ManagedBufferAPICosts(value uint64) map[string]uint64 {
 	gasMap["MBufferGetArgument"] = value
 	gasMap["MBufferFinish"] = value
 	gasMap["MBufferSetRandom"] = value
+	gasMap["MBufferCompare"] = value
+	gasMap["MBufferFind"] = value
+	gasMap["MBufferSplit"] = value
+	gasMap["MBufferParseDecimal"] = value
+	gasMap["MBufferFormatDecimal"] = value
+	gasMap["MBufferToBase64"] = value
+	gasMap["MBufferFromBase64"] = value
+	gasMap["MBufferFromHex"] = value
+	gasMap["MBufferTopEncodeU64"] = value
+	gasMap["MBufferTopDecodeU64"] = value
+	gasMap["MBufferNestedEncode"] = value
+	gasMap["MBufferNestedDecode"] = value
+
+	return gasMap
+}
+
+func FillGasMap_ManagedMapAPICosts(value uint64) map[string]uint64 {
+	gasMap := make(map[string]uint64)
+	gasMap["MMapNew"] = value
+	gasMap["MMapPut"] = value
+	gasMap["MMapGet"] = value
+	gasMap["MMapContains"] = value
+	gasMap["MMapRemove"] = value
+	gasMap["MMapLen"] = value
+	gasMap["MMapKeys"] = value
+
+	return gasMap
+}
+
+func FillGasMap_ManagedVecAPICosts(value uint64) map[string]uint64 {
+	gasMap := make(map[string]uint64)
+	gasMap["MVecNew"] = value
+	gasMap["MVecPush"] = value
+	gasMap["MVecGet"] = value
+	gasMap["MVecSet"] = value
+	gasMap["MVecRemove"] = value
+	gasMap["MVecLen"] = value
+	gasMap["MVecSlice"] = value
+	gasMap["MVecConcat"] = value
+	gasMap["MVecPushBigInt"] = value
+	gasMap["MVecGetBigInt"] = value
+	gasMap["MVecSetBigInt"] = value
+	gasMap["MVecPushU64"] = value
+	gasMap["MVecGetU64"] = value
+	gasMap["MVecSetU64"] = value
+
+	return gasMap
+}
+
+func FillGasMap_ManagedMemoryAPICosts(value uint64) map[string]uint64 {
+	gasMap := make(map[string]uint64)
+	gasMap["ManagedFree"] = value
+	gasMap["ManagedHandleAllocation"] = value
+	gasMap["ManagedByteAllocation"] = value
 
 	return gasMap
 }
@@ -0,0 +1,194 @@
+// Package bn254 implements the operations over the alt_bn128 (BN254) curve
+// exposed by the Ethereum precompiles described in EIP-196 and EIP-197.
+//
+// The curve arithmetic and the pairing are provided by gnark-crypto. The point
+// encodings follow the Ethereum precompiles: a G₁ point is x ‖ y and a G₂ point
+// is x_imag ‖ x_real ‖ y_imag ‖ y_real, every coordinate as a 32 byte big
+// endian number lower than the field modulus, with the all-zero encoding
+// standing for the point at infinity.
+package bn254
+
+import (
+	"math/big"
+
+	gnark "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// FieldElementLength is the length in bytes of an encoded coordinate or scalar
+const FieldElementLength = 32
+
+// G1PointLength is the length in bytes of an encoded G₁ point
+const G1PointLength = 2 * FieldElementLength
+
+// G2PointLength is the length in bytes of an encoded G₂ point
+const G2PointLength = 4 * FieldElementLength
+
+// PairLength is the length in bytes of a (G₁, G₂) pair given to the pairing check
+const PairLength = G1PointLength + G2PointLength
+
+// Order is the order of G₁, G₂ and GT, i.e. the modulus of the scalars
+var Order = fr.Modulus()
+
+type bn254 struct {
+}
+
+// NewBN254 creates the implementation of the alt_bn128 curve operations
+func NewBN254() *bn254 {
+	return &bn254{}
+}
+
+// Bn254G1Add adds two G₁ points and returns the encoded sum
+func (bn *bn254) Bn254G1Add(a, b []byte) ([]byte, error) {
+	first, err := unmarshalG1(a)
+	if err != nil {
+		return nil, err
+	}
+	second, err := unmarshalG1(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var sum gnark.G1Affine
+	sum.Add(first, second)
+	return marshalG1(&sum), nil
+}
+
+// Bn254G1ScalarMul multiplies a G₁ point by a 32 byte big endian scalar and returns the encoded product
+func (bn *bn254) Bn254G1ScalarMul(point, scalar []byte) ([]byte, error) {
+	if len(scalar) != FieldElementLength {
+		return nil, ErrInvalidScalar
+	}
+
+	g1, err := unmarshalG1(point)
+	if err != nil {
+		return nil, err
+	}
+
+	// every point of the curve has order Order, so the scalar can be reduced,
+	// as expected by the scalar decomposition of gnark-crypto
+	reducedScalar := big.NewInt(0).SetBytes(scalar)
+	reducedScalar.Mod(reducedScalar, Order)
+
+	var product gnark.G1Affine
+	product.ScalarMultiplication(g1, reducedScalar)
+	return marshalG1(&product), nil
+}
+
+// Bn254PairingCheck receives a concatenation of (G₁, G₂) pairs and returns
+// whether the product of their pairings is the identity of GT. An empty input
+// yields true, as in EIP-197
+func (bn *bn254) Bn254PairingCheck(pairs []byte) (bool, error) {
+	if len(pairs)%PairLength != 0 {
+		return false, ErrInvalidPairingInput
+	}
+
+	numPairs := len(pairs) / PairLength
+	g1Points := make([]gnark.G1Affine, numPairs)
+	g2Points := make([]gnark.G2Affine, numPairs)
+	for i := 0; i < numPairs; i++ {
+		pair := pairs[i*PairLength : (i+1)*PairLength]
+
+		g1, err := unmarshalG1(pair[:G1PointLength])
+		if err != nil {
+			return false, err
+		}
+		g2, err := unmarshalG2(pair[G1PointLength:])
+		if err != nil {
+			return false, err
+		}
+
+		g1Points[i] = *g1
+		g2Points[i] = *g2
+	}
+
+	return pairingCheck(g1Points, g2Points)
+}
+
+// pairingCheck checks the product of the pairings, applying the final
+// exponentiation only once; an empty product is the identity, while
+// gnark-crypto rejects empty inputs
+func pairingCheck(g1Points []gnark.G1Affine, g2Points []gnark.G2Affine) (bool, error) {
+	if len(g1Points) == 0 {
+		return true, nil
+	}
+
+	return gnark.PairingCheck(g1Points, g2Points)
+}
+
+func unmarshalFieldElement(data []byte, element *fp.Element) error {
+	err := element.SetBytesCanonical(data)
+	if err != nil {
+		return ErrInvalidFieldElement
+	}
+	return nil
+}
+
+func marshalFieldElement(element *fp.Element, out []byte) {
+	bytes := element.Bytes()
+	copy(out, bytes[:])
+}
+
+// unmarshalG1 decodes a G₁ point; every point on the curve belongs to G₁, as its cofactor is 1
+func unmarshalG1(data []byte) (*gnark.G1Affine, error) {
+	if len(data) != G1PointLength {
+		return nil, ErrInvalidG1Point
+	}
+
+	point := &gnark.G1Affine{}
+	err := unmarshalFieldElement(data[:FieldElementLength], &point.X)
+	if err != nil {
+		return nil, err
+	}
+	err = unmarshalFieldElement(data[FieldElementLength:], &point.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	if point.IsInfinity() {
+		return point, nil
+	}
+	if !point.IsOnCurve() {
+		return nil, ErrInvalidG1Point
+	}
+
+	return point, nil
+}
+
+func marshalG1(point *gnark.G1Affine) []byte {
+	out := make([]byte, G1PointLength)
+	if point.IsInfinity() {
+		return out
+	}
+
+	marshalFieldElement(&point.X, out[:FieldElementLength])
+	marshalFieldElement(&point.Y, out[FieldElementLength:])
+	return out
+}
+
+// unmarshalG2 decodes a G₂ point; unlike G₁, the twist has points outside of
+// the subgroup of order Order, which are rejected
+func unmarshalG2(data []byte) (*gnark.G2Affine, error) {
+	if len(data) != G2PointLength {
+		return nil, ErrInvalidG2Point
+	}
+
+	point := &gnark.G2Affine{}
+	coordinates := []*fp.Element{&point.X.A1, &point.X.A0, &point.Y.A1, &point.Y.A0}
+	for i, coordinate := range coordinates {
+		err := unmarshalFieldElement(data[i*FieldElementLength:(i+1)*FieldElementLength], coordinate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if point.IsInfinity() {
+		return point, nil
+	}
+	if !point.IsOnCurve() || !point.IsInSubGroup() {
+		return nil, ErrInvalidG2Point
+	}
+
+	return point, nil
+}
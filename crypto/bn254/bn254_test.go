@@ -0,0 +1,329 @@
+package bn254
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"testing"
+
+	gnark "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the G₁ and G₂ generators, as given in EIP-197
+const g1Generator = "0000000000000000000000000000000000000000000000000000000000000001" +
+	"0000000000000000000000000000000000000000000000000000000000000002"
+const g2Generator = "198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2" +
+	"1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+	"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b" +
+	"12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa"
+
+func decodeHex(t testing.TB, str string) []byte {
+	data, err := hex.DecodeString(str)
+	require.Nil(t, err)
+	return data
+}
+
+func encodeScalar(scalar *big.Int) []byte {
+	out := make([]byte, FieldElementLength)
+	bytes := scalar.Bytes()
+	copy(out[FieldElementLength-len(bytes):], bytes)
+	return out
+}
+
+func marshalG2(point *gnark.G2Affine) []byte {
+	out := make([]byte, G2PointLength)
+	if point.IsInfinity() {
+		return out
+	}
+
+	coordinates := []*fp.Element{&point.X.A1, &point.X.A0, &point.Y.A1, &point.Y.A0}
+	for i, coordinate := range coordinates {
+		marshalFieldElement(coordinate, out[i*FieldElementLength:(i+1)*FieldElementLength])
+	}
+	return out
+}
+
+func scalarMulG1(scalar *big.Int) []byte {
+	_, _, g1Gen, _ := gnark.Generators()
+	var product gnark.G1Affine
+	product.ScalarMultiplication(&g1Gen, scalar)
+	return marshalG1(&product)
+}
+
+func scalarMulG2(scalar *big.Int) []byte {
+	_, _, _, g2Gen := gnark.Generators()
+	var product gnark.G2Affine
+	product.ScalarMultiplication(&g2Gen, scalar)
+	return marshalG2(&product)
+}
+
+func negateG1(t testing.TB, point []byte) []byte {
+	g1, err := unmarshalG1(point)
+	require.Nil(t, err)
+
+	var negated gnark.G1Affine
+	negated.Neg(g1)
+	return marshalG1(&negated)
+}
+
+func concat(parts ...[]byte) []byte {
+	result := make([]byte, 0)
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+	return result
+}
+
+func TestBn254_Generators(t *testing.T) {
+	t.Parallel()
+
+	_, _, g1Gen, g2Gen := gnark.Generators()
+	assert.Equal(t, g1Generator, hex.EncodeToString(marshalG1(&g1Gen)))
+	assert.Equal(t, g2Generator, hex.EncodeToString(marshalG2(&g2Gen)))
+}
+
+// the addition and multiplication vectors are shared with the bn256Add and bn256ScalarMul precompile tests of go-ethereum
+func TestBn254_G1Add(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	input := decodeHex(t, "18b18acfb4c2c30276db5411368e7185b311dd124691610c5d3b74034e093dc9"+
+		"063c909c4720840cb5134cb9f59fa749755796819658d32efc0d288198f37266"+
+		"07c2b7f58a84bd6145f00c9c2bc0bb1a187f20ff2c92963a88019e7c6a014eed"+
+		"06614e20c147e940f2d70da3f74c9a17df361706a4485c742bd6788478fa17d7")
+	expected := "2243525c5efd4b9c3d3c45ac0ca3fe4dd85e830a4ce6b65fa1eeaee202839703" +
+		"301d1d33be6da8e509df21cc35964723180eed7532537db9ae5e7d48f195c915"
+
+	result, err := bn.Bn254G1Add(input[:G1PointLength], input[G1PointLength:])
+	assert.Nil(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(result))
+
+	generator := decodeHex(t, g1Generator)
+	infinity := make([]byte, G1PointLength)
+	result, err = bn.Bn254G1Add(generator, infinity)
+	assert.Nil(t, err)
+	assert.Equal(t, generator, result)
+
+	result, err = bn.Bn254G1Add(generator, negateG1(t, generator))
+	assert.Nil(t, err)
+	assert.Equal(t, infinity, result)
+
+	result, err = bn.Bn254G1Add(generator, generator)
+	assert.Nil(t, err)
+	assert.Equal(t, scalarMulG1(big.NewInt(2)), result)
+}
+
+func TestBn254_G1AddInvalidPoints(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	generator := decodeHex(t, g1Generator)
+
+	notOnCurve := decodeHex(t, g1Generator)
+	notOnCurve[G1PointLength-1] = 3
+	_, err := bn.Bn254G1Add(generator, notOnCurve)
+	assert.Equal(t, ErrInvalidG1Point, err)
+
+	_, err = bn.Bn254G1Add(generator, generator[1:])
+	assert.Equal(t, ErrInvalidG1Point, err)
+
+	outsideField := concat(encodeScalar(big.NewInt(1).Add(fp.Modulus(), big.NewInt(1))), encodeScalar(big.NewInt(2)))
+	_, err = bn.Bn254G1Add(outsideField, generator)
+	assert.Equal(t, ErrInvalidFieldElement, err)
+}
+
+func TestBn254_G1ScalarMul(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	input := decodeHex(t, "2bd3e6d0f3b142924f5ca7b49ce5b9d54c4703d7ae5648e61d02268b1a0a9fb7"+
+		"21611ce0a6af85915e2f1d70300909ce2e49dfad4a4619c8390cae66cefdb204"+
+		"00000000000000000000000000000000000000000000000011138ce750fa15c2")
+	expected := "070a8d6a982153cae4be29d434e8faef8a47b274a053f5a4ee2a6c9c13c31e5c" +
+		"031b8ce914eba3a9ffb989f9cdd5b0f01943074bf4f0f315690ec3cec6981afc"
+
+	result, err := bn.Bn254G1ScalarMul(input[:G1PointLength], input[G1PointLength:])
+	assert.Nil(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(result))
+
+	generator := decodeHex(t, g1Generator)
+	result, err = bn.Bn254G1ScalarMul(generator, encodeScalar(Order))
+	assert.Nil(t, err)
+	assert.Equal(t, make([]byte, G1PointLength), result)
+
+	_, err = bn.Bn254G1ScalarMul(generator, []byte{2})
+	assert.Equal(t, ErrInvalidScalar, err)
+}
+
+func TestBn254_PairingCheck(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	g1 := decodeHex(t, g1Generator)
+	g2 := decodeHex(t, g2Generator)
+
+	ok, err := bn.Bn254PairingCheck(nil)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = bn.Bn254PairingCheck(concat(g1, g2))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = bn.Bn254PairingCheck(concat(g1, g2, negateG1(t, g1), g2))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = bn.Bn254PairingCheck(concat(make([]byte, G1PointLength), g2))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// e(a·G₁, b·G₂) = e(ab·G₁, G₂)
+	a, b := big.NewInt(0x1234567), big.NewInt(0x89abcdef)
+	ab := big.NewInt(0).Mul(a, b)
+	ok, err = bn.Bn254PairingCheck(concat(scalarMulG1(a), scalarMulG2(b), negateG1(t, scalarMulG1(ab)), g2))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = bn.Bn254PairingCheck(concat(scalarMulG1(a), scalarMulG2(b), negateG1(t, scalarMulG1(a)), g2))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestBn254_PairingCheckInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	g1 := decodeHex(t, g1Generator)
+	g2 := decodeHex(t, g2Generator)
+
+	_, err := bn.Bn254PairingCheck(concat(g1, g2)[1:])
+	assert.Equal(t, ErrInvalidPairingInput, err)
+
+	notOnTwist := decodeHex(t, g2Generator)
+	notOnTwist[G2PointLength-1]++
+	_, err = bn.Bn254PairingCheck(concat(g1, notOnTwist))
+	assert.Equal(t, ErrInvalidG2Point, err)
+
+	swapped := concat(g2[FieldElementLength:2*FieldElementLength], g2[:FieldElementLength], g2[2*FieldElementLength:])
+	_, err = bn.Bn254PairingCheck(concat(g1, swapped))
+	assert.Equal(t, ErrInvalidG2Point, err)
+}
+
+// precompileTestVector is a test case of the go-ethereum precompile tests, copied to the testdata
+// folder from core/vm/testdata/precompiles of go-ethereum v1.10.26
+type precompileTestVector struct {
+	Input    string
+	Expected string
+	Name     string
+}
+
+func loadPrecompileTestVectors(t *testing.T, fileName string) []precompileTestVector {
+	data, err := ioutil.ReadFile("testdata/" + fileName)
+	require.Nil(t, err)
+
+	vectors := make([]precompileTestVector, 0)
+	err = json.Unmarshal(data, &vectors)
+	require.Nil(t, err)
+	require.NotEmpty(t, vectors)
+	return vectors
+}
+
+// padInput mirrors the precompiles, which pad a shorter input with zeros and ignore the excess of a longer one
+func padInput(t *testing.T, input string, length int) []byte {
+	padded := make([]byte, length)
+	copy(padded, decodeHex(t, input))
+	return padded
+}
+
+func TestBn254_EIP196AddVectors(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	for _, vector := range loadPrecompileTestVectors(t, "bn256Add.json") {
+		input := padInput(t, vector.Input, 2*G1PointLength)
+		result, err := bn.Bn254G1Add(input[:G1PointLength], input[G1PointLength:])
+		require.Nil(t, err, vector.Name)
+		assert.Equal(t, vector.Expected, hex.EncodeToString(result), vector.Name)
+	}
+}
+
+func TestBn254_EIP196ScalarMulVectors(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	for _, vector := range loadPrecompileTestVectors(t, "bn256ScalarMul.json") {
+		input := padInput(t, vector.Input, G1PointLength+FieldElementLength)
+		result, err := bn.Bn254G1ScalarMul(input[:G1PointLength], input[G1PointLength:])
+		require.Nil(t, err, vector.Name)
+		assert.Equal(t, vector.Expected, hex.EncodeToString(result), vector.Name)
+	}
+}
+
+func TestBn254_EIP197PairingVectors(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	for _, vector := range loadPrecompileTestVectors(t, "bn256Pairing.json") {
+		ok, err := bn.Bn254PairingCheck(decodeHex(t, vector.Input))
+		require.Nil(t, err, vector.Name)
+
+		expected := make([]byte, FieldElementLength)
+		if ok {
+			expected[FieldElementLength-1] = 1
+		}
+		assert.Equal(t, vector.Expected, hex.EncodeToString(expected), vector.Name)
+	}
+}
+
+func BenchmarkBn254_G1Add(b *testing.B) {
+	bn := NewBN254()
+	p1, p2 := scalarMulG1(big.NewInt(3)), scalarMulG1(big.NewInt(5))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = bn.Bn254G1Add(p1, p2)
+	}
+}
+
+func BenchmarkBn254_G1ScalarMul(b *testing.B) {
+	bn := NewBN254()
+	point := scalarMulG1(big.NewInt(3))
+	scalar := encodeScalar(big.NewInt(0).Sub(Order, big.NewInt(1)))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = bn.Bn254G1ScalarMul(point, scalar)
+	}
+}
+
+func BenchmarkBn254_PairingCheck1(b *testing.B) {
+	benchmarkPairingCheck(b, 1)
+}
+
+func BenchmarkBn254_PairingCheck2(b *testing.B) {
+	benchmarkPairingCheck(b, 2)
+}
+
+func BenchmarkBn254_PairingCheck4(b *testing.B) {
+	benchmarkPairingCheck(b, 4)
+}
+
+func benchmarkPairingCheck(b *testing.B, numPairs int) {
+	bn := NewBN254()
+	g2 := decodeHex(b, g2Generator)
+	pairs := make([]byte, 0)
+	for i := 0; i < numPairs; i++ {
+		pairs = append(pairs, scalarMulG1(big.NewInt(int64(i+2)))...)
+		pairs = append(pairs, g2...)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = bn.Bn254PairingCheck(pairs)
+	}
+}
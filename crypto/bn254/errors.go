@@ -0,0 +1,30 @@
+package bn254
+
+import "errors"
+
+// ErrInvalidFieldElement signals that a coordinate is not lower than the field modulus
+var ErrInvalidFieldElement = errors.New("invalid field element")
+
+// ErrInvalidG1Point signals that the data does not encode a point of G1
+var ErrInvalidG1Point = errors.New("invalid G1 point")
+
+// ErrInvalidG2Point signals that the data does not encode a point of G2
+var ErrInvalidG2Point = errors.New("invalid G2 point")
+
+// ErrInvalidScalar signals that a scalar does not have the expected length or is not lower than the group order
+var ErrInvalidScalar = errors.New("invalid scalar")
+
+// ErrInvalidPairingInput signals that the pairing input is not made of whole (G1, G2) pairs
+var ErrInvalidPairingInput = errors.New("invalid pairing input length")
+
+// ErrInvalidVerifyingKey signals that a Groth16 verifying key could not be decoded
+var ErrInvalidVerifyingKey = errors.New("invalid verifying key")
+
+// ErrInvalidProof signals that a Groth16 proof could not be decoded
+var ErrInvalidProof = errors.New("invalid proof")
+
+// ErrInvalidPublicInputs signals that the public inputs do not match the verifying key
+var ErrInvalidPublicInputs = errors.New("invalid public inputs")
+
+// ErrProofVerificationFailed signals that a well formed Groth16 proof does not verify
+var ErrProofVerificationFailed = errors.New("proof verification failed")
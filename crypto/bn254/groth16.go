@@ -0,0 +1,124 @@
+package bn254
+
+import (
+	"math/big"
+
+	gnark "github.com/consensys/gnark-crypto/ecc/bn254"
+)
+
+// groth16FixedKeyLength is the length of alpha (G₁), beta, gamma and delta (G₂) in a verifying key
+const groth16FixedKeyLength = G1PointLength + 3*G2PointLength
+
+// Groth16ProofLength is the length of an encoded proof: A (G₁) ‖ B (G₂) ‖ C (G₁)
+const Groth16ProofLength = 2*G1PointLength + G2PointLength
+
+type groth16VerifyingKey struct {
+	alpha *gnark.G1Affine
+	beta  *gnark.G2Affine
+	gamma *gnark.G2Affine
+	delta *gnark.G2Affine
+	ic    []gnark.G1Affine
+}
+
+// NumGroth16PublicInputs returns the number of public inputs expected by an encoded verifying key
+func NumGroth16PublicInputs(vk []byte) (int, error) {
+	icLength := len(vk) - groth16FixedKeyLength
+	if icLength < G1PointLength || icLength%G1PointLength != 0 {
+		return 0, ErrInvalidVerifyingKey
+	}
+	return icLength/G1PointLength - 1, nil
+}
+
+// VerifyGroth16 checks a Groth16 proof against a verifying key and the public inputs.
+// The verifying key is alpha (G₁) ‖ beta ‖ gamma ‖ delta (G₂) ‖ IC₀ ‖ ... ‖ ICₙ (G₁),
+// the proof is A (G₁) ‖ B (G₂) ‖ C (G₁) and the public inputs are n scalars of 32 bytes each.
+// The proof is accepted when e(-A, B)·e(alpha, beta)·e(L, gamma)·e(C, delta) = 1,
+// where L = IC₀ + Σ xᵢ·ICᵢ
+func (bn *bn254) VerifyGroth16(vk, proof, publicInputs []byte) error {
+	key, err := unmarshalGroth16VerifyingKey(vk)
+	if err != nil {
+		return err
+	}
+
+	if len(proof) != Groth16ProofLength {
+		return ErrInvalidProof
+	}
+	a, err := unmarshalG1(proof[:G1PointLength])
+	if err != nil {
+		return ErrInvalidProof
+	}
+	b, err := unmarshalG2(proof[G1PointLength : G1PointLength+G2PointLength])
+	if err != nil {
+		return ErrInvalidProof
+	}
+	c, err := unmarshalG1(proof[G1PointLength+G2PointLength:])
+	if err != nil {
+		return ErrInvalidProof
+	}
+
+	numInputs := len(key.ic) - 1
+	if len(publicInputs) != numInputs*FieldElementLength {
+		return ErrInvalidPublicInputs
+	}
+
+	var linearCombination gnark.G1Jac
+	linearCombination.FromAffine(&key.ic[0])
+	var term gnark.G1Jac
+	for i := 0; i < numInputs; i++ {
+		input := big.NewInt(0).SetBytes(publicInputs[i*FieldElementLength : (i+1)*FieldElementLength])
+		if input.Cmp(Order) >= 0 {
+			return ErrInvalidPublicInputs
+		}
+		term.ScalarMultiplicationAffine(&key.ic[i+1], input)
+		linearCombination.AddAssign(&term)
+	}
+
+	var minusA, l gnark.G1Affine
+	minusA.Neg(a)
+	l.FromJacobian(&linearCombination)
+
+	g1Points := []gnark.G1Affine{minusA, *key.alpha, l, *c}
+	g2Points := []gnark.G2Affine{*b, *key.beta, *key.gamma, *key.delta}
+	ok, err := pairingCheck(g1Points, g2Points)
+	if err != nil || !ok {
+		return ErrProofVerificationFailed
+	}
+
+	return nil
+}
+
+func unmarshalGroth16VerifyingKey(vk []byte) (*groth16VerifyingKey, error) {
+	numInputs, err := NumGroth16PublicInputs(vk)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &groth16VerifyingKey{
+		ic: make([]gnark.G1Affine, numInputs+1),
+	}
+	key.alpha, err = unmarshalG1(vk[:G1PointLength])
+	if err != nil {
+		return nil, ErrInvalidVerifyingKey
+	}
+
+	g2Offset := G1PointLength
+	g2Points := []**gnark.G2Affine{&key.beta, &key.gamma, &key.delta}
+	for _, g2Point := range g2Points {
+		*g2Point, err = unmarshalG2(vk[g2Offset : g2Offset+G2PointLength])
+		if err != nil {
+			return nil, ErrInvalidVerifyingKey
+		}
+		g2Offset += G2PointLength
+	}
+
+	for i := range key.ic {
+		icOffset := groth16FixedKeyLength + i*G1PointLength
+		icPoint, err := unmarshalG1(vk[icOffset : icOffset+G1PointLength])
+		if err != nil {
+			return nil, ErrInvalidVerifyingKey
+		}
+		key.ic[i] = *icPoint
+	}
+
+	return key, nil
+}
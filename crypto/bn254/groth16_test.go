@@ -0,0 +1,162 @@
+package bn254
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gnarkCubicVerifyingKey, gnarkCubicProof and gnarkCubicPublicInput were generated by gnark v0.8.0 for the
+// circuit x³ + x + 5 = y, with the secret x = 3 and the public y = 35, and checked by its own verifier
+const gnarkCubicVerifyingKey = "07be209865be98ebfddb1e49ff0c5c97344f86b0bb73e747f34d88d4ac19234e" +
+	"24af3c3bbf8552774679c35fa18d80eace1b192cdbe883d7b898fa527d425b3b" +
+	"157634260884da0bd03d430c1ec13549880954ffb88978da3a835ccca9198585" +
+	"0a6a5636d3c2bc79478ea7a717d2defcfba7e60580fde5ec2cd18f54e5e65c28" +
+	"1f36e79d4f9eedd00083bb35838658b3d942e43288250d9fbd92bf48e788eb21" +
+	"2ddb3bf83056148d6c02a1c080ab3cf7d2547e2fd22d549750e3bb5194ba7bd8" +
+	"043f00ae7385388ece0472f307e1c0aa71df419ebfcaa431cdfba2e4131f6e36" +
+	"09f9afcd1832ec6e094f9cc7a661eaeb9844ab70a4652a7bbad30d2a5c856e89" +
+	"25295e205e92bab7f267d4f7a16bceefcd96aee0ba51e34eeeab083845f2b004" +
+	"1aefb8d60a513e1c757e2f6a46712e2244986199e60fccd176e49628e2f06c5d" +
+	"02e8516258462ce4c03c26837409bf6dc7ba4115b521089983a58adfebd4277a" +
+	"1114e9c9c312733a6d1a92f4d1710581f21cbbf6dcb4d691d0206cf5a566bf44" +
+	"06eeb930a046ef040aa06bbe5e376a58acca2947cc4c65b8d97ad274273d8203" +
+	"24be6e35f9c44dbcd3dacad50d9e54d2dc31c9847a62de4bc8e2210834340395" +
+	"2ef971e2949b02b8f246b447f139ab652f830e3cf3c05a7886d05b25fad900d9" +
+	"25a79787f4804730da02160d2363b9ebf9629afc9965cddd0446a6ff54e3d839" +
+	"0e1fe774e8910aabdc23b08ee2fb8d104786c6dc95ad49afed03c9fc646aad07" +
+	"130fdea4712f9ada64c32bab776fc27d1019db87f846efbfbb0e7b183408da8e"
+
+const gnarkCubicProof = "0b16d3dd6af6b151debfee5baa9fcd9e74134e1131f74e38ef57a17276b29a4a" +
+	"2ad6a02fc53812c57a207ed91c92c360488a25328e435fa1fddb3a23774d491e" +
+	"1e8118a2d365e70a4dd9bf97c6fe66adc860650728c8db5d051e702a2f4af155" +
+	"093a593a94dbacb0d8651d885bb5301beb709b2d87ac5784354134c9e790de16" +
+	"0092424cfa83301c301cfe57d7c1a589e6fdd77e9ef45403169d77b2d270061d" +
+	"165690764d44b6177cb5671789d108f32226490ecf33679866700cc3f9900e69" +
+	"1fa729046768092cb0eff21d88122ffd8372558dc0d27e6cb78ff5557bb62e8e" +
+	"29bd990a7f7da1193afc487ff4e74d976ce1a5275672aa7e5578ff095002aad8"
+
+const gnarkCubicPublicInput = "0000000000000000000000000000000000000000000000000000000000000023"
+
+type groth16TestInstance struct {
+	vk           []byte
+	proof        []byte
+	publicInputs []byte
+}
+
+// createGroth16TestInstance simulates a proof with the knowledge of the trapdoor:
+// A = a·G₁, B = b·G₂ and C = c·G₁ with c = (ab - alpha·beta - l·gamma)/delta,
+// where l = ic₀ + Σ xᵢ·icᵢ, so that the verification equation holds
+func createGroth16TestInstance(inputs []int64) *groth16TestInstance {
+	alpha, beta, gamma, delta := big.NewInt(3), big.NewInt(5), big.NewInt(7), big.NewInt(11)
+	a, b := big.NewInt(13), big.NewInt(17)
+
+	vk := concat(scalarMulG1(alpha), scalarMulG2(beta), scalarMulG2(gamma), scalarMulG2(delta))
+	publicInputs := make([]byte, 0)
+
+	ic := big.NewInt(19)
+	l := big.NewInt(0).Set(ic)
+	vk = append(vk, scalarMulG1(ic)...)
+	for i, input := range inputs {
+		ic = big.NewInt(int64(23 + i))
+		vk = append(vk, scalarMulG1(ic)...)
+
+		x := big.NewInt(input)
+		publicInputs = append(publicInputs, encodeScalar(x)...)
+		l.Add(l, big.NewInt(0).Mul(x, ic))
+	}
+
+	c := big.NewInt(0).Mul(a, b)
+	c.Sub(c, big.NewInt(0).Mul(alpha, beta))
+	c.Sub(c, big.NewInt(0).Mul(l, gamma))
+	c.Mul(c, big.NewInt(0).ModInverse(delta, Order))
+	c.Mod(c, Order)
+
+	return &groth16TestInstance{
+		vk:           vk,
+		proof:        concat(scalarMulG1(a), scalarMulG2(b), scalarMulG1(c)),
+		publicInputs: publicInputs,
+	}
+}
+
+func TestBn254_VerifyGroth16(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	instance := createGroth16TestInstance([]int64{42, 1000})
+	assert.Nil(t, bn.VerifyGroth16(instance.vk, instance.proof, instance.publicInputs))
+
+	numInputs, err := NumGroth16PublicInputs(instance.vk)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numInputs)
+
+	instance = createGroth16TestInstance(nil)
+	assert.Nil(t, bn.VerifyGroth16(instance.vk, instance.proof, instance.publicInputs))
+}
+
+func TestBn254_VerifyGroth16GnarkProof(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	vk := decodeHex(t, gnarkCubicVerifyingKey)
+	proof := decodeHex(t, gnarkCubicProof)
+
+	numInputs, err := NumGroth16PublicInputs(vk)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numInputs)
+
+	assert.Nil(t, bn.VerifyGroth16(vk, proof, decodeHex(t, gnarkCubicPublicInput)))
+
+	err = bn.VerifyGroth16(vk, proof, encodeScalar(big.NewInt(36)))
+	assert.Equal(t, ErrProofVerificationFailed, err)
+}
+
+func TestBn254_VerifyGroth16WrongInputs(t *testing.T) {
+	t.Parallel()
+
+	bn := NewBN254()
+	instance := createGroth16TestInstance([]int64{42, 1000})
+
+	wrongInputs := concat(encodeScalar(big.NewInt(43)), encodeScalar(big.NewInt(1000)))
+	err := bn.VerifyGroth16(instance.vk, instance.proof, wrongInputs)
+	assert.Equal(t, ErrProofVerificationFailed, err)
+
+	wrongProof := concat(instance.proof[:G1PointLength+G2PointLength], scalarMulG1(big.NewInt(1)))
+	err = bn.VerifyGroth16(instance.vk, wrongProof, instance.publicInputs)
+	assert.Equal(t, ErrProofVerificationFailed, err)
+
+	err = bn.VerifyGroth16(instance.vk, instance.proof, instance.publicInputs[FieldElementLength:])
+	assert.Equal(t, ErrInvalidPublicInputs, err)
+
+	outOfRange := concat(encodeScalar(Order), instance.publicInputs[FieldElementLength:])
+	err = bn.VerifyGroth16(instance.vk, instance.proof, outOfRange)
+	assert.Equal(t, ErrInvalidPublicInputs, err)
+
+	err = bn.VerifyGroth16(instance.vk, instance.proof[1:], instance.publicInputs)
+	assert.Equal(t, ErrInvalidProof, err)
+
+	err = bn.VerifyGroth16(instance.vk[:groth16FixedKeyLength], instance.proof, instance.publicInputs)
+	assert.Equal(t, ErrInvalidVerifyingKey, err)
+
+	_, err = NumGroth16PublicInputs(instance.vk[1:])
+	assert.Equal(t, ErrInvalidVerifyingKey, err)
+}
+
+func BenchmarkBn254_VerifyGroth16(b *testing.B) {
+	benchmarkVerifyGroth16(b, nil)
+}
+
+func BenchmarkBn254_VerifyGroth16With8Inputs(b *testing.B) {
+	benchmarkVerifyGroth16(b, []int64{1, 2, 3, 4, 5, 6, 7, 8})
+}
+
+func benchmarkVerifyGroth16(b *testing.B, inputs []int64) {
+	bn := NewBN254()
+	instance := createGroth16TestInstance(inputs)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = bn.VerifyGroth16(instance.vk, instance.proof, instance.publicInputs)
+	}
+}
@@ -0,0 +1,68 @@
+package decorator
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+)
+
+var _ crypto.VMCrypto = (*CountingCrypto)(nil)
+
+// CountingCrypto counts the calls of every VMCrypto method, whatever their outcome
+type CountingCrypto struct {
+	*interceptedCrypto
+	mutex sync.RWMutex
+	calls map[string]int
+}
+
+// NewCountingCrypto wraps the given VMCrypto into a CountingCrypto
+func NewCountingCrypto(wrapped crypto.VMCrypto) (*CountingCrypto, error) {
+	counting := &CountingCrypto{
+		calls: make(map[string]int),
+	}
+
+	intercepted, err := newInterceptedCrypto(wrapped, counting.count)
+	if err != nil {
+		return nil, err
+	}
+	counting.interceptedCrypto = intercepted
+
+	return counting, nil
+}
+
+func (cc *CountingCrypto) count(method string, call func() error) error {
+	cc.mutex.Lock()
+	cc.calls[method]++
+	cc.mutex.Unlock()
+
+	return call()
+}
+
+// NumCalls returns the number of calls of the given methods, added together
+func (cc *CountingCrypto) NumCalls(methods ...string) int {
+	cc.mutex.RLock()
+	defer cc.mutex.RUnlock()
+
+	numCalls := 0
+	for _, method := range methods {
+		numCalls += cc.calls[method]
+	}
+	return numCalls
+}
+
+// NumSignatureChecks returns the number of calls of the signature verification methods
+func (cc *CountingCrypto) NumSignatureChecks() int {
+	return cc.NumCalls(SignatureMethods...)
+}
+
+// Reset forgets all the calls counted so far
+func (cc *CountingCrypto) Reset() {
+	cc.mutex.Lock()
+	cc.calls = make(map[string]int)
+	cc.mutex.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cc *CountingCrypto) IsInterfaceNil() bool {
+	return cc == nil
+}
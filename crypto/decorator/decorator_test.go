@@ -0,0 +1,123 @@
+package decorator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecorator_NilVMCrypto(t *testing.T) {
+	t.Parallel()
+
+	counting, err := NewCountingCrypto(nil)
+	assert.Nil(t, counting)
+	assert.Equal(t, ErrNilVMCrypto, err)
+
+	recording, err := NewLatencyRecordingCrypto(nil)
+	assert.Nil(t, recording)
+	assert.Equal(t, ErrNilVMCrypto, err)
+
+	injecting, err := NewFaultInjectingCrypto(nil)
+	assert.Nil(t, injecting)
+	assert.Equal(t, ErrNilVMCrypto, err)
+
+	var nilCounting *CountingCrypto
+	recording, err = NewLatencyRecordingCrypto(nilCounting)
+	assert.Nil(t, recording)
+	assert.Equal(t, ErrNilVMCrypto, err)
+}
+
+func TestCountingCrypto_CountsCalls(t *testing.T) {
+	t.Parallel()
+
+	vmCrypto := factory.NewVMCrypto()
+	counting, err := NewCountingCrypto(vmCrypto)
+	require.Nil(t, err)
+
+	expected, _ := vmCrypto.Keccak256([]byte("data"))
+	result, err := counting.Keccak256([]byte("data"))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, result)
+
+	_ = counting.VerifyEd25519([]byte("key"), []byte("msg"), []byte("sig"))
+	_ = counting.VerifyBLS([]byte("key"), []byte("msg"), []byte("sig"))
+	_, _ = counting.Sha256(nil)
+
+	assert.Equal(t, 1, counting.NumCalls(Keccak256))
+	assert.Equal(t, 2, counting.NumCalls(Keccak256, Sha256))
+	assert.Equal(t, 2, counting.NumSignatureChecks())
+	assert.Equal(t, 0, counting.NumCalls(VerifyGroth16))
+
+	counting.Reset()
+	assert.Equal(t, 0, counting.NumCalls(Keccak256))
+}
+
+func TestLatencyRecordingCrypto_RecordsEveryCall(t *testing.T) {
+	t.Parallel()
+
+	recording, err := NewLatencyRecordingCrypto(factory.NewVMCrypto())
+	require.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = recording.Sha512([]byte("data"))
+		require.Nil(t, err)
+	}
+	_, _ = recording.Bn254PairingCheck(nil)
+
+	assert.Len(t, recording.Latencies(Sha512), 3)
+	assert.Len(t, recording.Latencies(Bn254PairingCheck), 1)
+	assert.Empty(t, recording.Latencies(Sha256))
+
+	total := recording.TotalLatency(Sha512, Bn254PairingCheck)
+	for _, latency := range append(recording.Latencies(Sha512), recording.Latencies(Bn254PairingCheck)...) {
+		total -= latency
+	}
+	assert.Zero(t, total)
+
+	recording.Reset()
+	assert.Empty(t, recording.Latencies(Sha512))
+}
+
+func TestFaultInjectingCrypto_FailsChosenMethods(t *testing.T) {
+	t.Parallel()
+
+	counting, err := NewCountingCrypto(factory.NewVMCrypto())
+	require.Nil(t, err)
+	injecting, err := NewFaultInjectingCrypto(counting)
+	require.Nil(t, err)
+
+	errCustom := errors.New("custom error")
+	injecting.FailMethod(Sha256, errCustom)
+	injecting.FailMethodAfter(Keccak256, 2, nil)
+	injecting.FailMethod(VerifyEd25519Batch, nil)
+
+	result, err := injecting.Sha256([]byte("data"))
+	assert.Nil(t, result)
+	assert.Equal(t, errCustom, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = injecting.Keccak256([]byte("data"))
+		assert.Nil(t, err)
+	}
+	_, err = injecting.Keccak256([]byte("data"))
+	assert.Equal(t, ErrInjectedFault, err)
+
+	index, err := injecting.VerifyEd25519Batch(nil, nil, nil)
+	assert.Equal(t, -1, index)
+	assert.Equal(t, ErrInjectedFault, err)
+
+	_, err = injecting.Sha512([]byte("data"))
+	assert.Nil(t, err)
+
+	// the failing calls do not reach the wrapped implementation
+	assert.Equal(t, 0, counting.NumCalls(Sha256, VerifyEd25519Batch))
+	assert.Equal(t, 2, counting.NumCalls(Keccak256))
+	assert.Equal(t, 1, counting.NumCalls(Sha512))
+
+	injecting.ClearFaults()
+	_, err = injecting.Sha256([]byte("data"))
+	assert.Nil(t, err)
+}
@@ -0,0 +1,9 @@
+package decorator
+
+import "errors"
+
+// ErrNilVMCrypto signals that a decorator was given no implementation to wrap
+var ErrNilVMCrypto = errors.New("nil VMCrypto")
+
+// ErrInjectedFault is the default error returned by the FaultInjectingCrypto
+var ErrInjectedFault = errors.New("injected crypto fault")
@@ -0,0 +1,85 @@
+package decorator
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+)
+
+var _ crypto.VMCrypto = (*FaultInjectingCrypto)(nil)
+
+type fault struct {
+	err                error
+	numSuccessfulCalls int
+}
+
+// FaultInjectingCrypto makes chosen VMCrypto methods fail deterministically,
+// without calling the wrapped implementation; the other methods are forwarded
+type FaultInjectingCrypto struct {
+	*interceptedCrypto
+	mutex  sync.Mutex
+	faults map[string]*fault
+}
+
+// NewFaultInjectingCrypto wraps the given VMCrypto into a FaultInjectingCrypto
+func NewFaultInjectingCrypto(wrapped crypto.VMCrypto) (*FaultInjectingCrypto, error) {
+	injecting := &FaultInjectingCrypto{
+		faults: make(map[string]*fault),
+	}
+
+	intercepted, err := newInterceptedCrypto(wrapped, injecting.inject)
+	if err != nil {
+		return nil, err
+	}
+	injecting.interceptedCrypto = intercepted
+
+	return injecting, nil
+}
+
+func (fic *FaultInjectingCrypto) inject(method string, call func() error) error {
+	fic.mutex.Lock()
+	methodFault, found := fic.faults[method]
+	if found && methodFault.numSuccessfulCalls > 0 {
+		methodFault.numSuccessfulCalls--
+		found = false
+	}
+	fic.mutex.Unlock()
+
+	if found {
+		return methodFault.err
+	}
+	return call()
+}
+
+// FailMethod makes every following call of the method return the given error,
+// or ErrInjectedFault if the error is nil
+func (fic *FaultInjectingCrypto) FailMethod(method string, err error) {
+	fic.FailMethodAfter(method, 0, err)
+}
+
+// FailMethodAfter lets the given number of calls of the method through, then
+// makes every following call return the given error, or ErrInjectedFault if nil
+func (fic *FaultInjectingCrypto) FailMethodAfter(method string, numSuccessfulCalls int, err error) {
+	if err == nil {
+		err = ErrInjectedFault
+	}
+
+	fic.mutex.Lock()
+	fic.faults[method] = &fault{
+		err:                err,
+		numSuccessfulCalls: numSuccessfulCalls,
+	}
+	fic.mutex.Unlock()
+}
+
+// ClearFaults makes all the methods behave like the wrapped implementation again
+func (fic *FaultInjectingCrypto) ClearFaults() {
+	fic.mutex.Lock()
+	fic.faults = make(map[string]*fault)
+	fic.mutex.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fic *FaultInjectingCrypto) IsInterfaceNil() bool {
+	return fic == nil
+}
@@ -0,0 +1,232 @@
+// Package decorator wraps a VMCrypto implementation to observe or alter its
+// behaviour in tests: counting the calls, recording their latency or injecting
+// deterministic failures. The decorators can be nested in any order.
+package decorator
+
+import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// The names of the VMCrypto methods, as seen by the decorators
+const (
+	Sha256                              = "Sha256"
+	Keccak256                           = "Keccak256"
+	Ripemd160                           = "Ripemd160"
+	Sha512                              = "Sha512"
+	Sha3256                             = "Sha3256"
+	Blake2b256                          = "Blake2b256"
+	Blake2b512                          = "Blake2b512"
+	Blake2s256                          = "Blake2s256"
+	VerifyBLS                           = "VerifyBLS"
+	VerifyAggregatedBLS                 = "VerifyAggregatedBLS"
+	VerifyAggregatedDistinctMessagesBLS = "VerifyAggregatedDistinctMessagesBLS"
+	VerifyEd25519                       = "VerifyEd25519"
+	VerifyEd25519Batch                  = "VerifyEd25519Batch"
+	VerifySecp256k1                     = "VerifySecp256k1"
+	EncodeSecp256k1DERSignature         = "EncodeSecp256k1DERSignature"
+	RecoverSecp256k1                    = "RecoverSecp256k1"
+	VerifySecp256r1                     = "VerifySecp256r1"
+	Bn254G1Add                          = "Bn254G1Add"
+	Bn254G1ScalarMul                    = "Bn254G1ScalarMul"
+	Bn254PairingCheck                   = "Bn254PairingCheck"
+	VerifyGroth16                       = "VerifyGroth16"
+)
+
+// SignatureMethods lists the methods which verify signatures
+var SignatureMethods = []string{
+	VerifyBLS,
+	VerifyAggregatedBLS,
+	VerifyAggregatedDistinctMessagesBLS,
+	VerifyEd25519,
+	VerifyEd25519Batch,
+	VerifySecp256k1,
+	VerifySecp256r1,
+}
+
+// interceptor runs around every call of the wrapped implementation. It may
+// skip the call and return an error instead, in which case the method returns
+// that error along with empty results.
+type interceptor func(method string, call func() error) error
+
+// interceptedCrypto forwards every VMCrypto method through an interceptor
+type interceptedCrypto struct {
+	wrapped   crypto.VMCrypto
+	intercept interceptor
+}
+
+func newInterceptedCrypto(wrapped crypto.VMCrypto, intercept interceptor) (*interceptedCrypto, error) {
+	if check.IfNil(wrapped) {
+		return nil, ErrNilVMCrypto
+	}
+
+	return &interceptedCrypto{
+		wrapped:   wrapped,
+		intercept: intercept,
+	}, nil
+}
+
+func (ic *interceptedCrypto) hash(method string, hashFunction func([]byte) ([]byte, error), data []byte) ([]byte, error) {
+	var result []byte
+	err := ic.intercept(method, func() error {
+		var err error
+		result, err = hashFunction(data)
+		return err
+	})
+	return result, err
+}
+
+// Sha256 intercepts the Sha256 method of the wrapped implementation
+func (ic *interceptedCrypto) Sha256(data []byte) ([]byte, error) {
+	return ic.hash(Sha256, ic.wrapped.Sha256, data)
+}
+
+// Keccak256 intercepts the Keccak256 method of the wrapped implementation
+func (ic *interceptedCrypto) Keccak256(data []byte) ([]byte, error) {
+	return ic.hash(Keccak256, ic.wrapped.Keccak256, data)
+}
+
+// Ripemd160 intercepts the Ripemd160 method of the wrapped implementation
+func (ic *interceptedCrypto) Ripemd160(data []byte) ([]byte, error) {
+	return ic.hash(Ripemd160, ic.wrapped.Ripemd160, data)
+}
+
+// Sha512 intercepts the Sha512 method of the wrapped implementation
+func (ic *interceptedCrypto) Sha512(data []byte) ([]byte, error) {
+	return ic.hash(Sha512, ic.wrapped.Sha512, data)
+}
+
+// Sha3256 intercepts the Sha3256 method of the wrapped implementation
+func (ic *interceptedCrypto) Sha3256(data []byte) ([]byte, error) {
+	return ic.hash(Sha3256, ic.wrapped.Sha3256, data)
+}
+
+// Blake2b256 intercepts the Blake2b256 method of the wrapped implementation
+func (ic *interceptedCrypto) Blake2b256(data []byte) ([]byte, error) {
+	return ic.hash(Blake2b256, ic.wrapped.Blake2b256, data)
+}
+
+// Blake2b512 intercepts the Blake2b512 method of the wrapped implementation
+func (ic *interceptedCrypto) Blake2b512(data []byte) ([]byte, error) {
+	return ic.hash(Blake2b512, ic.wrapped.Blake2b512, data)
+}
+
+// Blake2s256 intercepts the Blake2s256 method of the wrapped implementation
+func (ic *interceptedCrypto) Blake2s256(data []byte) ([]byte, error) {
+	return ic.hash(Blake2s256, ic.wrapped.Blake2s256, data)
+}
+
+// VerifyBLS intercepts the VerifyBLS method of the wrapped implementation
+func (ic *interceptedCrypto) VerifyBLS(key []byte, msg []byte, sig []byte) error {
+	return ic.intercept(VerifyBLS, func() error {
+		return ic.wrapped.VerifyBLS(key, msg, sig)
+	})
+}
+
+// VerifyAggregatedBLS intercepts the VerifyAggregatedBLS method of the wrapped implementation
+func (ic *interceptedCrypto) VerifyAggregatedBLS(keys [][]byte, msg []byte, sig []byte) error {
+	return ic.intercept(VerifyAggregatedBLS, func() error {
+		return ic.wrapped.VerifyAggregatedBLS(keys, msg, sig)
+	})
+}
+
+// VerifyAggregatedDistinctMessagesBLS intercepts the VerifyAggregatedDistinctMessagesBLS method of the wrapped implementation
+func (ic *interceptedCrypto) VerifyAggregatedDistinctMessagesBLS(keys [][]byte, msgs [][]byte, sig []byte) error {
+	return ic.intercept(VerifyAggregatedDistinctMessagesBLS, func() error {
+		return ic.wrapped.VerifyAggregatedDistinctMessagesBLS(keys, msgs, sig)
+	})
+}
+
+// VerifyEd25519 intercepts the VerifyEd25519 method of the wrapped implementation
+func (ic *interceptedCrypto) VerifyEd25519(key []byte, msg []byte, sig []byte) error {
+	return ic.intercept(VerifyEd25519, func() error {
+		return ic.wrapped.VerifyEd25519(key, msg, sig)
+	})
+}
+
+// VerifyEd25519Batch intercepts the VerifyEd25519Batch method of the wrapped implementation
+func (ic *interceptedCrypto) VerifyEd25519Batch(keys [][]byte, msgs [][]byte, sigs [][]byte) (int, error) {
+	index := -1
+	err := ic.intercept(VerifyEd25519Batch, func() error {
+		var err error
+		index, err = ic.wrapped.VerifyEd25519Batch(keys, msgs, sigs)
+		return err
+	})
+	return index, err
+}
+
+// VerifySecp256k1 intercepts the VerifySecp256k1 method of the wrapped implementation
+func (ic *interceptedCrypto) VerifySecp256k1(key []byte, msg []byte, sig []byte, hashType uint8) error {
+	return ic.intercept(VerifySecp256k1, func() error {
+		return ic.wrapped.VerifySecp256k1(key, msg, sig, hashType)
+	})
+}
+
+// EncodeSecp256k1DERSignature intercepts the EncodeSecp256k1DERSignature method of the
+// wrapped implementation; an injected error can only surface as an empty signature
+func (ic *interceptedCrypto) EncodeSecp256k1DERSignature(r, s []byte) []byte {
+	var result []byte
+	_ = ic.intercept(EncodeSecp256k1DERSignature, func() error {
+		result = ic.wrapped.EncodeSecp256k1DERSignature(r, s)
+		return nil
+	})
+	return result
+}
+
+// RecoverSecp256k1 intercepts the RecoverSecp256k1 method of the wrapped implementation
+func (ic *interceptedCrypto) RecoverSecp256k1(messageHash, r, s []byte, v uint8) ([]byte, error) {
+	var result []byte
+	err := ic.intercept(RecoverSecp256k1, func() error {
+		var err error
+		result, err = ic.wrapped.RecoverSecp256k1(messageHash, r, s, v)
+		return err
+	})
+	return result, err
+}
+
+// VerifySecp256r1 intercepts the VerifySecp256r1 method of the wrapped implementation
+func (ic *interceptedCrypto) VerifySecp256r1(key []byte, msg []byte, sig []byte) error {
+	return ic.intercept(VerifySecp256r1, func() error {
+		return ic.wrapped.VerifySecp256r1(key, msg, sig)
+	})
+}
+
+// Bn254G1Add intercepts the Bn254G1Add method of the wrapped implementation
+func (ic *interceptedCrypto) Bn254G1Add(a []byte, b []byte) ([]byte, error) {
+	var result []byte
+	err := ic.intercept(Bn254G1Add, func() error {
+		var err error
+		result, err = ic.wrapped.Bn254G1Add(a, b)
+		return err
+	})
+	return result, err
+}
+
+// Bn254G1ScalarMul intercepts the Bn254G1ScalarMul method of the wrapped implementation
+func (ic *interceptedCrypto) Bn254G1ScalarMul(point []byte, scalar []byte) ([]byte, error) {
+	var result []byte
+	err := ic.intercept(Bn254G1ScalarMul, func() error {
+		var err error
+		result, err = ic.wrapped.Bn254G1ScalarMul(point, scalar)
+		return err
+	})
+	return result, err
+}
+
+// Bn254PairingCheck intercepts the Bn254PairingCheck method of the wrapped implementation
+func (ic *interceptedCrypto) Bn254PairingCheck(pairs []byte) (bool, error) {
+	var result bool
+	err := ic.intercept(Bn254PairingCheck, func() error {
+		var err error
+		result, err = ic.wrapped.Bn254PairingCheck(pairs)
+		return err
+	})
+	return result, err
+}
+
+// VerifyGroth16 intercepts the VerifyGroth16 method of the wrapped implementation
+func (ic *interceptedCrypto) VerifyGroth16(vk []byte, proof []byte, publicInputs []byte) error {
+	return ic.intercept(VerifyGroth16, func() error {
+		return ic.wrapped.VerifyGroth16(vk, proof, publicInputs)
+	})
+}
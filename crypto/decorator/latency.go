@@ -0,0 +1,80 @@
+package decorator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+)
+
+var _ crypto.VMCrypto = (*LatencyRecordingCrypto)(nil)
+
+// LatencyRecordingCrypto records how long every call of a VMCrypto method took
+type LatencyRecordingCrypto struct {
+	*interceptedCrypto
+	mutex     sync.RWMutex
+	latencies map[string][]time.Duration
+}
+
+// NewLatencyRecordingCrypto wraps the given VMCrypto into a LatencyRecordingCrypto
+func NewLatencyRecordingCrypto(wrapped crypto.VMCrypto) (*LatencyRecordingCrypto, error) {
+	recording := &LatencyRecordingCrypto{
+		latencies: make(map[string][]time.Duration),
+	}
+
+	intercepted, err := newInterceptedCrypto(wrapped, recording.record)
+	if err != nil {
+		return nil, err
+	}
+	recording.interceptedCrypto = intercepted
+
+	return recording, nil
+}
+
+func (lrc *LatencyRecordingCrypto) record(method string, call func() error) error {
+	start := time.Now()
+	err := call()
+	latency := time.Since(start)
+
+	lrc.mutex.Lock()
+	lrc.latencies[method] = append(lrc.latencies[method], latency)
+	lrc.mutex.Unlock()
+
+	return err
+}
+
+// Latencies returns the latency of every call of the given method, in the order of the calls
+func (lrc *LatencyRecordingCrypto) Latencies(method string) []time.Duration {
+	lrc.mutex.RLock()
+	defer lrc.mutex.RUnlock()
+
+	latencies := make([]time.Duration, len(lrc.latencies[method]))
+	copy(latencies, lrc.latencies[method])
+	return latencies
+}
+
+// TotalLatency returns the time spent in the given methods, added together
+func (lrc *LatencyRecordingCrypto) TotalLatency(methods ...string) time.Duration {
+	lrc.mutex.RLock()
+	defer lrc.mutex.RUnlock()
+
+	total := time.Duration(0)
+	for _, method := range methods {
+		for _, latency := range lrc.latencies[method] {
+			total += latency
+		}
+	}
+	return total
+}
+
+// Reset forgets all the latencies recorded so far
+func (lrc *LatencyRecordingCrypto) Reset() {
+	lrc.mutex.Lock()
+	lrc.latencies = make(map[string][]time.Duration)
+	lrc.mutex.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lrc *LatencyRecordingCrypto) IsInterfaceNil() bool {
+	return lrc == nil
+}
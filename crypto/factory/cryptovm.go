@@ -2,23 +2,36 @@ package factory
 
 import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/bn254"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/hashing"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/bls"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/ed25519"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/secp256k1"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing/secp256r1"
 )
 
+type vmCrypto struct {
+	crypto.Hasher
+	crypto.Ed25519
+	crypto.BLS
+	crypto.Secp256k1
+	crypto.Secp256r1
+	crypto.BN254
+}
+
 // NewVMCrypto returns a composite struct containing VMCrypto functionality implementations
 func NewVMCrypto() crypto.VMCrypto {
-	return struct {
-		crypto.Hasher
-		crypto.Ed25519
-		crypto.BLS
-		crypto.Secp256k1
-	}{
+	return &vmCrypto{
 		Hasher:    hashing.NewHasher(),
 		Ed25519:   ed25519.NewEd25519Signer(),
 		BLS:       bls.NewBLS(),
 		Secp256k1: secp256k1.NewSecp256k1(),
+		Secp256r1: secp256r1.NewSecp256r1(),
+		BN254:     bn254.NewBN254(),
 	}
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vc *vmCrypto) IsInterfaceNil() bool {
+	return vc == nil
+}
@@ -2,7 +2,10 @@ package hashing
 
 import (
 	"crypto/sha256"
+	"crypto/sha512"
 
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
 	"golang.org/x/crypto/ripemd160"
 	"golang.org/x/crypto/sha3"
 )
@@ -50,3 +53,75 @@ func (h *hasher) Ripemd160(data []byte) ([]byte, error) {
 	result := hash.Sum(nil)
 	return result, nil
 }
+
+// Sha512 returns a sha 512 hash of the input string
+func (h *hasher) Sha512(data []byte) ([]byte, error) {
+	hash := sha512.New()
+	_, err := hash.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := hash.Sum(nil)
+	return result, nil
+}
+
+// Sha3256 returns a sha3 256 hash of the input string, as standardized in FIPS 202
+func (h *hasher) Sha3256(data []byte) ([]byte, error) {
+	hash := sha3.New256()
+	_, err := hash.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := hash.Sum(nil)
+	return result, nil
+}
+
+// Blake2b256 returns an unkeyed blake2b hash of the input string, with a 256 bit digest
+func (h *hasher) Blake2b256(data []byte) ([]byte, error) {
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = hash.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := hash.Sum(nil)
+	return result, nil
+}
+
+// Blake2b512 returns an unkeyed blake2b hash of the input string, with a 512 bit digest
+func (h *hasher) Blake2b512(data []byte) ([]byte, error) {
+	hash, err := blake2b.New512(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = hash.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := hash.Sum(nil)
+	return result, nil
+}
+
+// Blake2s256 returns an unkeyed blake2s hash of the input string, with a 256 bit digest
+func (h *hasher) Blake2s256(data []byte) ([]byte, error) {
+	hash, err := blake2s.New256(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = hash.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := hash.Sum(nil)
+	return result, nil
+}
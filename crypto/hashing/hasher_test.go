@@ -0,0 +1,95 @@
+package hashing
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type hashTestVector struct {
+	input    string
+	expected string
+}
+
+// the vectors come from the FIPS 180-4 and FIPS 202 examples and from the appendices of RFC 7693
+func testHashFunction(t *testing.T, hash func(data []byte) ([]byte, error), vectors []hashTestVector) {
+	for _, vector := range vectors {
+		result, err := hash([]byte(vector.input))
+		assert.Nil(t, err)
+		assert.Equal(t, vector.expected, hex.EncodeToString(result), "input %q", vector.input)
+	}
+}
+
+func TestHasher_Sha512(t *testing.T) {
+	testHashFunction(t, NewHasher().Sha512, []hashTestVector{
+		{
+			input:    "",
+			expected: "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e",
+		},
+		{
+			input:    "abc",
+			expected: "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+		},
+		{
+			input:    "abcdefghbcdefghicdefghijdefghijkefghijklfghijklmghijklmnhijklmnoijklmnopjklmnopqklmnopqrlmnopqrsmnopqrstnopqrstu",
+			expected: "8e959b75dae313da8cf4f72814fc143f8f7779c6eb9f7fa17299aeadb6889018501d289e4900f7e4331b99dec4b5433ac7d329eeb6dd26545e96e55b874be909",
+		},
+	})
+}
+
+func TestHasher_Sha3256(t *testing.T) {
+	testHashFunction(t, NewHasher().Sha3256, []hashTestVector{
+		{
+			input:    "",
+			expected: "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a",
+		},
+		{
+			input:    "abc",
+			expected: "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532",
+		},
+		{
+			input:    "abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq",
+			expected: "41c0dba2a9d6240849100376a8235e2c82e1b9998a999e21db32dd97496d3376",
+		},
+	})
+}
+
+func TestHasher_Blake2b256(t *testing.T) {
+	testHashFunction(t, NewHasher().Blake2b256, []hashTestVector{
+		{
+			input:    "",
+			expected: "0e5751c026e543b2e8ab2eb06099daa1d1e5df47778f7787faab45cdf12fe3a8",
+		},
+		{
+			input:    "abc",
+			expected: "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319",
+		},
+	})
+}
+
+func TestHasher_Blake2b512(t *testing.T) {
+	testHashFunction(t, NewHasher().Blake2b512, []hashTestVector{
+		{
+			input:    "",
+			expected: "786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce",
+		},
+		{
+			input:    "abc",
+			expected: "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923",
+		},
+	})
+}
+
+func TestHasher_Blake2s256(t *testing.T) {
+	testHashFunction(t, NewHasher().Blake2s256, []hashTestVector{
+		{
+			input:    "",
+			expected: "69217a3079908094e11121d042354a7c1f55b6482ca1a51e1b250dfd1ed0eef9",
+		},
+		{
+			input:    "abc",
+			expected: "508c5e8c327c14e2e1a72ba34eeb452f37458b209ed63a294d999b4c86675982",
+		},
+	})
+}
@@ -9,19 +9,50 @@ type Hasher interface {
 
 	// Ripemd160 cryptographic function
 	Ripemd160(data []byte) ([]byte, error)
+
+	// Sha512 cryptographic function
+	Sha512(data []byte) ([]byte, error)
+
+	// Sha3256 cryptographic function
+	Sha3256(data []byte) ([]byte, error)
+
+	// Blake2b256 cryptographic function
+	Blake2b256(data []byte) ([]byte, error)
+
+	// Blake2b512 cryptographic function
+	Blake2b512(data []byte) ([]byte, error)
+
+	// Blake2s256 cryptographic function
+	Blake2s256(data []byte) ([]byte, error)
 }
 
 type BLS interface {
 	VerifyBLS(key []byte, msg []byte, sig []byte) error
+	VerifyAggregatedBLS(keys [][]byte, msg []byte, sig []byte) error
+	VerifyAggregatedDistinctMessagesBLS(keys [][]byte, msgs [][]byte, sig []byte) error
 }
 
 type Ed25519 interface {
 	VerifyEd25519(key []byte, msg []byte, sig []byte) error
+	VerifyEd25519Batch(keys [][]byte, msgs [][]byte, sigs [][]byte) (int, error)
 }
 
 type Secp256k1 interface {
 	VerifySecp256k1(key []byte, msg []byte, sig []byte, hashType uint8) error
 	EncodeSecp256k1DERSignature(r, s []byte) []byte
+	RecoverSecp256k1(messageHash, r, s []byte, v uint8) ([]byte, error)
+}
+
+type Secp256r1 interface {
+	VerifySecp256r1(key []byte, msg []byte, sig []byte) error
+}
+
+// BN254 provides the alt_bn128 curve operations of the EIP-196 and EIP-197 precompiles
+type BN254 interface {
+	Bn254G1Add(a []byte, b []byte) ([]byte, error)
+	Bn254G1ScalarMul(point []byte, scalar []byte) ([]byte, error)
+	Bn254PairingCheck(pairs []byte) (bool, error)
+	VerifyGroth16(vk []byte, proof []byte, publicInputs []byte) error
 }
 
 // VMCrypto will provide the interface to the main crypto functionalities of the vm
@@ -30,4 +61,7 @@ type VMCrypto interface {
 	Ed25519
 	BLS
 	Secp256k1
+	Secp256r1
+	BN254
+	IsInterfaceNil() bool
 }
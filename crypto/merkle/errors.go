@@ -0,0 +1,18 @@
+package merkle
+
+import "errors"
+
+// ErrInvalidHashAlgorithm signals that the hash algorithm flags are not supported
+var ErrInvalidHashAlgorithm = errors.New("invalid merkle hash algorithm")
+
+// ErrInvalidNodeLength signals that a root, leaf or proof node is not a 32 bytes hash
+var ErrInvalidNodeLength = errors.New("invalid merkle node length")
+
+// ErrInvalidLeafIndex signals that the leaf index does not fit the proof or the tree
+var ErrInvalidLeafIndex = errors.New("invalid merkle leaf index")
+
+// ErrNoLeaves signals an attempt to build a tree without leaves
+var ErrNoLeaves = errors.New("merkle tree without leaves")
+
+// ErrProofVerificationFailed signals that a well formed proof does not lead to the root
+var ErrProofVerificationFailed = errors.New("merkle proof verification failed")
@@ -0,0 +1,99 @@
+// Package merkle verifies Merkle inclusion proofs, for both the positional
+// convention, where the leaf index selects the side of each sibling, and the
+// sorted pair convention of OpenZeppelin, where each pair is sorted before hashing.
+package merkle
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+)
+
+// The hash algorithm flags: one of Sha256 or Keccak256, optionally combined with SortedPairs
+const (
+	Sha256      = 0
+	Keccak256   = 1
+	SortedPairs = 2
+)
+
+// HashLength is the length of every node of the tree
+const HashLength = 32
+
+const hashFunctionMask = 1
+
+type hashFunction func(data []byte) ([]byte, error)
+
+// VerifyProof checks that the leaf is included in the tree with the given root.
+// The proof holds the siblings from the leaf up to the root. For the positional
+// convention, bit i of the big endian leaf index tells whether the node at
+// level i is a right child; for sorted pairs the index is ignored.
+func VerifyProof(hasher crypto.Hasher, hashAlgo int32, root []byte, leaf []byte, proof [][]byte, index []byte) error {
+	hash, err := selectHashFunction(hasher, hashAlgo)
+	if err != nil {
+		return err
+	}
+	if len(root) != HashLength || len(leaf) != HashLength {
+		return ErrInvalidNodeLength
+	}
+
+	sortedPairs := hashAlgo&SortedPairs != 0
+	leafIndex := big.NewInt(0).SetBytes(index)
+	if !sortedPairs && leafIndex.BitLen() > len(proof) {
+		return ErrInvalidLeafIndex
+	}
+
+	node := leaf
+	for level, sibling := range proof {
+		if len(sibling) != HashLength {
+			return ErrInvalidNodeLength
+		}
+
+		isRightChild := leafIndex.Bit(level) == 1
+		if sortedPairs {
+			isRightChild = bytes.Compare(node, sibling) > 0
+		}
+
+		if isRightChild {
+			node, err = hashPair(hash, sibling, node)
+		} else {
+			node, err = hashPair(hash, node, sibling)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if !bytes.Equal(node, root) {
+		return ErrProofVerificationFailed
+	}
+
+	return nil
+}
+
+// ValidateHashAlgorithm checks the hash algorithm flags without hashing anything
+func ValidateHashAlgorithm(hashAlgo int32) error {
+	if hashAlgo&^(hashFunctionMask|SortedPairs) != 0 {
+		return ErrInvalidHashAlgorithm
+	}
+	return nil
+}
+
+func selectHashFunction(hasher crypto.Hasher, hashAlgo int32) (hashFunction, error) {
+	err := ValidateHashAlgorithm(hashAlgo)
+	if err != nil {
+		return nil, err
+	}
+
+	if hashAlgo&hashFunctionMask == Keccak256 {
+		return hasher.Keccak256, nil
+	}
+	return hasher.Sha256, nil
+}
+
+func hashPair(hash hashFunction, left []byte, right []byte) ([]byte, error) {
+	pair := make([]byte, 0, 2*HashLength)
+	pair = append(pair, left...)
+	pair = append(pair, right...)
+	return hash(pair)
+}
@@ -0,0 +1,181 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/hashing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var hashAlgorithms = []int32{Sha256, Keccak256, Sha256 | SortedPairs, Keccak256 | SortedPairs}
+
+func createLeaves(t testing.TB, numLeaves int) [][]byte {
+	hasher := hashing.NewHasher()
+	leaves := make([][]byte, numLeaves)
+	for i := range leaves {
+		leaf, err := hasher.Keccak256([]byte(fmt.Sprintf("erd1 recipient %d", i)))
+		require.Nil(t, err)
+		leaves[i] = leaf
+	}
+	return leaves
+}
+
+func concat(parts ...[]byte) []byte {
+	result := make([]byte, 0)
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+	return result
+}
+
+func TestMerkle_TreeRoot(t *testing.T) {
+	t.Parallel()
+
+	hasher := hashing.NewHasher()
+	leaves := createLeaves(t, 3)
+
+	tree, err := NewTree(hasher, Sha256, leaves[:1])
+	require.Nil(t, err)
+	assert.Equal(t, leaves[0], tree.Root())
+
+	// the odd node is paired with itself
+	left, _ := hasher.Sha256(concat(leaves[0], leaves[1]))
+	right, _ := hasher.Sha256(concat(leaves[2], leaves[2]))
+	expected, _ := hasher.Sha256(concat(left, right))
+	tree, err = NewTree(hasher, Sha256, leaves)
+	require.Nil(t, err)
+	assert.Equal(t, expected, tree.Root())
+
+	sortedLeaves := [][]byte{leaves[0], leaves[1]}
+	if string(leaves[0]) > string(leaves[1]) {
+		sortedLeaves[0], sortedLeaves[1] = leaves[1], leaves[0]
+	}
+	expected, _ = hasher.Keccak256(concat(sortedLeaves...))
+	tree, err = NewTree(hasher, Keccak256|SortedPairs, [][]byte{leaves[1], leaves[0]})
+	require.Nil(t, err)
+	assert.Equal(t, expected, tree.Root())
+}
+
+func TestMerkle_VerifyProof(t *testing.T) {
+	t.Parallel()
+
+	hasher := hashing.NewHasher()
+	for _, hashAlgo := range hashAlgorithms {
+		for numLeaves := 1; numLeaves <= 9; numLeaves++ {
+			leaves := createLeaves(t, numLeaves)
+			tree, err := NewTree(hasher, hashAlgo, leaves)
+			require.Nil(t, err)
+
+			for i, leaf := range leaves {
+				proof, index, err := tree.Proof(i)
+				require.Nil(t, err)
+
+				err = VerifyProof(hasher, hashAlgo, tree.Root(), leaf, proof, index)
+				assert.Nil(t, err, "algorithm %d, leaf %d of %d", hashAlgo, i, numLeaves)
+			}
+		}
+	}
+}
+
+func TestMerkle_VerifyProofRejectsWrongInclusion(t *testing.T) {
+	t.Parallel()
+
+	hasher := hashing.NewHasher()
+	for _, hashAlgo := range hashAlgorithms {
+		leaves := createLeaves(t, 6)
+		tree, err := NewTree(hasher, hashAlgo, leaves)
+		require.Nil(t, err)
+		proof, index, err := tree.Proof(2)
+		require.Nil(t, err)
+
+		err = VerifyProof(hasher, hashAlgo, tree.Root(), leaves[3], proof, index)
+		assert.Equal(t, ErrProofVerificationFailed, err)
+
+		wrongSibling := append([][]byte{}, proof...)
+		wrongSibling[1] = leaves[0]
+		err = VerifyProof(hasher, hashAlgo, tree.Root(), leaves[2], wrongSibling, index)
+		assert.Equal(t, ErrProofVerificationFailed, err)
+
+		err = VerifyProof(hasher, hashAlgo^Keccak256, tree.Root(), leaves[2], proof, index)
+		assert.Equal(t, ErrProofVerificationFailed, err)
+
+		err = VerifyProof(hasher, hashAlgo, tree.Root(), leaves[2], proof[:len(proof)-1], []byte{})
+		assert.NotNil(t, err)
+	}
+}
+
+func TestMerkle_VerifyProofPositionalIndex(t *testing.T) {
+	t.Parallel()
+
+	hasher := hashing.NewHasher()
+	leaves := createLeaves(t, 8)
+	tree, err := NewTree(hasher, Sha256, leaves)
+	require.Nil(t, err)
+	proof, index, err := tree.Proof(5)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{5}, index)
+
+	// the index selects the side of each sibling
+	err = VerifyProof(hasher, Sha256, tree.Root(), leaves[5], proof, []byte{4})
+	assert.Equal(t, ErrProofVerificationFailed, err)
+
+	// leading zero bytes do not change the index
+	err = VerifyProof(hasher, Sha256, tree.Root(), leaves[5], proof, []byte{0, 0, 5})
+	assert.Nil(t, err)
+
+	err = VerifyProof(hasher, Sha256, tree.Root(), leaves[5], proof, []byte{13})
+	assert.Equal(t, ErrInvalidLeafIndex, err)
+
+	proof, index, err = tree.Proof(0)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{}, index)
+	assert.Nil(t, VerifyProof(hasher, Sha256, tree.Root(), leaves[0], proof, index))
+
+	// the sorted pair convention ignores the index
+	tree, err = NewTree(hasher, Sha256|SortedPairs, leaves)
+	require.Nil(t, err)
+	proof, _, err = tree.Proof(5)
+	require.Nil(t, err)
+	assert.Nil(t, VerifyProof(hasher, Sha256|SortedPairs, tree.Root(), leaves[5], proof, []byte{0xff}))
+}
+
+func TestMerkle_InvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	hasher := hashing.NewHasher()
+	leaves := createLeaves(t, 4)
+	tree, err := NewTree(hasher, Keccak256, leaves)
+	require.Nil(t, err)
+	proof, index, err := tree.Proof(1)
+	require.Nil(t, err)
+
+	err = VerifyProof(hasher, 4, tree.Root(), leaves[1], proof, index)
+	assert.Equal(t, ErrInvalidHashAlgorithm, err)
+
+	err = VerifyProof(hasher, -1, tree.Root(), leaves[1], proof, index)
+	assert.Equal(t, ErrInvalidHashAlgorithm, err)
+
+	err = VerifyProof(hasher, Keccak256, tree.Root()[1:], leaves[1], proof, index)
+	assert.Equal(t, ErrInvalidNodeLength, err)
+
+	err = VerifyProof(hasher, Keccak256, tree.Root(), leaves[1][1:], proof, index)
+	assert.Equal(t, ErrInvalidNodeLength, err)
+
+	shortSibling := [][]byte{proof[0], proof[1][1:]}
+	err = VerifyProof(hasher, Keccak256, tree.Root(), leaves[1], shortSibling, index)
+	assert.Equal(t, ErrInvalidNodeLength, err)
+
+	_, err = NewTree(hasher, Keccak256, nil)
+	assert.Equal(t, ErrNoLeaves, err)
+
+	_, err = NewTree(hasher, Keccak256, [][]byte{leaves[0][1:]})
+	assert.Equal(t, ErrInvalidNodeLength, err)
+
+	_, err = NewTree(hasher, 8, leaves)
+	assert.Equal(t, ErrInvalidHashAlgorithm, err)
+
+	_, _, err = tree.Proof(4)
+	assert.Equal(t, ErrInvalidLeafIndex, err)
+}
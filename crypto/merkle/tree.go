@@ -0,0 +1,95 @@
+package merkle
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
+)
+
+// Tree is a complete Merkle tree over a list of leaf hashes, meant to build the
+// roots and proofs used by tests and by the tools that prepare contract inputs.
+// When a level has an odd number of nodes, its last node is paired with itself,
+// so that every proof has one sibling per level and the leaf index is the path.
+type Tree struct {
+	levels      [][][]byte
+	sortedPairs bool
+}
+
+// NewTree builds the tree with the hash algorithm flags accepted by VerifyProof
+func NewTree(hasher crypto.Hasher, hashAlgo int32, leaves [][]byte) (*Tree, error) {
+	hash, err := selectHashFunction(hasher, hashAlgo)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, ErrNoLeaves
+	}
+	for _, leaf := range leaves {
+		if len(leaf) != HashLength {
+			return nil, ErrInvalidNodeLength
+		}
+	}
+
+	tree := &Tree{
+		levels:      [][][]byte{leaves},
+		sortedPairs: hashAlgo&SortedPairs != 0,
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		parents := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			left, right := level[i], nodeAt(level, i+1)
+			if tree.sortedPairs && bytes.Compare(left, right) > 0 {
+				left, right = right, left
+			}
+
+			parent, err := hashPair(hash, left, right)
+			if err != nil {
+				return nil, err
+			}
+			parents = append(parents, parent)
+		}
+
+		tree.levels = append(tree.levels, parents)
+		level = parents
+	}
+
+	return tree, nil
+}
+
+// Root returns the root of the tree
+func (tree *Tree) Root() []byte {
+	return tree.levels[len(tree.levels)-1][0]
+}
+
+// NumLeaves returns the number of leaves the tree was built from
+func (tree *Tree) NumLeaves() int {
+	return len(tree.levels[0])
+}
+
+// Proof returns the siblings of the leaf from the bottom up, together with the
+// big endian leaf index expected by VerifyProof for the positional convention
+func (tree *Tree) Proof(leafIndex int) ([][]byte, []byte, error) {
+	if leafIndex < 0 || leafIndex >= tree.NumLeaves() {
+		return nil, nil, ErrInvalidLeafIndex
+	}
+
+	proof := make([][]byte, 0, len(tree.levels)-1)
+	index := leafIndex
+	for _, level := range tree.levels[:len(tree.levels)-1] {
+		proof = append(proof, nodeAt(level, index^1))
+		index /= 2
+	}
+
+	return proof, big.NewInt(int64(leafIndex)).Bytes(), nil
+}
+
+// nodeAt pairs the last node of an odd level with itself
+func nodeAt(level [][]byte, index int) []byte {
+	if index >= len(level) {
+		return level[len(level)-1]
+	}
+	return level[index]
+}
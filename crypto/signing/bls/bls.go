@@ -1,10 +1,12 @@
 package bls
 
 import (
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go-crypto"
-	"github.com/ElrondNetwork/elrond-go-crypto/signing"
+	elrondSigning "github.com/ElrondNetwork/elrond-go-crypto/signing"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	herumiBLS "github.com/herumi/bls-go-binary/bls"
 )
 
 type bls struct {
@@ -15,7 +17,7 @@ type bls struct {
 func NewBLS() *bls {
 	b := &bls{}
 	suite := mcl.NewSuiteBLS12()
-	b.keyGenerator = signing.NewKeyGenerator(suite)
+	b.keyGenerator = elrondSigning.NewKeyGenerator(suite)
 	b.signer = singlesig.NewBlsSigner()
 
 	return b
@@ -29,3 +31,135 @@ func (b *bls) VerifyBLS(key []byte, msg []byte, sig []byte) error {
 
 	return b.signer.Verify(publicKey, msg, sig)
 }
+
+// VerifyAggregatedBLS checks an aggregated signature of all the keys over the same message.
+// The keys are not weighted against rogue key attacks, so they must have been registered with
+// a proof of possession. Duplicated keys are rejected.
+func (b *bls) VerifyAggregatedBLS(keys [][]byte, msg []byte, sig []byte) error {
+	if len(msg) == 0 {
+		return signing.ErrEmptyMessage
+	}
+
+	publicKeys, err := b.parsePublicKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	signature, err := parseSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	if !signature.FastAggregateVerify(publicKeys, msg) {
+		return signing.ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// VerifyAggregatedDistinctMessagesBLS checks an aggregated signature in which each key signed its
+// own message. The messages must be pairwise distinct and there must be exactly one for each key.
+func (b *bls) VerifyAggregatedDistinctMessagesBLS(keys [][]byte, msgs [][]byte, sig []byte) error {
+	if len(keys) != len(msgs) {
+		return signing.ErrKeysAndMessagesMismatch
+	}
+
+	seenMessages := make(map[string]struct{}, len(msgs))
+	for _, msg := range msgs {
+		if len(msg) == 0 {
+			return signing.ErrEmptyMessage
+		}
+		if _, seen := seenMessages[string(msg)]; seen {
+			return signing.ErrDuplicatedMessage
+		}
+		seenMessages[string(msg)] = struct{}{}
+	}
+
+	publicKeys, err := b.parsePublicKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	signature, err := parseSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	// e(sig, Q) == e(H(m1), pk1) * ... * e(H(mn), pkn), checked as a single product equal to one
+	generator := &herumiBLS.PublicKey{}
+	herumiBLS.BlsGetGeneratorOfPublicKey(generator)
+
+	g1Points := make([]herumiBLS.G1, 0, len(keys)+1)
+	g2Points := make([]herumiBLS.G2, 0, len(keys)+1)
+
+	negatedSignature := herumiBLS.G1{}
+	herumiBLS.G1Neg(&negatedSignature, herumiBLS.CastFromSign(signature))
+	g1Points = append(g1Points, negatedSignature)
+	g2Points = append(g2Points, *herumiBLS.CastFromPublicKey(generator))
+
+	for i, msg := range msgs {
+		g1Points = append(g1Points, *herumiBLS.CastFromSign(herumiBLS.HashAndMapToSignature(msg)))
+		g2Points = append(g2Points, *herumiBLS.CastFromPublicKey(&publicKeys[i]))
+	}
+
+	result := &herumiBLS.GT{}
+	herumiBLS.MillerLoopVec(result, g1Points, g2Points)
+	herumiBLS.FinalExp(result, result)
+	if !result.IsOne() {
+		return signing.ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (b *bls) parsePublicKeys(keys [][]byte) ([]herumiBLS.PublicKey, error) {
+	if len(keys) == 0 {
+		return nil, signing.ErrInvalidPublicKey
+	}
+
+	publicKeys := make([]herumiBLS.PublicKey, 0, len(keys))
+	seenKeys := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		publicKey, err := b.keyGenerator.PublicKeyFromByteArray(key)
+		if err != nil {
+			return nil, err
+		}
+
+		point, isPoint := publicKey.Point().(*mcl.PointG2)
+		if !isPoint || !singlesig.IsPubKeyPointValid(point) {
+			return nil, signing.ErrInvalidPublicKey
+		}
+
+		// compare the canonical encodings, so that the same point is never counted twice
+		canonicalKey, err := publicKey.ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+		if _, seen := seenKeys[string(canonicalKey)]; seen {
+			return nil, signing.ErrDuplicatedPublicKey
+		}
+		seenKeys[string(canonicalKey)] = struct{}{}
+
+		publicKeys = append(publicKeys, *herumiBLS.CastToPublicKey(point.G2))
+	}
+
+	return publicKeys, nil
+}
+
+func parseSignature(sig []byte) (*herumiBLS.Sign, error) {
+	if len(sig) == 0 {
+		return nil, signing.ErrInvalidSignature
+	}
+
+	signature := &herumiBLS.Sign{}
+	err := signature.Deserialize(sig)
+	if err != nil {
+		return nil, signing.ErrInvalidSignature
+	}
+
+	if !singlesig.IsSigValidPoint(signature) {
+		return nil, signing.ErrInvalidSignature
+	}
+
+	return signature, nil
+}
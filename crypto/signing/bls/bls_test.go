@@ -2,9 +2,16 @@ package bls
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto"
+	elrondSigning "github.com/ElrondNetwork/elrond-go-crypto/signing"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go-crypto/signing/mcl/singlesig"
+	herumiBLS "github.com/herumi/bls-go-binary/bls"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -33,3 +40,128 @@ func splitString(t testing.TB, str string) ([]byte, []byte, []byte) {
 
 	return pkBuff, msgBuff, sigBuff
 }
+
+func generateSigners(t testing.TB, numSigners int) ([][]byte, []crypto.PrivateKey) {
+	keyGenerator := elrondSigning.NewKeyGenerator(mcl.NewSuiteBLS12())
+	publicKeys := make([][]byte, 0, numSigners)
+	privateKeys := make([]crypto.PrivateKey, 0, numSigners)
+	for i := 0; i < numSigners; i++ {
+		privateKey, publicKey := keyGenerator.GeneratePair()
+		publicKeyBytes, err := publicKey.ToByteArray()
+		require.Nil(t, err)
+
+		publicKeys = append(publicKeys, publicKeyBytes)
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	return publicKeys, privateKeys
+}
+
+func aggregateSignatures(t testing.TB, privateKeys []crypto.PrivateKey, msgs [][]byte) []byte {
+	signer := singlesig.NewBlsSigner()
+	aggregated := &herumiBLS.Sign{}
+	for i, privateKey := range privateKeys {
+		sig, err := signer.Sign(privateKey, msgs[i])
+		require.Nil(t, err)
+
+		signature := &herumiBLS.Sign{}
+		require.Nil(t, signature.Deserialize(sig))
+		aggregated.Add(signature)
+	}
+
+	return aggregated.Serialize()
+}
+
+func repeatMessage(msg []byte, count int) [][]byte {
+	msgs := make([][]byte, count)
+	for i := range msgs {
+		msgs[i] = msg
+	}
+	return msgs
+}
+
+func distinctMessages(count int) [][]byte {
+	msgs := make([][]byte, count)
+	for i := range msgs {
+		msgs[i] = []byte(fmt.Sprintf("message %d", i))
+	}
+	return msgs
+}
+
+func TestBls_VerifyAggregatedBLS(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	keys, privateKeys := generateSigners(t, 4)
+	sig := aggregateSignatures(t, privateKeys, repeatMessage(msg, 4))
+
+	b := NewBLS()
+	assert.Nil(t, b.VerifyAggregatedBLS(keys, msg, sig))
+	assert.Equal(t, signing.ErrInvalidSignature, b.VerifyAggregatedBLS(keys, []byte("other message"), sig))
+	assert.Equal(t, signing.ErrInvalidSignature, b.VerifyAggregatedBLS(keys[:3], msg, sig))
+	assert.Equal(t, signing.ErrEmptyMessage, b.VerifyAggregatedBLS(keys, []byte{}, sig))
+	assert.Equal(t, signing.ErrInvalidPublicKey, b.VerifyAggregatedBLS([][]byte{}, msg, sig))
+	assert.Equal(t, signing.ErrInvalidSignature, b.VerifyAggregatedBLS(keys, msg, sig[1:]))
+}
+
+func TestBls_VerifyAggregatedBLS_SingleSigner(t *testing.T) {
+	t.Parallel()
+
+	pk, msg, sig := splitString(t, checkOK)
+
+	b := NewBLS()
+	assert.Nil(t, b.VerifyAggregatedBLS([][]byte{pk}, msg, sig))
+	assert.Nil(t, b.VerifyAggregatedDistinctMessagesBLS([][]byte{pk}, [][]byte{msg}, sig))
+}
+
+func TestBls_VerifyAggregatedBLS_DuplicatedKey(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	keys, privateKeys := generateSigners(t, 3)
+	// the first signer signs twice, so the aggregate is valid for the duplicated key set
+	privateKeys = append(privateKeys, privateKeys[0])
+	keys = append(keys, keys[0])
+	sig := aggregateSignatures(t, privateKeys, repeatMessage(msg, 4))
+
+	b := NewBLS()
+	assert.Equal(t, signing.ErrDuplicatedPublicKey, b.VerifyAggregatedBLS(keys, msg, sig))
+}
+
+func TestBls_VerifyAggregatedDistinctMessagesBLS(t *testing.T) {
+	t.Parallel()
+
+	msgs := distinctMessages(4)
+	keys, privateKeys := generateSigners(t, 4)
+	sig := aggregateSignatures(t, privateKeys, msgs)
+
+	b := NewBLS()
+	assert.Nil(t, b.VerifyAggregatedDistinctMessagesBLS(keys, msgs, sig))
+
+	swappedKeys := [][]byte{keys[1], keys[0], keys[2], keys[3]}
+	assert.Equal(t, signing.ErrInvalidSignature, b.VerifyAggregatedDistinctMessagesBLS(swappedKeys, msgs, sig))
+
+	otherMsgs := distinctMessages(5)[1:]
+	assert.Equal(t, signing.ErrInvalidSignature, b.VerifyAggregatedDistinctMessagesBLS(keys, otherMsgs, sig))
+}
+
+func TestBls_VerifyAggregatedDistinctMessagesBLS_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	msgs := distinctMessages(3)
+	keys, privateKeys := generateSigners(t, 3)
+	sig := aggregateSignatures(t, privateKeys, msgs)
+
+	b := NewBLS()
+	assert.Equal(t, signing.ErrKeysAndMessagesMismatch, b.VerifyAggregatedDistinctMessagesBLS(keys[:2], msgs, sig))
+	assert.Equal(t, signing.ErrKeysAndMessagesMismatch, b.VerifyAggregatedDistinctMessagesBLS(keys, msgs[:2], sig))
+
+	duplicatedKeys := [][]byte{keys[0], keys[1], keys[0]}
+	assert.Equal(t, signing.ErrDuplicatedPublicKey, b.VerifyAggregatedDistinctMessagesBLS(duplicatedKeys, msgs, sig))
+
+	duplicatedMsgs := [][]byte{msgs[0], msgs[1], msgs[0]}
+	assert.Equal(t, signing.ErrDuplicatedMessage, b.VerifyAggregatedDistinctMessagesBLS(keys, duplicatedMsgs, sig))
+
+	emptyMsgs := [][]byte{msgs[0], msgs[1], {}}
+	assert.Equal(t, signing.ErrEmptyMessage, b.VerifyAggregatedDistinctMessagesBLS(keys, emptyMsgs, sig))
+}
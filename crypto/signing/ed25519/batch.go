@@ -0,0 +1,169 @@
+package ed25519
+
+import (
+	"bytes"
+	libed25519 "crypto/ed25519"
+	"crypto/sha512"
+	"encoding/binary"
+	"hash"
+
+	"filippo.io/edwards25519"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+)
+
+// batchCoefficientLength is the length in bytes of the random coefficients of the batch equation
+const batchCoefficientLength = 16
+
+const batchCoefficientsDomain = "arwen ed25519 batch coefficients"
+
+type batchEntry struct {
+	key *edwards25519.Point
+	r   *edwards25519.Point
+	s   *edwards25519.Scalar
+	k   *edwards25519.Scalar
+}
+
+// VerifyEd25519Batch verifies many signatures at once and returns the index of the
+// first invalid one, or -1 if all of them are valid.
+//
+// All the signatures are checked together with the randomized batch equation
+// [8](Σ zᵢRᵢ + Σ zᵢkᵢAᵢ - (Σ zᵢsᵢ)B) = 0, computed with a single multi-scalar
+// multiplication. Only when it fails are the signatures checked one by one, with
+// the cofactored equation [8](sB - R - kA) = 0, to find the invalid one. The
+// encodings are as strict as in VerifyEd25519 and keys of small order are
+// rejected, so the two only disagree on signatures whose R was crafted by the
+// signer with a small order component, which VerifyEd25519 rejects.
+//
+// The coefficients zᵢ are derived from a hash of the whole batch, so that the
+// result is deterministic while a forged batch passes with a probability of 2⁻¹²⁸.
+func (e *ed25519) VerifyEd25519Batch(keys [][]byte, msgs [][]byte, sigs [][]byte) (int, error) {
+	if len(keys) != len(msgs) {
+		return -1, signing.ErrKeysAndMessagesMismatch
+	}
+	if len(keys) != len(sigs) {
+		return -1, signing.ErrKeysAndSignaturesMismatch
+	}
+
+	entries := make([]*batchEntry, 0, len(keys))
+	firstMalformed := -1
+	for i := range keys {
+		entry, ok := decodeBatchEntry(keys[i], msgs[i], sigs[i])
+		if !ok {
+			firstMalformed = i
+			break
+		}
+		entries = append(entries, entry)
+	}
+
+	if verifyBatchEquation(entries, batchCoefficients(keys, msgs, sigs, len(entries))) {
+		return firstMalformed, nil
+	}
+
+	for i, entry := range entries {
+		if !verifyCofactored(entry) {
+			return i, nil
+		}
+	}
+
+	// unreachable: the batch equation holds whenever every cofactored equation holds
+	return firstMalformed, nil
+}
+
+// decodeBatchEntry decodes a signature with the same rules as crypto/ed25519: a non
+// canonical s is rejected and R must be the canonical encoding of a point. Keys of
+// small order are rejected as well, since the cofactored equation holds for them
+// with R the identity and s = 0, whatever the message
+func decodeBatchEntry(key []byte, msg []byte, sig []byte) (*batchEntry, bool) {
+	if len(key) != libed25519.PublicKeySize || len(sig) != libed25519.SignatureSize {
+		return nil, false
+	}
+
+	entry := &batchEntry{}
+	var err error
+	entry.key, err = new(edwards25519.Point).SetBytes(key)
+	if err != nil || isSmallOrder(entry.key) {
+		return nil, false
+	}
+	entry.r, err = new(edwards25519.Point).SetBytes(sig[:32])
+	if err != nil || !bytes.Equal(entry.r.Bytes(), sig[:32]) {
+		return nil, false
+	}
+	entry.s, err = edwards25519.NewScalar().SetCanonicalBytes(sig[32:])
+	if err != nil {
+		return nil, false
+	}
+
+	hasher := sha512.New()
+	_, _ = hasher.Write(sig[:32])
+	_, _ = hasher.Write(key)
+	_, _ = hasher.Write(msg)
+	entry.k, err = edwards25519.NewScalar().SetUniformBytes(hasher.Sum(nil))
+	if err != nil {
+		return nil, false
+	}
+
+	return entry, true
+}
+
+// batchCoefficients derives the first count coefficients from a hash of the batch
+func batchCoefficients(keys [][]byte, msgs [][]byte, sigs [][]byte, count int) []*edwards25519.Scalar {
+	hasher := sha512.New()
+	_, _ = hasher.Write([]byte(batchCoefficientsDomain))
+	for i := 0; i < count; i++ {
+		writeWithLength(hasher, keys[i])
+		writeWithLength(hasher, msgs[i])
+		writeWithLength(hasher, sigs[i])
+	}
+	seed := hasher.Sum(nil)
+
+	coefficients := make([]*edwards25519.Scalar, count)
+	for i := range coefficients {
+		hasher.Reset()
+		_, _ = hasher.Write(seed)
+		_ = binary.Write(hasher, binary.BigEndian, uint64(i))
+
+		coefficientBytes := make([]byte, 32)
+		copy(coefficientBytes, hasher.Sum(nil)[:batchCoefficientLength])
+		coefficients[i], _ = edwards25519.NewScalar().SetCanonicalBytes(coefficientBytes)
+	}
+	return coefficients
+}
+
+func writeWithLength(hasher hash.Hash, data []byte) {
+	_ = binary.Write(hasher, binary.BigEndian, uint64(len(data)))
+	_, _ = hasher.Write(data)
+}
+
+func verifyBatchEquation(entries []*batchEntry, coefficients []*edwards25519.Scalar) bool {
+	if len(entries) == 0 {
+		return true
+	}
+
+	scalars := make([]*edwards25519.Scalar, 0, 2*len(entries)+1)
+	points := make([]*edwards25519.Point, 0, 2*len(entries)+1)
+	sumS := edwards25519.NewScalar()
+	for i, entry := range entries {
+		z := coefficients[i]
+		sumS.MultiplyAdd(z, entry.s, sumS)
+
+		scalars = append(scalars, z, edwards25519.NewScalar().Multiply(z, entry.k))
+		points = append(points, entry.r, entry.key)
+	}
+	scalars = append(scalars, edwards25519.NewScalar().Negate(sumS))
+	points = append(points, edwards25519.NewGeneratorPoint())
+
+	result := new(edwards25519.Point).VarTimeMultiScalarMult(scalars, points)
+	return isSmallOrder(result)
+}
+
+func verifyCofactored(entry *batchEntry) bool {
+	minusKey := new(edwards25519.Point).Negate(entry.key)
+	result := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(entry.k, minusKey, entry.s)
+	result.Subtract(result, entry.r)
+	return isSmallOrder(result)
+}
+
+func isSmallOrder(point *edwards25519.Point) bool {
+	cleared := new(edwards25519.Point).MultByCofactor(point)
+	return cleared.Equal(edwards25519.NewIdentityPoint()) == 1
+}
@@ -0,0 +1,222 @@
+package ed25519
+
+import (
+	libed25519 "crypto/ed25519"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// smallOrderPoint is the encoding of a point of order 8
+const smallOrderPoint = "c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a"
+
+func decodeHex(t testing.TB, str string) []byte {
+	data, err := hex.DecodeString(str)
+	require.Nil(t, err)
+	return data
+}
+
+func generateBatch(t testing.TB, numSigs int) ([][]byte, [][]byte, [][]byte) {
+	keys := make([][]byte, numSigs)
+	msgs := make([][]byte, numSigs)
+	sigs := make([][]byte, numSigs)
+	for i := 0; i < numSigs; i++ {
+		seed := make([]byte, libed25519.SeedSize)
+		seed[0] = byte(i)
+		seed[1] = byte(i >> 8)
+		privateKey := libed25519.NewKeyFromSeed(seed)
+
+		keys[i] = privateKey.Public().(libed25519.PublicKey)
+		msgs[i] = []byte(fmt.Sprintf("price report %d", i))
+		sigs[i] = libed25519.Sign(privateKey, msgs[i])
+	}
+	return keys, msgs, sigs
+}
+
+func nonCanonicalIdentity() []byte {
+	encoding := make([]byte, 32)
+	for i := range encoding {
+		encoding[i] = 0xff
+	}
+	encoding[0] = 0xee
+	encoding[31] = 0x7f
+	return encoding
+}
+
+// firstInvalidBySingleVerify is the reference result of a batch, computed with the single verification
+func firstInvalidBySingleVerify(e *ed25519, keys [][]byte, msgs [][]byte, sigs [][]byte) int {
+	for i := range keys {
+		if e.VerifyEd25519(keys[i], msgs[i], sigs[i]) != nil {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestEd25519_VerifyEd25519Batch(t *testing.T) {
+	t.Parallel()
+
+	e := NewEd25519Signer()
+	for _, numSigs := range []int{0, 1, 2, 7, 32} {
+		keys, msgs, sigs := generateBatch(t, numSigs)
+		index, err := e.VerifyEd25519Batch(keys, msgs, sigs)
+		assert.Nil(t, err)
+		assert.Equal(t, -1, index, "batch of %d", numSigs)
+	}
+}
+
+func TestEd25519_VerifyEd25519BatchConsistentWithSingleVerify(t *testing.T) {
+	t.Parallel()
+
+	type corruption struct {
+		name    string
+		corrupt func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int)
+	}
+	corruptions := []corruption{
+		{"message", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			msgs[index] = append([]byte{}, msgs[index]...)
+			msgs[index][0] ^= 1
+		}},
+		{"R", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			sigs[index] = append([]byte{}, sigs[index]...)
+			sigs[index][0] ^= 1
+		}},
+		{"s", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			sigs[index] = append([]byte{}, sigs[index]...)
+			sigs[index][40] ^= 1
+		}},
+		{"s not reduced", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			sigs[index] = append([]byte{}, sigs[index]...)
+			sigs[index][63] |= 0x10
+		}},
+		{"R not canonical", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			// y = p + 1 is a second encoding of the identity
+			sigs[index] = append([]byte{}, sigs[index]...)
+			copy(sigs[index][:32], nonCanonicalIdentity())
+		}},
+		{"R with negative zero x", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			sigs[index] = append([]byte{}, sigs[index]...)
+			copy(sigs[index][:32], make([]byte, 32))
+			sigs[index][0] = 1
+			sigs[index][31] = 0x80
+		}},
+		{"key", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			keys[index] = keys[(index+1)%len(keys)]
+		}},
+		{"short signature", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			sigs[index] = sigs[index][:libed25519.SignatureSize-1]
+		}},
+		{"short key", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			keys[index] = keys[index][:libed25519.PublicKeySize-1]
+		}},
+		{"swapped signatures", func(keys [][]byte, msgs [][]byte, sigs [][]byte, index int) {
+			other := (index + 1) % len(sigs)
+			sigs[index], sigs[other] = sigs[other], sigs[index]
+		}},
+	}
+
+	e := NewEd25519Signer()
+	numSigs := 8
+	for _, c := range corruptions {
+		for _, index := range []int{0, 3, numSigs - 1} {
+			keys, msgs, sigs := generateBatch(t, numSigs)
+			c.corrupt(keys, msgs, sigs, index)
+
+			expected := firstInvalidBySingleVerify(e, keys, msgs, sigs)
+			require.NotEqual(t, -1, expected, "%s at %d", c.name, index)
+
+			result, err := e.VerifyEd25519Batch(keys, msgs, sigs)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, result, "%s at %d", c.name, index)
+		}
+	}
+}
+
+func TestEd25519_VerifyEd25519BatchSmallOrderKey(t *testing.T) {
+	t.Parallel()
+
+	// with a key A of order 8, R the identity and s = 0, the cofactored
+	// equation 8sB = 8R + 8hA holds for every message, so such keys are rejected
+	forgedSig := make([]byte, libed25519.SignatureSize)
+	forgedSig[0] = 1
+
+	e := NewEd25519Signer()
+	for i := 0; i < 16; i++ {
+		keys, msgs, sigs := generateBatch(t, 4)
+		keys[2] = decodeHex(t, smallOrderPoint)
+		msgs[2] = []byte(fmt.Sprintf("forged report %d", i))
+		sigs[2] = forgedSig
+
+		result, err := e.VerifyEd25519Batch(keys, msgs, sigs)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, result, "message %d", i)
+	}
+}
+
+func TestEd25519_VerifyEd25519BatchSmallOrderComponentInR(t *testing.T) {
+	t.Parallel()
+
+	// a signer can add a point T of order 8 to its R: sB = R + T + hA does not
+	// hold, while its cofactored form does, so only the batch accepts it
+	seed := make([]byte, libed25519.SeedSize)
+	digest := sha512.Sum512(seed)
+	privateScalar, err := edwards25519.NewScalar().SetBytesWithClamping(digest[:32])
+	require.Nil(t, err)
+	key := new(edwards25519.Point).ScalarBaseMult(privateScalar).Bytes()
+
+	nonceBytes := sha512.Sum512([]byte("nonce"))
+	nonce, err := edwards25519.NewScalar().SetUniformBytes(nonceBytes[:])
+	require.Nil(t, err)
+	torsion, err := new(edwards25519.Point).SetBytes(decodeHex(t, smallOrderPoint))
+	require.Nil(t, err)
+	r := new(edwards25519.Point).ScalarBaseMult(nonce)
+	r.Add(r, torsion)
+
+	msg := []byte("price report")
+	hasher := sha512.New()
+	_, _ = hasher.Write(r.Bytes())
+	_, _ = hasher.Write(key)
+	_, _ = hasher.Write(msg)
+	k, err := edwards25519.NewScalar().SetUniformBytes(hasher.Sum(nil))
+	require.Nil(t, err)
+	s := edwards25519.NewScalar().MultiplyAdd(k, privateScalar, nonce)
+	sig := append(r.Bytes(), s.Bytes()...)
+
+	e := NewEd25519Signer()
+	assert.Equal(t, signing.ErrInvalidSignature, e.VerifyEd25519(key, msg, sig))
+
+	result, err := e.VerifyEd25519Batch([][]byte{key}, [][]byte{msg}, [][]byte{sig})
+	assert.Nil(t, err)
+	assert.Equal(t, -1, result)
+}
+
+func TestEd25519_VerifyEd25519BatchMismatchedLengths(t *testing.T) {
+	t.Parallel()
+
+	e := NewEd25519Signer()
+	keys, msgs, sigs := generateBatch(t, 3)
+
+	_, err := e.VerifyEd25519Batch(keys, msgs[:2], sigs)
+	assert.Equal(t, signing.ErrKeysAndMessagesMismatch, err)
+
+	_, err = e.VerifyEd25519Batch(keys, msgs, sigs[:2])
+	assert.Equal(t, signing.ErrKeysAndSignaturesMismatch, err)
+}
+
+func BenchmarkEd25519_VerifyEd25519Batch(b *testing.B) {
+	e := NewEd25519Signer()
+	for _, numSigs := range []int{1, 8, 64} {
+		keys, msgs, sigs := generateBatch(b, numSigs)
+		b.Run(fmt.Sprintf("batch-%d", numSigs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = e.VerifyEd25519Batch(keys, msgs, sigs)
+			}
+		})
+	}
+}
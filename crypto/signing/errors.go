@@ -12,3 +12,21 @@ var ErrInvalidSignature = errors.New("invalid signature")
 
 // ErrHasherNotSupported will be returned when a provided hasher type is not supported by the signature scheme
 var ErrHasherNotSupported = errors.New("hasher not supported")
+
+// ErrInvalidRecoveryID will be returned when the recovery id of a secp256k1 signature is not supported
+var ErrInvalidRecoveryID = errors.New("invalid recovery id")
+
+// ErrEmptyMessage will be returned when a signature scheme is asked to verify an empty message
+var ErrEmptyMessage = errors.New("empty message")
+
+// ErrDuplicatedPublicKey will be returned when the same public key takes part twice in an aggregated signature
+var ErrDuplicatedPublicKey = errors.New("duplicated public key")
+
+// ErrDuplicatedMessage will be returned when the same message is signed twice in an aggregated signature over distinct messages
+var ErrDuplicatedMessage = errors.New("duplicated message")
+
+// ErrKeysAndMessagesMismatch will be returned when the number of public keys differs from the number of messages
+var ErrKeysAndMessagesMismatch = errors.New("number of public keys and messages mismatch")
+
+// ErrKeysAndSignaturesMismatch will be returned when the number of public keys differs from the number of signatures
+var ErrKeysAndSignaturesMismatch = errors.New("number of public keys and signatures mismatch")
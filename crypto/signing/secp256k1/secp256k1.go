@@ -20,6 +20,10 @@ const (
 	ECDSARipemd160
 )
 
+const scalarLength = 32
+const compactSignatureLength = 1 + 2*scalarLength
+const compactRecoveryIDOffset = 27
+
 type secp256k1 struct {
 }
 
@@ -65,6 +69,42 @@ func (sec *secp256k1) EncodeSecp256k1DERSignature(r, s []byte) []byte {
 	return sig.Serialize()
 }
 
+// RecoverSecp256k1 recovers the public key which produced the signature (r, s) over the message hash,
+// in the same way as the ethereum ecrecover. The recovery id v is accepted either as 0/1 or as 27/28.
+// The public key is returned in the uncompressed format
+func (sec *secp256k1) RecoverSecp256k1(messageHash, r, s []byte, v uint8) ([]byte, error) {
+	if len(messageHash) != scalarLength || len(r) != scalarLength || len(s) != scalarLength {
+		return nil, signing.ErrInvalidSignature
+	}
+
+	recoveryID := v
+	if recoveryID >= compactRecoveryIDOffset {
+		recoveryID -= compactRecoveryIDOffset
+	}
+	if recoveryID > 1 {
+		return nil, signing.ErrInvalidRecoveryID
+	}
+
+	curveOrder := btcec.S256().N
+	rValue := big.NewInt(0).SetBytes(r)
+	sValue := big.NewInt(0).SetBytes(s)
+	if rValue.Sign() == 0 || rValue.Cmp(curveOrder) >= 0 || sValue.Sign() == 0 || sValue.Cmp(curveOrder) >= 0 {
+		return nil, signing.ErrInvalidSignature
+	}
+
+	compactSig := make([]byte, 0, compactSignatureLength)
+	compactSig = append(compactSig, compactRecoveryIDOffset+recoveryID)
+	compactSig = append(compactSig, r...)
+	compactSig = append(compactSig, s...)
+
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), compactSig, messageHash)
+	if err != nil {
+		return nil, signing.ErrInvalidSignature
+	}
+
+	return pubKey.SerializeUncompressed(), nil
+}
+
 func (sec *secp256k1) hashMessage(msg []byte, hashType uint8) ([]byte, error) {
 	hasher := hashing.NewHasher()
 
@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/hashing"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,6 +45,54 @@ func TestEthereumSig2(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+// vector of the ecrecover precompile tests in go-ethereum, recovering the address 0x7156526fbd7a3c72969b54f64e42c10fbb768c8a
+func TestRecoverSecp256k1(t *testing.T) {
+	hash, _ := hex.DecodeString("456e9aea5e197a1f1af7a3e85a3212fa4049a3ba34c2289b4c860fc0b0c64ef3")
+	r, _ := hex.DecodeString("9242685bf161793cc25603c231bc2f568eb630ea16aa137d2664ac8038825608")
+	s, _ := hex.DecodeString("4f8ae3bd7535248d0bd448298cc2e2071e56992d0774dc340c368ae950852ada")
+	expectedKey, _ := hex.DecodeString("04f57c1d4c961024e998eaec4b6bebec90e788ef5ade22e636ce76111b60db107d4c3404b9908a2f357c84ccb48cf412be41d09574a9291c9c7eb5173ccf2a339f")
+
+	verifier := NewSecp256k1()
+	key, err := verifier.RecoverSecp256k1(hash, r, s, 28)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedKey, key)
+
+	key, err = verifier.RecoverSecp256k1(hash, r, s, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedKey, key)
+
+	key, err = verifier.RecoverSecp256k1(hash, r, s, 27)
+	assert.Nil(t, err)
+	assert.NotEqual(t, expectedKey, key)
+
+	hasher := hashing.NewHasher()
+	keyHash, _ := hasher.Keccak256(key[1:])
+	assert.NotEqual(t, "7156526fbd7a3c72969b54f64e42c10fbb768c8a", hex.EncodeToString(keyHash[12:]))
+	keyHash, _ = hasher.Keccak256(expectedKey[1:])
+	assert.Equal(t, "7156526fbd7a3c72969b54f64e42c10fbb768c8a", hex.EncodeToString(keyHash[12:]))
+}
+
+func TestRecoverSecp256k1_InvalidInput(t *testing.T) {
+	hash, _ := hex.DecodeString("456e9aea5e197a1f1af7a3e85a3212fa4049a3ba34c2289b4c860fc0b0c64ef3")
+	r, _ := hex.DecodeString("9242685bf161793cc25603c231bc2f568eb630ea16aa137d2664ac8038825608")
+	s, _ := hex.DecodeString("4f8ae3bd7535248d0bd448298cc2e2071e56992d0774dc340c368ae950852ada")
+	zero := make([]byte, 32)
+	curveOrder, _ := hex.DecodeString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+
+	verifier := NewSecp256k1()
+	_, err := verifier.RecoverSecp256k1(hash, r, s, 29)
+	assert.Equal(t, signing.ErrInvalidRecoveryID, err)
+
+	_, err = verifier.RecoverSecp256k1(hash[1:], r, s, 28)
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+
+	_, err = verifier.RecoverSecp256k1(hash, zero, s, 28)
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+
+	_, err = verifier.RecoverSecp256k1(hash, r, curveOrder, 28)
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+}
+
 /*
 04a3fe01e1c6ab5306130d09c1a928bd1598ccce020503ade24d0a5bf7040d5f4cdec9fdcba6497f834641b7908ed04d0b7698bbce6100ff2bbf82e5c52d523b19
 776562656c69676874
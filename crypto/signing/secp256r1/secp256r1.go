@@ -0,0 +1,100 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"math/big"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+)
+
+const coordinateLength = 32
+const compressedPublicKeyLength = 1 + coordinateLength
+const uncompressedPublicKeyLength = 1 + 2*coordinateLength
+
+type ecdsaSignature struct {
+	R *big.Int
+	S *big.Int
+}
+
+type secp256r1 struct {
+}
+
+func NewSecp256r1() *secp256r1 {
+	return &secp256r1{}
+}
+
+// VerifySecp256r1 checks an ECDSA signature over the NIST P-256 curve, provided in the DER encoding format.
+// The message is hashed with SHA-256 before verification, as in the ES256 algorithm used by WebAuthn
+func (sec *secp256r1) VerifySecp256r1(key, msg, sig []byte) error {
+	pubKey, err := parsePublicKey(key)
+	if err != nil {
+		return err
+	}
+
+	signature := &ecdsaSignature{}
+	rest, err := asn1.Unmarshal(sig, signature)
+	if err != nil || len(rest) != 0 {
+		return signing.ErrInvalidSignature
+	}
+
+	messageHash := sha256.Sum256(msg)
+	verified := ecdsa.Verify(pubKey, messageHash[:], signature.R, signature.S)
+	if !verified {
+		return signing.ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// parsePublicKey accepts both the compressed and the uncompressed SEC 1 encodings of a point
+func parsePublicKey(key []byte) (*ecdsa.PublicKey, error) {
+	curve := elliptic.P256()
+
+	var x, y *big.Int
+	switch len(key) {
+	case uncompressedPublicKeyLength:
+		x, y = elliptic.Unmarshal(curve, key)
+	case compressedPublicKeyLength:
+		x, y = decompressPoint(curve, key)
+	}
+	if x == nil {
+		return nil, signing.ErrInvalidPublicKey
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// decompressPoint solves y^2 = x^3 - 3x + b for the y with the parity given by the key prefix
+func decompressPoint(curve elliptic.Curve, key []byte) (*big.Int, *big.Int) {
+	if key[0] != 2 && key[0] != 3 {
+		return nil, nil
+	}
+
+	params := curve.Params()
+	x := big.NewInt(0).SetBytes(key[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil
+	}
+
+	threeX := big.NewInt(0).Lsh(x, 1)
+	threeX.Add(threeX, x)
+
+	ySquared := big.NewInt(0).Mul(x, x)
+	ySquared.Mul(ySquared, x)
+	ySquared.Sub(ySquared, threeX)
+	ySquared.Add(ySquared, params.B)
+	ySquared.Mod(ySquared, params.P)
+
+	y := big.NewInt(0).ModSqrt(ySquared, params.P)
+	if y == nil {
+		return nil, nil
+	}
+	if y.Bit(0) != uint(key[0]&1) {
+		y.Sub(params.P, y)
+	}
+
+	return x, y
+}
@@ -0,0 +1,86 @@
+package secp256r1
+
+import (
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto/signing"
+	"github.com/stretchr/testify/assert"
+)
+
+// the key and the signatures are the P-256 / SHA-256 examples of RFC 6979, appendix A.2.5
+const rfc6979PublicKey = "04" +
+	"60fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb6" +
+	"7903fe1008b8bc99a41ae9e95628bc64f2f1b20c2d7e9f5177a3c294d4462299"
+
+func encodeDERSignature(r, s string) []byte {
+	rValue, _ := big.NewInt(0).SetString(r, 16)
+	sValue, _ := big.NewInt(0).SetString(s, 16)
+	sig, _ := asn1.Marshal(ecdsaSignature{R: rValue, S: sValue})
+	return sig
+}
+
+func TestVerifySecp256r1(t *testing.T) {
+	key, _ := hex.DecodeString(rfc6979PublicKey)
+	verifier := NewSecp256r1()
+
+	sig := encodeDERSignature(
+		"efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716",
+		"f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8",
+	)
+	err := verifier.VerifySecp256r1(key, []byte("sample"), sig)
+	assert.Nil(t, err)
+
+	sig = encodeDERSignature(
+		"f1abb023518351cd71d881567b1ea663ed3efcf6c5132b354f28d3b0b7d38367",
+		"019f4113742a2b14bd25926b49c649155f267e60d3814b4c0cc84250e46f0083",
+	)
+	err = verifier.VerifySecp256r1(key, []byte("test"), sig)
+	assert.Nil(t, err)
+
+	err = verifier.VerifySecp256r1(key, []byte("sample"), sig)
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+}
+
+func TestVerifySecp256r1_CompressedKey(t *testing.T) {
+	uncompressedKey, _ := hex.DecodeString(rfc6979PublicKey)
+	// the y coordinate of the key is odd
+	compressedKey := append([]byte{3}, uncompressedKey[1:33]...)
+	sig := encodeDERSignature(
+		"efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716",
+		"f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8",
+	)
+
+	verifier := NewSecp256r1()
+	err := verifier.VerifySecp256r1(compressedKey, []byte("sample"), sig)
+	assert.Nil(t, err)
+
+	compressedKey[0] = 2
+	err = verifier.VerifySecp256r1(compressedKey, []byte("sample"), sig)
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+}
+
+func TestVerifySecp256r1_InvalidInput(t *testing.T) {
+	key, _ := hex.DecodeString(rfc6979PublicKey)
+	sig := encodeDERSignature(
+		"efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716",
+		"f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8",
+	)
+	verifier := NewSecp256r1()
+
+	err := verifier.VerifySecp256r1(key[:64], []byte("sample"), sig)
+	assert.Equal(t, signing.ErrInvalidPublicKey, err)
+
+	keyNotOnCurve := append([]byte{}, key...)
+	keyNotOnCurve[64] ^= 1
+	err = verifier.VerifySecp256r1(keyNotOnCurve, []byte("sample"), sig)
+	assert.Equal(t, signing.ErrInvalidPublicKey, err)
+
+	err = verifier.VerifySecp256r1(key, []byte("sample"), sig[:len(sig)-1])
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+
+	err = verifier.VerifySecp256r1(key, []byte("sample"), append(sig, 0))
+	assert.Equal(t, signing.ErrInvalidSignature, err)
+}
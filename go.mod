@@ -3,16 +3,19 @@ module github.com/ElrondNetwork/arwen-wasm-vm/v1_4
 go 1.13
 
 require (
+	filippo.io/edwards25519 v1.1.0
 	github.com/ElrondNetwork/big-int-util v0.1.0
 	github.com/ElrondNetwork/elrond-go-core v1.1.13
 	github.com/ElrondNetwork/elrond-go-crypto v1.0.0
 	github.com/ElrondNetwork/elrond-go-logger v1.0.5
 	github.com/ElrondNetwork/elrond-vm-common v1.3.4
 	github.com/btcsuite/btcd v0.21.0-beta
+	github.com/consensys/gnark-crypto v0.9.1
 	github.com/gin-gonic/gin v1.7.6
+	github.com/herumi/bls-go-binary v1.0.0
 	github.com/mitchellh/mapstructure v1.4.1
 	github.com/pelletier/go-toml v1.9.3
-	github.com/stretchr/testify v1.7.0
+	github.com/stretchr/testify v1.8.0
 	github.com/urfave/cli v1.22.5
-	golang.org/x/crypto v0.0.0-20210711020723-a769d52b0f97
+	golang.org/x/crypto v0.0.0-20220722155217-630584e8d5aa
 )
@@ -128,6 +128,20 @@ func TestMandosCheckESDTErr1(t *testing.T) {
   for token: NFT-123456, nonce: 1: Bad attributes. Want: "str:other_attributes". Have: "str:serialized_attributes"`)
 }
 
+func TestMandosCheckAsyncCallsErr1(t *testing.T) {
+	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-async-calls.err1.json")
+	require.EqualError(t, err,
+		`Check state "check-1": async calls mismatch for account "address:the-address":
+  for tx str:tx-hash-1....................., context str:vacation: no async call matches expected call #0. Want: {destination: "sc:train", status: "AsyncCallResolved"}. Have: [{destination: "sc:train", status: "AsyncCallPending", data: "str:bookTrain", gasLimit: "4000000", gasLocked: "0", providedGas: "0", value: "0", successCallback: "", errorCallback: ""}, {destination: "sc:hotel", status: "AsyncCallPending", data: "str:bookHotel", gasLimit: "2000000", gasLocked: "0", providedGas: "0", value: "0", successCallback: "", errorCallback: ""}]`)
+}
+
+func TestMandosCheckAsyncCallsErr2(t *testing.T) {
+	err := runSingleTestReturnError("mandos-self-test/set-check", "set-check-async-calls.err2.json")
+	require.EqualError(t, err,
+		`Check state "check-1": async calls mismatch for account "address:the-address":
+  for tx str:tx-hash-1....................., context str:vacation: wrong number of async calls. Want: 1. Have: 2`)
+}
+
 func TestMandosEsdtZeroBalance(t *testing.T) {
 	err := runSingleTestReturnError("mandos-self-test", "esdt-zero-balance-check-err.scen.json")
 	require.EqualError(t, err,
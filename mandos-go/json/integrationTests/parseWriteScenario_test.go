@@ -29,3 +29,23 @@ func TestWriteScenario(t *testing.T) {
 
 	require.Equal(t, contents, []byte(serialized))
 }
+
+func TestWriteScenarioAsyncCalls(t *testing.T) {
+	contents, err := loadExampleFile("asyncCalls.scen.json")
+	require.Nil(t, err)
+
+	p := mjparse.NewParser(
+		fr.NewDefaultFileResolver().ReplacePath(
+			"smart-contract.wasm",
+			"exampleFile.txt"))
+
+	scenario, parseErr := p.ParseScenarioFile(contents)
+	require.Nil(t, parseErr)
+
+	serialized := mjwrite.ScenarioToJSONString(scenario)
+
+	// good for debugging:
+	_ = ioutil.WriteFile("serialized-async-calls.scen.json", []byte(serialized), 0644)
+
+	require.Equal(t, contents, []byte(serialized))
+}
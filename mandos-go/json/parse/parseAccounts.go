@@ -121,6 +121,11 @@ func (p *Parser) processAccount(acctRaw oj.OJsonObject) (*mj.Account, error) {
 			if err != nil {
 				return nil, fmt.Errorf("invalid asyncCallData string: %w", err)
 			}
+		case "asyncCalls":
+			acct.AsyncCalls, err = p.processAsyncCallsMap(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid account async calls: %w", err)
+			}
 		case "update":
 			acct.Update, err = p.parseBool(kvp.Value)
 			if err != nil {
@@ -26,6 +26,10 @@ func (p *Parser) processCheckAccount(acctRaw oj.OJsonObject) (*mj.CheckAccount,
 		Code:                  mj.JSONCheckBytesUnspecified(),
 		Owner:                 mj.JSONCheckBytesUnspecified(),
 		AsyncCallData:         mj.JSONCheckBytesUnspecified(),
+		ExplicitAsyncCalls:    false,
+		IgnoreAsyncCalls:      true,
+		MoreAsyncCallsAllowed: false,
+		CheckAsyncCalls:       nil,
 		IgnoreESDT:            false,
 		MoreESDTTokensAllowed: false,
 		CheckESDTData:         nil,
@@ -121,6 +125,19 @@ func (p *Parser) processCheckAccount(acctRaw oj.OJsonObject) (*mj.CheckAccount,
 			if err != nil {
 				return nil, fmt.Errorf("invalid asyncCallData: %w", err)
 			}
+		case "asyncCalls":
+			acct.ExplicitAsyncCalls = true
+			acct.IgnoreAsyncCalls = IsStar(kvp.Value)
+			if !acct.IgnoreAsyncCalls {
+				asyncCallsMap, asyncCallsOk := kvp.Value.(*oj.OJsonMap)
+				if !asyncCallsOk {
+					return nil, errors.New("invalid account async calls")
+				}
+				err = p.processCheckAsyncCallsMap(asyncCallsMap, &acct)
+				if err != nil {
+					return nil, fmt.Errorf("invalid account async calls: %w", err)
+				}
+			}
 
 		default:
 			return nil, fmt.Errorf("unknown account field: %s", kvp.Key)
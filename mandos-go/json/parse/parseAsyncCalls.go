@@ -0,0 +1,439 @@
+package mandosjsonparse
+
+import (
+	"errors"
+	"fmt"
+
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+// Map of async calls saved by an account, by transaction hash, e.g.:
+//
+//	{
+//		"str:txHash": {
+//			"callerAddr": "address:caller",
+//			"contexts": {
+//				"str:identifier": {
+//					"callback": "",
+//					"calls": [ ... ]
+//				}
+//			}
+//		}
+//	}
+func (p *Parser) processAsyncCallsMap(asyncCallsRaw oj.OJsonObject) ([]*mj.AsyncCallsInfo, error) {
+	asyncCallsMap, isMap := asyncCallsRaw.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("async calls object is not a map")
+	}
+
+	var result []*mj.AsyncCallsInfo
+	for _, txKvp := range asyncCallsMap.OrderedKV {
+		txHash, err := p.ExprInterpreter.InterpretString(txKvp.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid async calls tx hash: %w", err)
+		}
+
+		asyncCallsInfo, err := p.processAsyncCallsInfo(txKvp.Value)
+		if err != nil {
+			return nil, err
+		}
+		asyncCallsInfo.TxHash = mj.NewJSONBytesFromString(txHash, txKvp.Key)
+		result = append(result, asyncCallsInfo)
+	}
+
+	return result, nil
+}
+
+func (p *Parser) processAsyncCallsInfo(infoRaw oj.OJsonObject) (*mj.AsyncCallsInfo, error) {
+	infoMap, isMap := infoRaw.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("async calls info is not a map")
+	}
+
+	info := &mj.AsyncCallsInfo{
+		CallerAddr: mj.JSONBytesEmpty(),
+		ReturnData: mj.JSONBytesEmpty(),
+	}
+	var err error
+	for _, kvp := range infoMap.OrderedKV {
+		switch kvp.Key {
+		case "callerAddr":
+			info.CallerAddr, err = p.processStringAsByteArray(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async calls caller address: %w", err)
+			}
+		case "returnData":
+			info.ReturnData, err = p.processStringAsByteArray(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async calls return data: %w", err)
+			}
+		case "contexts":
+			contextsMap, isContextsMap := kvp.Value.(*oj.OJsonMap)
+			if !isContextsMap {
+				return nil, errors.New("async contexts object is not a map")
+			}
+			for _, contextKvp := range contextsMap.OrderedKV {
+				asyncContext, err := p.processAsyncContext(contextKvp)
+				if err != nil {
+					return nil, err
+				}
+				info.Contexts = append(info.Contexts, asyncContext)
+			}
+		default:
+			return nil, fmt.Errorf("unknown async calls field: %s", kvp.Key)
+		}
+	}
+
+	return info, nil
+}
+
+func (p *Parser) processAsyncContext(contextKvp *oj.OJsonKeyValuePair) (*mj.AsyncContext, error) {
+	identifier, err := p.ExprInterpreter.InterpretString(contextKvp.Key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid async context identifier: %w", err)
+	}
+
+	contextMap, isMap := contextKvp.Value.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("async context is not a map")
+	}
+
+	asyncContext := &mj.AsyncContext{
+		Identifier: mj.NewJSONBytesFromString(identifier, contextKvp.Key),
+		Callback:   mj.JSONBytesEmpty(),
+	}
+	for _, kvp := range contextMap.OrderedKV {
+		switch kvp.Key {
+		case "callback":
+			asyncContext.Callback, err = p.processStringAsByteArray(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async context callback: %w", err)
+			}
+		case "calls":
+			callsList, isList := kvp.Value.(*oj.OJsonList)
+			if !isList {
+				return nil, errors.New("async context calls object is not a list")
+			}
+			for _, callRaw := range callsList.AsList() {
+				asyncCall, err := p.processAsyncCall(callRaw)
+				if err != nil {
+					return nil, err
+				}
+				asyncContext.Calls = append(asyncContext.Calls, asyncCall)
+			}
+		default:
+			return nil, fmt.Errorf("unknown async context field: %s", kvp.Key)
+		}
+	}
+
+	return asyncContext, nil
+}
+
+func (p *Parser) processAsyncCall(callRaw oj.OJsonObject) (*mj.AsyncCall, error) {
+	callMap, isMap := callRaw.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("async call is not a map")
+	}
+
+	asyncCall := &mj.AsyncCall{
+		Destination:     mj.JSONBytesEmpty(),
+		Status:          mj.AsyncCallStatusPending,
+		Data:            mj.JSONBytesEmpty(),
+		GasLimit:        mj.JSONUint64Zero(),
+		GasLocked:       mj.JSONUint64Zero(),
+		ProvidedGas:     mj.JSONUint64Zero(),
+		Value:           mj.JSONBigIntZero(),
+		SuccessCallback: mj.JSONBytesEmpty(),
+		ErrorCallback:   mj.JSONBytesEmpty(),
+	}
+	var err error
+	for _, kvp := range callMap.OrderedKV {
+		switch kvp.Key {
+		case "destination":
+			asyncCall.Destination, err = p.processStringAsByteArray(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call destination: %w", err)
+			}
+		case "status":
+			asyncCall.Status, err = p.parseAsyncCallStatus(kvp.Value)
+			if err != nil {
+				return nil, err
+			}
+		case "data":
+			asyncCall.Data, err = p.processStringAsByteArray(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call data: %w", err)
+			}
+		case "gasLimit":
+			asyncCall.GasLimit, err = p.processUint64(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call gas limit: %w", err)
+			}
+		case "gasLocked":
+			asyncCall.GasLocked, err = p.processUint64(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call gas locked: %w", err)
+			}
+		case "providedGas":
+			asyncCall.ProvidedGas, err = p.processUint64(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call provided gas: %w", err)
+			}
+		case "value":
+			asyncCall.Value, err = p.processBigInt(kvp.Value, bigIntUnsignedBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call value: %w", err)
+			}
+		case "esdtValue":
+			asyncCall.ESDTValue, err = p.processTxESDT(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call ESDT transfers: %w", err)
+			}
+		case "successCallback":
+			asyncCall.SuccessCallback, err = p.processStringAsByteArray(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call success callback: %w", err)
+			}
+		case "errorCallback":
+			asyncCall.ErrorCallback, err = p.processStringAsByteArray(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call error callback: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("unknown async call field: %s", kvp.Key)
+		}
+	}
+
+	return asyncCall, nil
+}
+
+func (p *Parser) parseAsyncCallStatus(obj oj.OJsonObject) (string, error) {
+	status, err := p.parseString(obj)
+	if err != nil {
+		return "", fmt.Errorf("invalid async call status: %w", err)
+	}
+	if !mj.IsAsyncCallStatusName(status) {
+		return "", fmt.Errorf("unknown async call status: %s", status)
+	}
+
+	return status, nil
+}
+
+func (p *Parser) processCheckAsyncCallsMap(asyncCallsMap *oj.OJsonMap, acct *mj.CheckAccount) error {
+	for _, txKvp := range asyncCallsMap.OrderedKV {
+		if txKvp.Key == "+" {
+			acct.MoreAsyncCallsAllowed = true
+			continue
+		}
+
+		txHash, err := p.ExprInterpreter.InterpretString(txKvp.Key)
+		if err != nil {
+			return fmt.Errorf("invalid async calls tx hash: %w", err)
+		}
+
+		asyncCallsInfo, err := p.processCheckAsyncCallsInfo(txKvp.Value)
+		if err != nil {
+			return err
+		}
+		asyncCallsInfo.TxHash = mj.NewJSONBytesFromString(txHash, txKvp.Key)
+		acct.CheckAsyncCalls = append(acct.CheckAsyncCalls, asyncCallsInfo)
+	}
+
+	return nil
+}
+
+func (p *Parser) processCheckAsyncCallsInfo(infoRaw oj.OJsonObject) (*mj.CheckAsyncCallsInfo, error) {
+	infoMap, isMap := infoRaw.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("async calls info is not a map")
+	}
+
+	info := &mj.CheckAsyncCallsInfo{
+		CallerAddr: mj.JSONCheckBytesUnspecified(),
+		ReturnData: mj.JSONCheckBytesUnspecified(),
+	}
+	var err error
+	for _, kvp := range infoMap.OrderedKV {
+		switch kvp.Key {
+		case "callerAddr":
+			info.CallerAddr, err = p.parseCheckBytes(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async calls caller address: %w", err)
+			}
+		case "returnData":
+			info.ReturnData, err = p.parseCheckBytes(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async calls return data: %w", err)
+			}
+		case "contexts":
+			contextsMap, isContextsMap := kvp.Value.(*oj.OJsonMap)
+			if !isContextsMap {
+				return nil, errors.New("async contexts object is not a map")
+			}
+			for _, contextKvp := range contextsMap.OrderedKV {
+				if contextKvp.Key == "+" {
+					info.MoreContextsAllowed = true
+					continue
+				}
+
+				asyncContext, err := p.processCheckAsyncContext(contextKvp)
+				if err != nil {
+					return nil, err
+				}
+				info.Contexts = append(info.Contexts, asyncContext)
+			}
+		default:
+			return nil, fmt.Errorf("unknown async calls field: %s", kvp.Key)
+		}
+	}
+
+	return info, nil
+}
+
+func (p *Parser) processCheckAsyncContext(contextKvp *oj.OJsonKeyValuePair) (*mj.CheckAsyncContext, error) {
+	identifier, err := p.ExprInterpreter.InterpretString(contextKvp.Key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid async context identifier: %w", err)
+	}
+
+	contextMap, isMap := contextKvp.Value.(*oj.OJsonMap)
+	if !isMap {
+		return nil, errors.New("async context is not a map")
+	}
+
+	asyncContext := &mj.CheckAsyncContext{
+		Identifier:  mj.NewJSONBytesFromString(identifier, contextKvp.Key),
+		Callback:    mj.JSONCheckBytesUnspecified(),
+		IgnoreCalls: true,
+	}
+	for _, kvp := range contextMap.OrderedKV {
+		switch kvp.Key {
+		case "callback":
+			asyncContext.Callback, err = p.parseCheckBytes(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async context callback: %w", err)
+			}
+		case "calls":
+			err = p.processCheckAsyncCallList(kvp.Value, asyncContext)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown async context field: %s", kvp.Key)
+		}
+	}
+
+	return asyncContext, nil
+}
+
+func (p *Parser) processCheckAsyncCallList(callsRaw oj.OJsonObject, asyncContext *mj.CheckAsyncContext) error {
+	asyncContext.IgnoreCalls = IsStar(callsRaw)
+	if asyncContext.IgnoreCalls {
+		return nil
+	}
+
+	callsList, isList := callsRaw.(*oj.OJsonList)
+	if !isList {
+		return errors.New("async context calls object is not a list")
+	}
+	for _, callRaw := range callsList.AsList() {
+		switch callItem := callRaw.(type) {
+		case *oj.OJsonString:
+			if callItem.Value != "+" {
+				return errors.New("async call entry is an invalid string")
+			}
+			asyncContext.MoreCallsAllowed = true
+		case *oj.OJsonMap:
+			if asyncContext.MoreCallsAllowed {
+				return errors.New("async call entry found after \"+\"")
+			}
+
+			asyncCall, err := p.processCheckAsyncCall(callItem)
+			if err != nil {
+				return err
+			}
+			asyncContext.Calls = append(asyncContext.Calls, asyncCall)
+		default:
+			return errors.New("async call entry is not a map")
+		}
+	}
+
+	return nil
+}
+
+func (p *Parser) processCheckAsyncCall(callMap *oj.OJsonMap) (*mj.CheckAsyncCall, error) {
+	asyncCall := mj.NewCheckAsyncCall()
+	var err error
+	for _, kvp := range callMap.OrderedKV {
+		switch kvp.Key {
+		case "destination":
+			asyncCall.Destination, err = p.parseCheckBytes(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call destination: %w", err)
+			}
+		case "status":
+			asyncCall.Status, err = p.parseCheckAsyncCallStatus(kvp.Value)
+			if err != nil {
+				return nil, err
+			}
+		case "data":
+			asyncCall.Data, err = p.parseCheckBytes(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call data: %w", err)
+			}
+		case "gasLimit":
+			asyncCall.GasLimit, err = p.processCheckUint64(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call gas limit: %w", err)
+			}
+		case "gasLocked":
+			asyncCall.GasLocked, err = p.processCheckUint64(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call gas locked: %w", err)
+			}
+		case "providedGas":
+			asyncCall.ProvidedGas, err = p.processCheckUint64(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call provided gas: %w", err)
+			}
+		case "value":
+			asyncCall.Value, err = p.processCheckBigInt(kvp.Value, bigIntUnsignedBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call value: %w", err)
+			}
+		case "successCallback":
+			asyncCall.SuccessCallback, err = p.parseCheckBytes(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call success callback: %w", err)
+			}
+		case "errorCallback":
+			asyncCall.ErrorCallback, err = p.parseCheckBytes(kvp.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid async call error callback: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("unknown async call field: %s", kvp.Key)
+		}
+	}
+
+	return asyncCall, nil
+}
+
+// the status is checked by name, the interpreter is not involved
+func (p *Parser) parseCheckAsyncCallStatus(obj oj.OJsonObject) (mj.JSONCheckBytes, error) {
+	if IsStar(obj) {
+		return mj.JSONCheckBytesStar(), nil
+	}
+
+	status, err := p.parseAsyncCallStatus(obj)
+	if err != nil {
+		return mj.JSONCheckBytes{}, err
+	}
+
+	return mj.JSONCheckBytes{
+		Value:    []byte(status),
+		IsStar:   false,
+		Original: obj,
+	}, nil
+}
@@ -44,6 +44,9 @@ func AccountsToOJ(accounts []*mj.Account) oj.OJsonObject {
 		if len(account.AsyncCallData) > 0 {
 			acctOJ.Put("asyncCallData", stringToOJ(account.AsyncCallData))
 		}
+		if len(account.AsyncCalls) > 0 {
+			acctOJ.Put("asyncCalls", asyncCallsToOJ(account.AsyncCalls))
+		}
 
 		acctsOJ.Put(bytesFromStringToString(account.Address), acctOJ)
 	}
@@ -98,6 +101,14 @@ func checkAccountsToOJ(checkAccounts *mj.CheckAccounts) oj.OJsonObject {
 		if !checkAccount.AsyncCallData.IsUnspecified() {
 			acctOJ.Put("asyncCallData", checkBytesToOJ(checkAccount.AsyncCallData))
 		}
+		if checkAccount.ExplicitAsyncCalls {
+			if checkAccount.IgnoreAsyncCalls {
+				acctOJ.Put("asyncCalls", stringToOJ("*"))
+			} else {
+				acctOJ.Put("asyncCalls", checkAsyncCallsToOJ(
+					checkAccount.CheckAsyncCalls, checkAccount.MoreAsyncCallsAllowed))
+			}
+		}
 
 		acctsOJ.Put(bytesFromStringToString(checkAccount.Address), acctOJ)
 	}
@@ -0,0 +1,164 @@
+package mandosjsonwrite
+
+import (
+	mj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/model"
+	oj "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/orderedjson"
+)
+
+func asyncCallsToOJ(asyncCalls []*mj.AsyncCallsInfo) *oj.OJsonMap {
+	asyncCallsOJ := oj.NewMap()
+	for _, info := range asyncCalls {
+		infoOJ := oj.NewMap()
+		if len(info.CallerAddr.Original) > 0 {
+			infoOJ.Put("callerAddr", bytesFromStringToOJ(info.CallerAddr))
+		}
+		if len(info.ReturnData.Original) > 0 {
+			infoOJ.Put("returnData", bytesFromStringToOJ(info.ReturnData))
+		}
+
+		contextsOJ := oj.NewMap()
+		for _, asyncContext := range info.Contexts {
+			contextsOJ.Put(bytesFromStringToString(asyncContext.Identifier), asyncContextToOJ(asyncContext))
+		}
+		infoOJ.Put("contexts", contextsOJ)
+
+		asyncCallsOJ.Put(bytesFromStringToString(info.TxHash), infoOJ)
+	}
+
+	return asyncCallsOJ
+}
+
+func asyncContextToOJ(asyncContext *mj.AsyncContext) oj.OJsonObject {
+	contextOJ := oj.NewMap()
+	if len(asyncContext.Callback.Original) > 0 {
+		contextOJ.Put("callback", bytesFromStringToOJ(asyncContext.Callback))
+	}
+
+	var callsList []oj.OJsonObject
+	for _, asyncCall := range asyncContext.Calls {
+		callsList = append(callsList, asyncCallToOJ(asyncCall))
+	}
+	callsOJList := oj.OJsonList(callsList)
+	contextOJ.Put("calls", &callsOJList)
+
+	return contextOJ
+}
+
+func asyncCallToOJ(asyncCall *mj.AsyncCall) oj.OJsonObject {
+	callOJ := oj.NewMap()
+	if len(asyncCall.Destination.Original) > 0 {
+		callOJ.Put("destination", bytesFromStringToOJ(asyncCall.Destination))
+	}
+	callOJ.Put("status", stringToOJ(asyncCall.Status))
+	if len(asyncCall.Data.Original) > 0 {
+		callOJ.Put("data", bytesFromStringToOJ(asyncCall.Data))
+	}
+	if len(asyncCall.GasLimit.Original) > 0 {
+		callOJ.Put("gasLimit", uint64ToOJ(asyncCall.GasLimit))
+	}
+	if len(asyncCall.GasLocked.Original) > 0 {
+		callOJ.Put("gasLocked", uint64ToOJ(asyncCall.GasLocked))
+	}
+	if len(asyncCall.ProvidedGas.Original) > 0 {
+		callOJ.Put("providedGas", uint64ToOJ(asyncCall.ProvidedGas))
+	}
+	if len(asyncCall.Value.Original) > 0 {
+		callOJ.Put("value", bigIntToOJ(asyncCall.Value))
+	}
+	if len(asyncCall.ESDTValue) > 0 {
+		callOJ.Put("esdtValue", esdtTxDataToOJ(asyncCall.ESDTValue))
+	}
+	if len(asyncCall.SuccessCallback.Original) > 0 {
+		callOJ.Put("successCallback", bytesFromStringToOJ(asyncCall.SuccessCallback))
+	}
+	if len(asyncCall.ErrorCallback.Original) > 0 {
+		callOJ.Put("errorCallback", bytesFromStringToOJ(asyncCall.ErrorCallback))
+	}
+
+	return callOJ
+}
+
+func checkAsyncCallsToOJ(asyncCalls []*mj.CheckAsyncCallsInfo, moreAsyncCallsAllowed bool) *oj.OJsonMap {
+	asyncCallsOJ := oj.NewMap()
+	for _, info := range asyncCalls {
+		infoOJ := oj.NewMap()
+		if !info.CallerAddr.IsUnspecified() {
+			infoOJ.Put("callerAddr", checkBytesToOJ(info.CallerAddr))
+		}
+		if !info.ReturnData.IsUnspecified() {
+			infoOJ.Put("returnData", checkBytesToOJ(info.ReturnData))
+		}
+
+		contextsOJ := oj.NewMap()
+		for _, asyncContext := range info.Contexts {
+			contextsOJ.Put(bytesFromStringToString(asyncContext.Identifier), checkAsyncContextToOJ(asyncContext))
+		}
+		if info.MoreContextsAllowed {
+			contextsOJ.Put("+", stringToOJ(""))
+		}
+		infoOJ.Put("contexts", contextsOJ)
+
+		asyncCallsOJ.Put(bytesFromStringToString(info.TxHash), infoOJ)
+	}
+	if moreAsyncCallsAllowed {
+		asyncCallsOJ.Put("+", stringToOJ(""))
+	}
+
+	return asyncCallsOJ
+}
+
+func checkAsyncContextToOJ(asyncContext *mj.CheckAsyncContext) oj.OJsonObject {
+	contextOJ := oj.NewMap()
+	if !asyncContext.Callback.IsUnspecified() {
+		contextOJ.Put("callback", checkBytesToOJ(asyncContext.Callback))
+	}
+
+	if asyncContext.IgnoreCalls {
+		return contextOJ
+	}
+
+	var callsList []oj.OJsonObject
+	for _, asyncCall := range asyncContext.Calls {
+		callsList = append(callsList, checkAsyncCallToOJ(asyncCall))
+	}
+	if asyncContext.MoreCallsAllowed {
+		callsList = append(callsList, stringToOJ("+"))
+	}
+	callsOJList := oj.OJsonList(callsList)
+	contextOJ.Put("calls", &callsOJList)
+
+	return contextOJ
+}
+
+func checkAsyncCallToOJ(asyncCall *mj.CheckAsyncCall) oj.OJsonObject {
+	callOJ := oj.NewMap()
+	if !asyncCall.Destination.IsUnspecified() {
+		callOJ.Put("destination", checkBytesToOJ(asyncCall.Destination))
+	}
+	if !asyncCall.Status.IsUnspecified() {
+		callOJ.Put("status", checkBytesToOJ(asyncCall.Status))
+	}
+	if !asyncCall.Data.IsUnspecified() {
+		callOJ.Put("data", checkBytesToOJ(asyncCall.Data))
+	}
+	if !asyncCall.GasLimit.IsUnspecified() {
+		callOJ.Put("gasLimit", checkUint64ToOJ(asyncCall.GasLimit))
+	}
+	if !asyncCall.GasLocked.IsUnspecified() {
+		callOJ.Put("gasLocked", checkUint64ToOJ(asyncCall.GasLocked))
+	}
+	if !asyncCall.ProvidedGas.IsUnspecified() {
+		callOJ.Put("providedGas", checkUint64ToOJ(asyncCall.ProvidedGas))
+	}
+	if !asyncCall.Value.IsUnspecified() {
+		callOJ.Put("value", checkBigIntToOJ(asyncCall.Value))
+	}
+	if !asyncCall.SuccessCallback.IsUnspecified() {
+		callOJ.Put("successCallback", checkBytesToOJ(asyncCall.SuccessCallback))
+	}
+	if !asyncCall.ErrorCallback.IsUnspecified() {
+		callOJ.Put("errorCallback", checkBytesToOJ(asyncCall.ErrorCallback))
+	}
+
+	return callOJ
+}
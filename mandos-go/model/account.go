@@ -15,6 +15,7 @@ type Account struct {
 	Code            JSONBytesFromString
 	Owner           JSONBytesFromString
 	AsyncCallData   string
+	AsyncCalls      []*AsyncCallsInfo
 	ESDTData        []*ESDTData
 	Update          bool
 }
@@ -39,6 +40,10 @@ type CheckAccount struct {
 	Code                  JSONCheckBytes
 	Owner                 JSONCheckBytes
 	AsyncCallData         JSONCheckBytes
+	CheckAsyncCalls       []*CheckAsyncCallsInfo
+	ExplicitAsyncCalls    bool
+	IgnoreAsyncCalls      bool
+	MoreAsyncCallsAllowed bool
 	CheckESDTData         []*CheckESDTData
 	IgnoreESDT            bool
 	MoreESDTTokensAllowed bool
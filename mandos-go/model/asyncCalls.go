@@ -0,0 +1,95 @@
+package mandosjsonmodel
+
+// Names of the async call statuses, as they appear in mandos.
+const (
+	AsyncCallStatusPending  = "AsyncCallPending"
+	AsyncCallStatusResolved = "AsyncCallResolved"
+	AsyncCallStatusRejected = "AsyncCallRejected"
+)
+
+// IsAsyncCallStatusName returns true if the name is one of the async call statuses.
+func IsAsyncCallStatusName(name string) bool {
+	switch name {
+	case AsyncCallStatusPending, AsyncCallStatusResolved, AsyncCallStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// AsyncCall models an async call saved in an async context.
+type AsyncCall struct {
+	Destination     JSONBytesFromString
+	Status          string
+	Data            JSONBytesFromString
+	GasLimit        JSONUint64
+	GasLocked       JSONUint64
+	ProvidedGas     JSONUint64
+	Value           JSONBigInt
+	ESDTValue       []*ESDTTxData
+	SuccessCallback JSONBytesFromString
+	ErrorCallback   JSONBytesFromString
+}
+
+// AsyncContext models a group of async calls, identified by the contract.
+type AsyncContext struct {
+	Identifier JSONBytesFromString
+	Callback   JSONBytesFromString
+	Calls      []*AsyncCall
+}
+
+// AsyncCallsInfo models the async calls saved by an account during a transaction.
+type AsyncCallsInfo struct {
+	TxHash     JSONBytesFromString
+	CallerAddr JSONBytesFromString
+	ReturnData JSONBytesFromString
+	Contexts   []*AsyncContext
+}
+
+// CheckAsyncCall checks an async call saved in an async context.
+// Unspecified fields are not checked.
+type CheckAsyncCall struct {
+	Destination     JSONCheckBytes
+	Status          JSONCheckBytes
+	Data            JSONCheckBytes
+	GasLimit        JSONCheckUint64
+	GasLocked       JSONCheckUint64
+	ProvidedGas     JSONCheckUint64
+	Value           JSONCheckBigInt
+	SuccessCallback JSONCheckBytes
+	ErrorCallback   JSONCheckBytes
+}
+
+// NewCheckAsyncCall creates an async call check with all fields unspecified.
+func NewCheckAsyncCall() *CheckAsyncCall {
+	return &CheckAsyncCall{
+		Destination:     JSONCheckBytesUnspecified(),
+		Status:          JSONCheckBytesUnspecified(),
+		Data:            JSONCheckBytesUnspecified(),
+		GasLimit:        JSONCheckUint64Unspecified(),
+		GasLocked:       JSONCheckUint64Unspecified(),
+		ProvidedGas:     JSONCheckUint64Unspecified(),
+		Value:           JSONCheckBigIntUnspecified(),
+		SuccessCallback: JSONCheckBytesUnspecified(),
+		ErrorCallback:   JSONCheckBytesUnspecified(),
+	}
+}
+
+// CheckAsyncContext checks an async context and its calls.
+// The calls can be listed in any order; "+" at the end of the list allows more calls.
+type CheckAsyncContext struct {
+	Identifier       JSONBytesFromString
+	Callback         JSONCheckBytes
+	Calls            []*CheckAsyncCall
+	IgnoreCalls      bool
+	MoreCallsAllowed bool
+}
+
+// CheckAsyncCallsInfo checks the async calls saved by an account during a transaction.
+type CheckAsyncCallsInfo struct {
+	TxHash              JSONBytesFromString
+	CallerAddr          JSONCheckBytes
+	ReturnData          JSONCheckBytes
+	Contexts            []*CheckAsyncContext
+	MoreContextsAllowed bool
+}
@@ -0,0 +1,86 @@
+package storagemapper
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ABI is the subset of an elrond-wasm contract ABI used to type storage mappers
+type ABI struct {
+	Name      string         `json:"name"`
+	Endpoints []*ABIEndpoint `json:"endpoints"`
+}
+
+// ABIEndpoint is an endpoint of a contract ABI
+type ABIEndpoint struct {
+	Name    string      `json:"name"`
+	Inputs  []*ABIParam `json:"inputs"`
+	Outputs []*ABIParam `json:"outputs"`
+}
+
+// ABIParam is an input or an output of an ABI endpoint
+type ABIParam struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ParseABI parses the JSON ABI generated by elrond-wasm
+func ParseABI(data []byte) (*ABI, error) {
+	abi := &ABI{}
+	err := json.Unmarshal(data, abi)
+	if err != nil {
+		return nil, err
+	}
+	return abi, nil
+}
+
+// findStorageGetter returns the view of a storage mapper, which by
+// convention is named either after the mapper or "get" + the mapper name
+func (abi *ABI) findStorageGetter(mapperName string) *ABIEndpoint {
+	if abi == nil || len(mapperName) == 0 {
+		return nil
+	}
+
+	getterName := "get" + strings.ToUpper(mapperName[:1]) + mapperName[1:]
+	for _, endpoint := range abi.Endpoints {
+		if endpoint.Name == mapperName || endpoint.Name == getterName {
+			return endpoint
+		}
+	}
+	return nil
+}
+
+// annotate sets the key and value types of a mapper from its storage getter.
+// Getters of maps take the key as their single input.
+func (abi *ABI) annotate(mapper *Mapper) {
+	endpoint := abi.findStorageGetter(string(mapper.Name))
+	if endpoint == nil {
+		return
+	}
+
+	if len(endpoint.Outputs) == 1 {
+		mapper.ValueType = elementType(endpoint.Outputs[0].Type)
+	}
+	if mapper.Kind == Map && len(endpoint.Inputs) == 1 {
+		mapper.KeyType = elementType(endpoint.Inputs[0].Type)
+	}
+}
+
+var collectionTypePrefixes = []string{"variadic<", "multi<", "List<", "ManagedVec<", "MultiResultVec<", "VarArgs<"}
+
+// elementType strips the collection wrappers of an ABI type, since the
+// storage of vecs, sets and linked lists holds one element per key
+func elementType(abiType string) string {
+	for {
+		stripped := false
+		for _, prefix := range collectionTypePrefixes {
+			if strings.HasPrefix(abiType, prefix) && strings.HasSuffix(abiType, ">") {
+				abiType = abiType[len(prefix) : len(abiType)-1]
+				stripped = true
+			}
+		}
+		if !stripped {
+			return abiType
+		}
+	}
+}
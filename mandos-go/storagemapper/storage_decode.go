@@ -0,0 +1,336 @@
+package storagemapper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+)
+
+// MapperKind is the kind of elrond-wasm storage mapper a group of storage keys belongs to
+type MapperKind int
+
+const (
+	// SingleValue is a plain key holding one value, e.g. SingleValueMapper
+	SingleValue MapperKind = iota
+
+	// Vec is a VecMapper, with a length key and one key per item
+	Vec
+
+	// Set is a SetMapper or an UnorderedSetMapper
+	Set
+
+	// LinkedList is a LinkedListMapper (also known as QueueMapper)
+	LinkedList
+
+	// Map is a MapMapper, i.e. a SetMapper of keys plus one key per mapped value
+	Map
+)
+
+// String returns the name of the mapper kind
+func (kind MapperKind) String() string {
+	switch kind {
+	case Vec:
+		return "vec"
+	case Set:
+		return "set"
+	case LinkedList:
+		return "linkedList"
+	case Map:
+		return "map"
+	default:
+		return "single"
+	}
+}
+
+// Key suffixes used by the elrond-wasm storage mappers
+const (
+	LenSuffix       = ".len"
+	ItemSuffix      = ".item"
+	InfoSuffix      = ".info"
+	NodeLinksSuffix = ".node_links"
+	ValueSuffix     = ".value"
+	NodeIDSuffix    = ".node_id"
+	MappedSuffix    = ".mapped"
+	IndexSuffix     = ".index"
+)
+
+// suffixes followed by nothing, by a u32 index or by an encoded value
+var noArgumentSuffixes = []string{LenSuffix, InfoSuffix}
+var indexSuffixes = []string{ItemSuffix, NodeLinksSuffix, ValueSuffix}
+var valueSuffixes = []string{NodeIDSuffix, MappedSuffix, IndexSuffix}
+
+const u32Length = 4
+
+// StorageKey is a raw storage key, split into the name of its mapper, the
+// mapper suffix and what follows the suffix.
+type StorageKey struct {
+	Key      []byte
+	Name     []byte
+	Suffix   string
+	Argument []byte
+	Value    []byte
+}
+
+// Entry is a logical element of a mapper: an item of a vec, set or linked list, or a key-value pair of a map
+type Entry struct {
+	Key   []byte
+	Value []byte
+}
+
+// Mapper groups the raw storage keys belonging to one storage mapper
+type Mapper struct {
+	Name        []byte
+	Kind        MapperKind
+	Value       []byte
+	Entries     []*Entry
+	StorageKeys []*StorageKey
+	KeyType     string
+	ValueType   string
+}
+
+// Decode groups the given raw storage into mappers, sorted by name. Keys
+// which do not follow any mapper layout are returned as single values.
+// The ABI is optional and is only used to find the types of the values.
+func Decode(storage map[string][]byte, abi *ABI) []*Mapper {
+	groups := make(map[string][]*StorageKey)
+	for key, value := range storage {
+		storageKey := SplitKey([]byte(key))
+		storageKey.Value = value
+		groupName := string(storageKey.Name)
+		if len(storageKey.Suffix) == 0 {
+			groupName = key + "\x00single"
+		}
+		groups[groupName] = append(groups[groupName], storageKey)
+	}
+
+	mappers := make([]*Mapper, 0, len(groups))
+	for _, group := range groups {
+		mapper := newMapper(group)
+		abi.annotate(mapper)
+		mappers = append(mappers, mapper)
+	}
+
+	sort.Slice(mappers, func(i, j int) bool {
+		cmp := bytes.Compare(mappers[i].Name, mappers[j].Name)
+		if cmp != 0 {
+			return cmp < 0
+		}
+		return mappers[i].Kind < mappers[j].Kind
+	})
+
+	return mappers
+}
+
+// SplitKey finds the first mapper suffix of the key which is followed by
+// an argument of the expected shape.
+func SplitKey(key []byte) *StorageKey {
+	for i := 1; i < len(key); i++ {
+		if key[i] != '.' {
+			continue
+		}
+
+		rest := key[i:]
+		for _, suffix := range noArgumentSuffixes {
+			if string(rest) == suffix {
+				return &StorageKey{Key: key, Name: key[:i], Suffix: suffix}
+			}
+		}
+		for _, suffix := range indexSuffixes {
+			if bytes.HasPrefix(rest, []byte(suffix)) && len(rest) == len(suffix)+u32Length {
+				return &StorageKey{Key: key, Name: key[:i], Suffix: suffix, Argument: rest[len(suffix):]}
+			}
+		}
+		for _, suffix := range valueSuffixes {
+			if bytes.HasPrefix(rest, []byte(suffix)) && len(rest) > len(suffix) {
+				return &StorageKey{Key: key, Name: key[:i], Suffix: suffix, Argument: rest[len(suffix):]}
+			}
+		}
+	}
+
+	return &StorageKey{Key: key, Name: key}
+}
+
+func newMapper(group []*StorageKey) *Mapper {
+	bySuffix := make(map[string][]*StorageKey)
+	for _, storageKey := range group {
+		bySuffix[storageKey.Suffix] = append(bySuffix[storageKey.Suffix], storageKey)
+	}
+
+	mapper := &Mapper{
+		Name: group[0].Name,
+	}
+
+	switch {
+	case len(bySuffix[MappedSuffix]) > 0:
+		mapper.Kind = Map
+		mapper.decodeMap(bySuffix)
+	case len(bySuffix[NodeIDSuffix]) > 0:
+		mapper.Kind = Set
+		mapper.decodeLinkedList(bySuffix)
+	case len(bySuffix[IndexSuffix]) > 0:
+		mapper.Kind = Set
+		mapper.decodeVec(bySuffix)
+	case len(bySuffix[InfoSuffix]) > 0 || len(bySuffix[NodeLinksSuffix]) > 0 || len(bySuffix[ValueSuffix]) > 0:
+		mapper.Kind = LinkedList
+		mapper.decodeLinkedList(bySuffix)
+	case len(bySuffix[LenSuffix]) > 0 || len(bySuffix[ItemSuffix]) > 0:
+		mapper.Kind = Vec
+		mapper.decodeVec(bySuffix)
+	default:
+		mapper.Kind = SingleValue
+		mapper.Value = group[0].Value
+		mapper.StorageKeys = group
+	}
+
+	return mapper
+}
+
+func (mapper *Mapper) decodeVec(bySuffix map[string][]*StorageKey) {
+	items := sortedByIndex(bySuffix[ItemSuffix])
+
+	mapper.StorageKeys = append(mapper.StorageKeys, bySuffix[LenSuffix]...)
+	for _, item := range items {
+		mapper.Entries = append(mapper.Entries, &Entry{Key: item.Argument, Value: item.Value})
+		mapper.StorageKeys = append(mapper.StorageKeys, item)
+	}
+	mapper.StorageKeys = append(mapper.StorageKeys, sortedByArgument(bySuffix[IndexSuffix])...)
+}
+
+func (mapper *Mapper) decodeLinkedList(bySuffix map[string][]*StorageKey) {
+	values := make(map[uint32]*StorageKey)
+	for _, storageKey := range bySuffix[ValueSuffix] {
+		values[decodeU32(storageKey.Argument)] = storageKey
+	}
+	links := make(map[uint32]*StorageKey)
+	for _, storageKey := range bySuffix[NodeLinksSuffix] {
+		links[decodeU32(storageKey.Argument)] = storageKey
+	}
+
+	mapper.StorageKeys = append(mapper.StorageKeys, bySuffix[InfoSuffix]...)
+	for _, nodeID := range walkLinkedList(bySuffix[InfoSuffix], values, links) {
+		value, ok := values[nodeID]
+		if ok {
+			mapper.Entries = append(mapper.Entries, &Entry{Key: value.Argument, Value: value.Value})
+			mapper.StorageKeys = append(mapper.StorageKeys, value)
+		}
+		link, ok := links[nodeID]
+		if ok {
+			mapper.StorageKeys = append(mapper.StorageKeys, link)
+		}
+	}
+	mapper.StorageKeys = append(mapper.StorageKeys, sortedByArgument(bySuffix[NodeIDSuffix])...)
+}
+
+func (mapper *Mapper) decodeMap(bySuffix map[string][]*StorageKey) {
+	keySet := &Mapper{Name: mapper.Name}
+	keySet.decodeLinkedList(bySuffix)
+
+	mapped := make(map[string]*StorageKey)
+	for _, storageKey := range bySuffix[MappedSuffix] {
+		mapped[string(storageKey.Argument)] = storageKey
+	}
+
+	mapper.StorageKeys = keySet.StorageKeys
+	for _, keyEntry := range keySet.Entries {
+		value, ok := mapped[string(keyEntry.Value)]
+		if !ok {
+			continue
+		}
+		delete(mapped, string(keyEntry.Value))
+		mapper.Entries = append(mapper.Entries, &Entry{Key: keyEntry.Value, Value: value.Value})
+		mapper.StorageKeys = append(mapper.StorageKeys, value)
+	}
+
+	// mapped values whose keys are missing from the key set, e.g. partial storage
+	remaining := make([]*StorageKey, 0, len(mapped))
+	for _, storageKey := range mapped {
+		remaining = append(remaining, storageKey)
+	}
+	for _, storageKey := range sortedByArgument(remaining) {
+		mapper.Entries = append(mapper.Entries, &Entry{Key: storageKey.Argument, Value: storageKey.Value})
+		mapper.StorageKeys = append(mapper.StorageKeys, storageKey)
+	}
+}
+
+// walkLinkedList returns the node ids from the front of the list, following
+// the next links, followed by the unreachable nodes, in ascending order.
+func walkLinkedList(info []*StorageKey, values map[uint32]*StorageKey, links map[uint32]*StorageKey) []uint32 {
+	nodeIDs := make([]uint32, 0, len(values))
+	visited := make(map[uint32]bool)
+
+	if len(info) > 0 {
+		fields := decodeU32Fields(info[0].Value)
+		if len(fields) > 1 {
+			nodeID := fields[1]
+			for nodeID != 0 && !visited[nodeID] {
+				visited[nodeID] = true
+				nodeIDs = append(nodeIDs, nodeID)
+
+				link, ok := links[nodeID]
+				if !ok {
+					break
+				}
+				nodeLinks := decodeU32Fields(link.Value)
+				if len(nodeLinks) < 2 {
+					break
+				}
+				nodeID = nodeLinks[1]
+			}
+		}
+	}
+
+	unreachable := make([]uint32, 0)
+	for nodeID := range values {
+		if !visited[nodeID] {
+			visited[nodeID] = true
+			unreachable = append(unreachable, nodeID)
+		}
+	}
+	for nodeID := range links {
+		if !visited[nodeID] {
+			visited[nodeID] = true
+			unreachable = append(unreachable, nodeID)
+		}
+	}
+	sort.Slice(unreachable, func(i, j int) bool { return unreachable[i] < unreachable[j] })
+
+	return append(nodeIDs, unreachable...)
+}
+
+func sortedByIndex(storageKeys []*StorageKey) []*StorageKey {
+	sorted := append([]*StorageKey{}, storageKeys...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return decodeU32(sorted[i].Argument) < decodeU32(sorted[j].Argument)
+	})
+	return sorted
+}
+
+func sortedByArgument(storageKeys []*StorageKey) []*StorageKey {
+	sorted := append([]*StorageKey{}, storageKeys...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Argument, sorted[j].Argument) < 0
+	})
+	return sorted
+}
+
+// decodeU32 decodes a nested-encoded u32
+func decodeU32(data []byte) uint32 {
+	if len(data) != u32Length {
+		return 0
+	}
+	return binary.BigEndian.Uint32(data)
+}
+
+// decodeU32Fields decodes a struct made only of nested-encoded u32 fields,
+// such as the list info (len, front, back, new) or the node links (previous, next)
+func decodeU32Fields(data []byte) []uint32 {
+	if len(data)%u32Length != 0 {
+		return nil
+	}
+
+	fields := make([]uint32, 0, len(data)/u32Length)
+	for i := 0; i < len(data); i += u32Length {
+		fields = append(fields, binary.BigEndian.Uint32(data[i:i+u32Length]))
+	}
+	return fields
+}
@@ -0,0 +1,200 @@
+package storagemapper
+
+import (
+	"encoding/binary"
+	"testing"
+
+	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
+	"github.com/stretchr/testify/require"
+)
+
+func u32(values ...uint32) []byte {
+	data := make([]byte, len(values)*u32Length)
+	for i, value := range values {
+		binary.BigEndian.PutUint32(data[i*u32Length:], value)
+	}
+	return data
+}
+
+func key(parts ...[]byte) string {
+	var data []byte
+	for _, part := range parts {
+		data = append(data, part...)
+	}
+	return string(data)
+}
+
+func TestSplitKey(t *testing.T) {
+	storageKey := SplitKey([]byte(key([]byte("list.item"), u32(3))))
+	require.Equal(t, []byte("list"), storageKey.Name)
+	require.Equal(t, ItemSuffix, storageKey.Suffix)
+	require.Equal(t, u32(3), storageKey.Argument)
+
+	storageKey = SplitKey([]byte("list.len"))
+	require.Equal(t, []byte("list"), storageKey.Name)
+	require.Equal(t, LenSuffix, storageKey.Suffix)
+
+	// an index suffix which is not followed by a u32 is part of the name
+	storageKey = SplitKey([]byte("config.item"))
+	require.Equal(t, []byte("config.item"), storageKey.Name)
+	require.Equal(t, "", storageKey.Suffix)
+
+	storageKey = SplitKey([]byte("a.b.c"))
+	require.Equal(t, []byte("a.b.c"), storageKey.Name)
+	require.Equal(t, "", storageKey.Suffix)
+}
+
+func TestDecode_SingleValues(t *testing.T) {
+	mappers := Decode(map[string][]byte{
+		"owner":   []byte("alice"),
+		"counter": {5},
+	}, nil)
+
+	require.Len(t, mappers, 2)
+	require.Equal(t, []byte("counter"), mappers[0].Name)
+	require.Equal(t, SingleValue, mappers[0].Kind)
+	require.Equal(t, []byte{5}, mappers[0].Value)
+	require.Equal(t, []byte("owner"), mappers[1].Name)
+	require.Equal(t, []byte("alice"), mappers[1].Value)
+}
+
+func TestDecode_Vec(t *testing.T) {
+	mappers := Decode(map[string][]byte{
+		"numbers.len":                       u32(3),
+		key([]byte("numbers.item"), u32(2)): {20},
+		key([]byte("numbers.item"), u32(1)): {10},
+		key([]byte("numbers.item"), u32(3)): {30},
+	}, nil)
+
+	require.Len(t, mappers, 1)
+	mapper := mappers[0]
+	require.Equal(t, Vec, mapper.Kind)
+	require.Len(t, mapper.Entries, 3)
+	for i, entry := range mapper.Entries {
+		require.Equal(t, u32(uint32(i+1)), entry.Key)
+		require.Equal(t, []byte{byte(10 * (i + 1))}, entry.Value)
+	}
+	require.Len(t, mapper.StorageKeys, 4)
+	require.Equal(t, LenSuffix, mapper.StorageKeys[0].Suffix)
+}
+
+func TestDecode_UnorderedSet(t *testing.T) {
+	mappers := Decode(map[string][]byte{
+		"ids.len":                       u32(2),
+		key([]byte("ids.item"), u32(1)): []byte("a"),
+		key([]byte("ids.item"), u32(2)): []byte("b"),
+		"ids.indexa":                    u32(1),
+		"ids.indexb":                    u32(2),
+	}, nil)
+
+	require.Len(t, mappers, 1)
+	require.Equal(t, Set, mappers[0].Kind)
+	require.Len(t, mappers[0].Entries, 2)
+	require.Len(t, mappers[0].StorageKeys, 5)
+}
+
+func TestDecode_LinkedListFollowsLinks(t *testing.T) {
+	// list 7 -> 2 -> 5, with node ids deliberately not in order
+	mappers := Decode(map[string][]byte{
+		"queue.info":                            u32(3, 7, 5, 7),
+		key([]byte("queue.value"), u32(7)):      []byte("first"),
+		key([]byte("queue.value"), u32(2)):      []byte("second"),
+		key([]byte("queue.value"), u32(5)):      []byte("third"),
+		key([]byte("queue.node_links"), u32(7)): u32(0, 2),
+		key([]byte("queue.node_links"), u32(2)): u32(7, 5),
+		key([]byte("queue.node_links"), u32(5)): u32(2, 0),
+	}, nil)
+
+	require.Len(t, mappers, 1)
+	mapper := mappers[0]
+	require.Equal(t, LinkedList, mapper.Kind)
+	require.Len(t, mapper.Entries, 3)
+	require.Equal(t, []byte("first"), mapper.Entries[0].Value)
+	require.Equal(t, []byte("second"), mapper.Entries[1].Value)
+	require.Equal(t, []byte("third"), mapper.Entries[2].Value)
+	require.Len(t, mapper.StorageKeys, 7)
+}
+
+func TestDecode_SetAndMap(t *testing.T) {
+	storage := map[string][]byte{
+		"balance.info":                            u32(1, 1, 1, 1),
+		key([]byte("balance.value"), u32(1)):      []byte("alice"),
+		key([]byte("balance.node_links"), u32(1)): u32(0, 0),
+		"balance.node_idalice":                    u32(1),
+	}
+
+	mappers := Decode(storage, nil)
+	require.Len(t, mappers, 1)
+	require.Equal(t, Set, mappers[0].Kind)
+
+	storage["balance.mappedalice"] = []byte{100}
+	mappers = Decode(storage, nil)
+	require.Len(t, mappers, 1)
+	mapper := mappers[0]
+	require.Equal(t, Map, mapper.Kind)
+	require.Len(t, mapper.Entries, 1)
+	require.Equal(t, []byte("alice"), mapper.Entries[0].Key)
+	require.Equal(t, []byte{100}, mapper.Entries[0].Value)
+	require.Len(t, mapper.StorageKeys, 5)
+}
+
+func TestDecode_WithABI(t *testing.T) {
+	abi, err := ParseABI([]byte(`{
+		"name": "Test",
+		"endpoints": [
+			{"name": "getNumbers", "inputs": [], "outputs": [{"type": "variadic<u64>"}]},
+			{"name": "balance", "inputs": [{"name": "user", "type": "Address"}], "outputs": [{"type": "BigUint"}]}
+		]
+	}`))
+	require.Nil(t, err)
+
+	address := []byte("alice___________________________")
+	mappers := Decode(map[string][]byte{
+		"numbers.len":                             u32(1),
+		key([]byte("numbers.item"), u32(1)):       {0, 0, 0, 0, 0, 0, 0, 9},
+		"balance.info":                            u32(1, 1, 1, 1),
+		key([]byte("balance.value"), u32(1)):      address,
+		key([]byte("balance.node_links"), u32(1)): u32(0, 0),
+		key([]byte("balance.node_id"), address):   u32(1),
+		key([]byte("balance.mapped"), address):    {1, 0},
+	}, abi)
+
+	require.Len(t, mappers, 2)
+	balance := mappers[0]
+	require.Equal(t, "Address", balance.KeyType)
+	require.Equal(t, "BigUint", balance.ValueType)
+	numbers := mappers[1]
+	require.Equal(t, "", numbers.KeyType)
+	require.Equal(t, "u64", numbers.ValueType)
+
+	reconstructor := &er.ExprReconstructor{}
+	view := balance.View(reconstructor)
+	require.Equal(t, "str:balance", view.Name)
+	require.Equal(t, "map", view.Kind)
+	require.Len(t, view.Entries, 1)
+	require.Equal(t, "256", view.Entries[0].Value)
+
+	view = numbers.View(reconstructor)
+	require.Equal(t, "vec", view.Kind)
+	require.Len(t, view.Entries, 1)
+	require.Equal(t, "1", view.Entries[0].Key)
+	require.Equal(t, "9", view.Entries[0].Value)
+}
+
+func TestMapper_Expressions(t *testing.T) {
+	mappers := Decode(map[string][]byte{
+		"list.len":                       u32(1),
+		key([]byte("list.item"), u32(1)): []byte("hello"),
+	}, nil)
+	mapper := mappers[0]
+	reconstructor := &er.ExprReconstructor{}
+
+	require.Equal(t, "str:list.len", mapper.KeyExpression(mapper.StorageKeys[0], reconstructor))
+	require.Equal(t, "1", mapper.ValueExpression(mapper.StorageKeys[0], reconstructor))
+	require.Equal(t, "str:list.item|u32:1", mapper.KeyExpression(mapper.StorageKeys[1], reconstructor))
+
+	linkedList := Decode(map[string][]byte{
+		"queue.info": u32(0, 0, 0, 0),
+	}, nil)[0]
+	require.Equal(t, "u32:0|u32:0|u32:0|u32:0", linkedList.ValueExpression(linkedList.StorageKeys[0], reconstructor))
+}
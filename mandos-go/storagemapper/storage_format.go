@@ -0,0 +1,197 @@
+package storagemapper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	er "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mandos-go/expression/reconstructor"
+)
+
+const addressLength = 32
+
+// MapperView is a human-readable rendering of a mapper, suitable for JSON output
+type MapperView struct {
+	Name      string
+	Kind      string
+	KeyType   string       `json:",omitempty"`
+	ValueType string       `json:",omitempty"`
+	Value     string       `json:",omitempty"`
+	Entries   []*EntryView `json:",omitempty"`
+}
+
+// EntryView is a human-readable rendering of a mapper entry
+type EntryView struct {
+	Key   string
+	Value string
+}
+
+// Views renders the mappers for human readers
+func Views(mappers []*Mapper, reconstructor *er.ExprReconstructor) []*MapperView {
+	views := make([]*MapperView, 0, len(mappers))
+	for _, mapper := range mappers {
+		views = append(views, mapper.View(reconstructor))
+	}
+	return views
+}
+
+// View renders the mapper for human readers. The entries of vecs are keyed
+// by item index, those of sets and linked lists by node id.
+func (mapper *Mapper) View(reconstructor *er.ExprReconstructor) *MapperView {
+	view := &MapperView{
+		Name:      nameExpression(mapper.Name, reconstructor),
+		Kind:      mapper.Kind.String(),
+		KeyType:   mapper.KeyType,
+		ValueType: mapper.ValueType,
+	}
+
+	if mapper.Kind == SingleValue {
+		view.Value = typedExpression(mapper.Value, mapper.ValueType, reconstructor)
+		return view
+	}
+
+	for _, entry := range mapper.Entries {
+		key := fmt.Sprintf("%d", decodeU32(entry.Key))
+		if mapper.Kind == Map {
+			key = argumentExpression(entry.Key, mapper.KeyType, reconstructor)
+		}
+		view.Entries = append(view.Entries, &EntryView{
+			Key:   key,
+			Value: typedExpression(entry.Value, mapper.ValueType, reconstructor),
+		})
+	}
+	return view
+}
+
+// KeyExpression renders a storage key of the mapper as a mandos expression,
+// e.g. "str:list.item|u32:3"
+func (mapper *Mapper) KeyExpression(storageKey *StorageKey, reconstructor *er.ExprReconstructor) string {
+	identifier, arguments := splitName(storageKey.Name)
+	if len(identifier) == 0 {
+		return nameExpression(storageKey.Key, reconstructor)
+	}
+
+	var parts []string
+	if len(arguments) == 0 {
+		parts = append(parts, "str:"+string(identifier)+storageKey.Suffix)
+	} else {
+		parts = append(parts, "str:"+string(identifier), argumentExpression(arguments, "", reconstructor))
+		if len(storageKey.Suffix) > 0 {
+			parts = append(parts, "str:"+storageKey.Suffix)
+		}
+	}
+
+	switch storageKey.Suffix {
+	case ItemSuffix, NodeLinksSuffix, ValueSuffix:
+		parts = append(parts, fmt.Sprintf("u32:%d", decodeU32(storageKey.Argument)))
+	case NodeIDSuffix, IndexSuffix, MappedSuffix:
+		parts = append(parts, argumentExpression(storageKey.Argument, mapper.elementType(), reconstructor))
+	}
+
+	return strings.Join(parts, "|")
+}
+
+// ValueExpression renders the value stored under a storage key of the mapper
+func (mapper *Mapper) ValueExpression(storageKey *StorageKey, reconstructor *er.ExprReconstructor) string {
+	switch storageKey.Suffix {
+	case LenSuffix, NodeIDSuffix, IndexSuffix:
+		return reconstructor.Reconstruct(storageKey.Value, er.NumberHint)
+	case InfoSuffix, NodeLinksSuffix:
+		fields := decodeU32Fields(storageKey.Value)
+		if len(fields) == 0 {
+			return reconstructor.Reconstruct(storageKey.Value, er.NoHint)
+		}
+		var parts []string
+		for _, field := range fields {
+			parts = append(parts, fmt.Sprintf("u32:%d", field))
+		}
+		return strings.Join(parts, "|")
+	case MappedSuffix:
+		return typedExpression(storageKey.Value, mapper.ValueType, reconstructor)
+	default:
+		if mapper.Kind == Map {
+			// values of the key set of a map are map keys
+			return argumentExpression(storageKey.Value, mapper.KeyType, reconstructor)
+		}
+		return typedExpression(storageKey.Value, mapper.ValueType, reconstructor)
+	}
+}
+
+// elementType is the type of the values used as arguments of the
+// .node_id, .index and .mapped keys
+func (mapper *Mapper) elementType() string {
+	if mapper.Kind == Map {
+		return mapper.KeyType
+	}
+	return mapper.ValueType
+}
+
+// splitName separates the mapper identifier from the encoded arguments of
+// mappers declared with key arguments, e.g. "balance" followed by an address
+func splitName(name []byte) ([]byte, []byte) {
+	for i, b := range name {
+		isIdentifierByte := b == '_' || b == '.' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+		if !isIdentifierByte {
+			return name[:i], name[i:]
+		}
+	}
+	return name, nil
+}
+
+func nameExpression(name []byte, reconstructor *er.ExprReconstructor) string {
+	identifier, arguments := splitName(name)
+	if len(identifier) == 0 {
+		return argumentExpression(name, "", reconstructor)
+	}
+	if len(arguments) == 0 {
+		return "str:" + string(identifier)
+	}
+	return "str:" + string(identifier) + "|" + argumentExpression(arguments, "", reconstructor)
+}
+
+// argumentExpression renders nested-encoded values which are part of
+// storage keys, where the less ambiguous representations are preferred
+func argumentExpression(argument []byte, abiType string, reconstructor *er.ExprReconstructor) string {
+	switch {
+	case abiType == "u32" && len(argument) == 4:
+		return fmt.Sprintf("u32:%d", decodeU32(argument))
+	case abiType == "u64" && len(argument) == 8:
+		return fmt.Sprintf("u64:%d", binary.BigEndian.Uint64(argument))
+	case len(argument) == addressLength:
+		return reconstructor.Reconstruct(argument, er.AddressHint)
+	case isPrintable(argument):
+		return "str:" + string(argument)
+	default:
+		return "0x" + hex.EncodeToString(argument)
+	}
+}
+
+func typedExpression(value []byte, abiType string, reconstructor *er.ExprReconstructor) string {
+	return reconstructor.Reconstruct(value, hintForType(abiType))
+}
+
+func hintForType(abiType string) er.ExprReconstructorHint {
+	switch abiType {
+	case "Address", "ManagedAddress":
+		return er.AddressHint
+	case "BigUint", "u8", "u16", "u32", "u64", "usize", "bool":
+		return er.NumberHint
+	case "TokenIdentifier", "EgldOrEsdtTokenIdentifier", "utf-8 string", "String":
+		return er.StrHint
+	default:
+		return er.NoHint
+	}
+}
+
+func isPrintable(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	for _, b := range data {
+		if b < 32 || b > 126 || b == '|' {
+			return false
+		}
+	}
+	return true
+}
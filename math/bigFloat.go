@@ -0,0 +1,213 @@
+package math
+
+import (
+	"math/big"
+)
+
+// BigFloatPrecision is the mantissa precision, in bits, of every managed big float
+const BigFloatPrecision = 128
+
+// BigFloatRoundingMode is the rounding mode of every managed big float operation
+const BigFloatRoundingMode = big.ToNearestEven
+
+// MaxBigFloatExponent bounds the binary exponent of the managed big floats,
+// so that no operation can ever produce an infinity
+const MaxBigFloatExponent = 1 << 16
+
+// MaxBigFloatDecimals is the maximum number of decimals of the fractional
+// part given to BigFloatFromParts
+const MaxBigFloatDecimals = 18
+
+// NewBigFloat returns a zero big float with the precision and rounding mode of the managed big floats
+func NewBigFloat() *big.Float {
+	return new(big.Float).SetPrec(BigFloatPrecision).SetMode(BigFloatRoundingMode)
+}
+
+// CheckBigFloat returns an error if the value cannot be held by a managed big float
+func CheckBigFloat(value *big.Float) error {
+	if value.Prec() != BigFloatPrecision || value.Mode() != BigFloatRoundingMode {
+		return ErrBigFloatWrongPrecision
+	}
+	if value.IsInf() {
+		return ErrBigFloatExponentOutOfRange
+	}
+	exponent := value.MantExp(nil)
+	if exponent > MaxBigFloatExponent || exponent < -MaxBigFloatExponent {
+		return ErrBigFloatExponentOutOfRange
+	}
+	return nil
+}
+
+// BigFloatFromParts returns integralPart + fractionalPart * 10^exponent, where
+// exponent is between -MaxBigFloatDecimals and 0. A non-zero integral part
+// gives its sign to the whole value, so the fractional part must then be
+// positive; with a zero integral part the fractional part may be negative.
+func BigFloatFromParts(integralPart int64, fractionalPart int64, exponent int32) (*big.Float, error) {
+	if exponent > 0 || exponent < -MaxBigFloatDecimals {
+		return nil, ErrBigFloatInvalidParts
+	}
+	if integralPart != 0 && fractionalPart < 0 {
+		return nil, ErrBigFloatInvalidParts
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exponent)), nil)
+	fractional := big.NewInt(fractionalPart)
+	if new(big.Int).Abs(fractional).Cmp(scale) >= 0 {
+		return nil, ErrBigFloatInvalidParts
+	}
+
+	numerator := new(big.Int).Mul(big.NewInt(integralPart), scale)
+	if integralPart < 0 {
+		numerator.Sub(numerator, fractional)
+	} else {
+		numerator.Add(numerator, fractional)
+	}
+
+	// both operands are exact, so the value is rounded only once
+	result := NewBigFloat().Quo(new(big.Float).SetInt(numerator), new(big.Float).SetInt(scale))
+	return result, CheckBigFloat(result)
+}
+
+// BigFloatFromBigInt returns the value of the big int, rounded to the precision of the managed big floats
+func BigFloatFromBigInt(value *big.Int) (*big.Float, error) {
+	result := NewBigFloat().SetInt(value)
+	return result, CheckBigFloat(result)
+}
+
+// BigFloatAdd returns a + b
+func BigFloatAdd(a *big.Float, b *big.Float) (*big.Float, error) {
+	result := NewBigFloat().Add(a, b)
+	return result, CheckBigFloat(result)
+}
+
+// BigFloatSub returns a - b
+func BigFloatSub(a *big.Float, b *big.Float) (*big.Float, error) {
+	result := NewBigFloat().Sub(a, b)
+	return result, CheckBigFloat(result)
+}
+
+// BigFloatMul returns a * b
+func BigFloatMul(a *big.Float, b *big.Float) (*big.Float, error) {
+	result := NewBigFloat().Mul(a, b)
+	return result, CheckBigFloat(result)
+}
+
+// BigFloatDiv returns a / b
+func BigFloatDiv(a *big.Float, b *big.Float) (*big.Float, error) {
+	if b.Sign() == 0 {
+		return nil, ErrBigFloatDivisionByZero
+	}
+	result := NewBigFloat().Quo(a, b)
+	return result, CheckBigFloat(result)
+}
+
+// BigFloatNeg returns -a
+func BigFloatNeg(a *big.Float) *big.Float {
+	return NewBigFloat().Neg(a)
+}
+
+// BigFloatAbs returns |a|
+func BigFloatAbs(a *big.Float) *big.Float {
+	return NewBigFloat().Abs(a)
+}
+
+// BigFloatSqrt returns the square root of a
+func BigFloatSqrt(a *big.Float) (*big.Float, error) {
+	if a.Sign() < 0 {
+		return nil, ErrBigFloatNegativeSqrt
+	}
+	result := NewBigFloat().Sqrt(a)
+	return result, CheckBigFloat(result)
+}
+
+// BigFloatPow returns a^exponent, computed by repeated squaring with every
+// product rounded, and the number of multiplications performed
+func BigFloatPow(a *big.Float, exponent int32) (*big.Float, uint64, error) {
+	numMultiplications := uint64(0)
+	result := NewBigFloat().SetInt64(1)
+	base := NewBigFloat().Set(a)
+
+	remaining := uint32(exponent)
+	if exponent < 0 {
+		remaining = uint32(-int64(exponent))
+	}
+
+	var err error
+	for remaining > 0 {
+		if remaining&1 == 1 {
+			result, err = BigFloatMul(result, base)
+			if err != nil {
+				return nil, numMultiplications, err
+			}
+			numMultiplications++
+		}
+		remaining >>= 1
+		if remaining > 0 {
+			base, err = BigFloatMul(base, base)
+			if err != nil {
+				return nil, numMultiplications, err
+			}
+			numMultiplications++
+		}
+	}
+
+	if exponent < 0 {
+		result, err = BigFloatDiv(NewBigFloat().SetInt64(1), result)
+		if err != nil {
+			return nil, numMultiplications, err
+		}
+		numMultiplications++
+	}
+
+	return result, numMultiplications, nil
+}
+
+// BigFloatTruncate returns the integer part of a, rounding towards zero
+func BigFloatTruncate(a *big.Float) *big.Int {
+	result, _ := a.Int(nil)
+	return result
+}
+
+// BigFloatFloor returns the greatest integer less than or equal to a
+func BigFloatFloor(a *big.Float) *big.Int {
+	result, accuracy := a.Int(nil)
+	if accuracy == big.Above {
+		result.Sub(result, big.NewInt(1))
+	}
+	return result
+}
+
+// BigFloatCeil returns the least integer greater than or equal to a
+func BigFloatCeil(a *big.Float) *big.Int {
+	result, accuracy := a.Int(nil)
+	if accuracy == big.Below {
+		result.Add(result, big.NewInt(1))
+	}
+	return result
+}
+
+// EncodeBigFloat serializes the value in the gob encoding of big.Float
+func EncodeBigFloat(value *big.Float) ([]byte, error) {
+	return value.GobEncode()
+}
+
+// DecodeBigFloat deserializes a value produced by EncodeBigFloat, rejecting
+// values which do not have the precision, rounding mode or range of the
+// managed big floats
+func DecodeBigFloat(data []byte) (*big.Float, error) {
+	if len(data) == 0 {
+		return nil, ErrBigFloatWrongPrecision
+	}
+
+	result := new(big.Float)
+	err := result.GobDecode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckBigFloat(result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,181 @@
+package math
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func referenceFloat(value string) *big.Float {
+	result, _, err := big.ParseFloat(value, 10, BigFloatPrecision, BigFloatRoundingMode)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func requireEqualFloats(t *testing.T, expected *big.Float, actual *big.Float) {
+	require.Equal(t, 0, expected.Cmp(actual), "expected %s, got %s", expected.Text('g', 40), actual.Text('g', 40))
+	require.Equal(t, uint(BigFloatPrecision), actual.Prec())
+	require.Equal(t, BigFloatRoundingMode, actual.Mode())
+}
+
+func TestBigFloatFromParts(t *testing.T) {
+	value, err := BigFloatFromParts(3, 25, -2)
+	require.Nil(t, err)
+	requireEqualFloats(t, referenceFloat("3.25"), value)
+
+	value, err = BigFloatFromParts(-3, 1, -1)
+	require.Nil(t, err)
+	requireEqualFloats(t, referenceFloat("-3.1"), value)
+
+	value, err = BigFloatFromParts(0, -5, -1)
+	require.Nil(t, err)
+	requireEqualFloats(t, referenceFloat("-0.5"), value)
+
+	value, err = BigFloatFromParts(7, 0, 0)
+	require.Nil(t, err)
+	requireEqualFloats(t, referenceFloat("7"), value)
+
+	_, err = BigFloatFromParts(1, 5, 1)
+	require.Equal(t, ErrBigFloatInvalidParts, err)
+	_, err = BigFloatFromParts(1, 5, -MaxBigFloatDecimals-1)
+	require.Equal(t, ErrBigFloatInvalidParts, err)
+	_, err = BigFloatFromParts(1, -5, -1)
+	require.Equal(t, ErrBigFloatInvalidParts, err)
+	_, err = BigFloatFromParts(1, 10, -1)
+	require.Equal(t, ErrBigFloatInvalidParts, err)
+}
+
+func TestBigFloatArithmetic_MatchesReference(t *testing.T) {
+	operands := []string{"0", "1", "-1", "0.1", "3.25", "-1234567.890123", "1e-30", "98765432109876543210.5"}
+	for _, x := range operands {
+		for _, y := range operands {
+			a, b := referenceFloat(x), referenceFloat(y)
+
+			sum, err := BigFloatAdd(a, b)
+			require.Nil(t, err)
+			requireEqualFloats(t, NewBigFloat().Add(a, b), sum)
+
+			difference, err := BigFloatSub(a, b)
+			require.Nil(t, err)
+			requireEqualFloats(t, NewBigFloat().Sub(a, b), difference)
+
+			product, err := BigFloatMul(a, b)
+			require.Nil(t, err)
+			requireEqualFloats(t, NewBigFloat().Mul(a, b), product)
+
+			quotient, err := BigFloatDiv(a, b)
+			if b.Sign() == 0 {
+				require.Equal(t, ErrBigFloatDivisionByZero, err)
+				continue
+			}
+			require.Nil(t, err)
+			requireEqualFloats(t, NewBigFloat().Quo(a, b), quotient)
+		}
+
+		a := referenceFloat(x)
+		requireEqualFloats(t, NewBigFloat().Neg(a), BigFloatNeg(a))
+		requireEqualFloats(t, NewBigFloat().Abs(a), BigFloatAbs(a))
+
+		root, err := BigFloatSqrt(a)
+		if a.Sign() < 0 {
+			require.Equal(t, ErrBigFloatNegativeSqrt, err)
+			continue
+		}
+		require.Nil(t, err)
+		requireEqualFloats(t, NewBigFloat().Sqrt(a), root)
+	}
+}
+
+func TestBigFloatPow(t *testing.T) {
+	// exact results
+	result, numMultiplications, err := BigFloatPow(referenceFloat("1.5"), 10)
+	require.Nil(t, err)
+	requireEqualFloats(t, referenceFloat("57.6650390625"), result)
+	require.Equal(t, uint64(5), numMultiplications)
+
+	result, _, err = BigFloatPow(referenceFloat("2"), -3)
+	require.Nil(t, err)
+	requireEqualFloats(t, referenceFloat("0.125"), result)
+
+	result, numMultiplications, err = BigFloatPow(referenceFloat("-3"), 0)
+	require.Nil(t, err)
+	requireEqualFloats(t, referenceFloat("1"), result)
+	require.Equal(t, uint64(0), numMultiplications)
+
+	// inexact results stay within a few units in the last place of the exact value
+	base := referenceFloat("1.0001")
+	result, _, err = BigFloatPow(base, 365)
+	require.Nil(t, err)
+	exact := new(big.Float).SetPrec(4096).SetInt64(1)
+	for i := 0; i < 365; i++ {
+		exact.Mul(exact, base)
+	}
+	relativeError := new(big.Float).SetPrec(4096).Sub(exact, result)
+	relativeError.Quo(relativeError.Abs(relativeError), exact)
+	require.True(t, relativeError.Cmp(big.NewFloat(0).SetMantExp(big.NewFloat(1), -BigFloatPrecision+8)) < 0)
+
+	_, _, err = BigFloatPow(referenceFloat("0"), -1)
+	require.Equal(t, ErrBigFloatDivisionByZero, err)
+	_, _, err = BigFloatPow(referenceFloat("10"), 1<<20)
+	require.Equal(t, ErrBigFloatExponentOutOfRange, err)
+}
+
+func TestBigFloatExponentRange(t *testing.T) {
+	huge := NewBigFloat().SetMantExp(NewBigFloat().SetInt64(1), MaxBigFloatExponent-1)
+	require.Nil(t, CheckBigFloat(huge))
+
+	_, err := BigFloatMul(huge, huge)
+	require.Equal(t, ErrBigFloatExponentOutOfRange, err)
+
+	tiny := NewBigFloat().SetMantExp(NewBigFloat().SetInt64(1), -MaxBigFloatExponent+1)
+	_, err = BigFloatDiv(tiny, huge)
+	require.Equal(t, ErrBigFloatExponentOutOfRange, err)
+
+	require.Equal(t, ErrBigFloatWrongPrecision, CheckBigFloat(big.NewFloat(1)))
+}
+
+func TestBigFloatToBigInt(t *testing.T) {
+	cases := []struct {
+		value    string
+		truncate int64
+		floor    int64
+		ceil     int64
+	}{
+		{"2.5", 2, 2, 3},
+		{"-2.5", -2, -3, -2},
+		{"3", 3, 3, 3},
+		{"-3", -3, -3, -3},
+		{"0.1", 0, 0, 1},
+		{"-0.1", 0, -1, 0},
+	}
+	for _, c := range cases {
+		value := referenceFloat(c.value)
+		require.Equal(t, big.NewInt(c.truncate), BigFloatTruncate(value), c.value)
+		require.Equal(t, big.NewInt(c.floor), BigFloatFloor(value), c.value)
+		require.Equal(t, big.NewInt(c.ceil), BigFloatCeil(value), c.value)
+	}
+}
+
+func TestBigFloatEncoding(t *testing.T) {
+	value := referenceFloat("-1234.5678")
+	encoded, err := EncodeBigFloat(value)
+	require.Nil(t, err)
+
+	decoded, err := DecodeBigFloat(encoded)
+	require.Nil(t, err)
+	requireEqualFloats(t, value, decoded)
+
+	wrongPrecision, _ := big.NewFloat(1.5).GobEncode()
+	_, err = DecodeBigFloat(wrongPrecision)
+	require.Equal(t, ErrBigFloatWrongPrecision, err)
+
+	_, err = DecodeBigFloat(nil)
+	require.Equal(t, ErrBigFloatWrongPrecision, err)
+
+	infinity, _ := NewBigFloat().SetInf(false).GobEncode()
+	_, err = DecodeBigFloat(infinity)
+	require.Equal(t, ErrBigFloatExponentOutOfRange, err)
+}
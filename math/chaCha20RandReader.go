@@ -0,0 +1,68 @@
+package math
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/hkdf"
+)
+
+// RandomSeedLength is the length of the seeds derived for the call frames
+const RandomSeedLength = 32
+
+var frameRandomSeedInfo = []byte("arwen.randomness.frame")
+var chaCha20KeyInfo = []byte("arwen.randomness.chacha20")
+
+type chaCha20RandReader struct {
+	cipher *chacha20.Cipher
+}
+
+// NewChaCha20RandReader creates and returns a new generator which reads the
+// ChaCha20 key stream, under a key derived from the seed with HKDF-SHA256
+func NewChaCha20RandReader(seed []byte) *chaCha20RandReader {
+	key := deriveBytes(seed, chaCha20KeyInfo, chacha20.KeySize)
+	nonce := make([]byte, chacha20.NonceSize)
+
+	// the key and the nonce always have the expected sizes
+	cipher, _ := chacha20.NewUnauthenticatedCipher(key, nonce)
+
+	return &chaCha20RandReader{
+		cipher: cipher,
+	}
+}
+
+// Read generates len(p) random bytes and writes them into p. It always returns len(p) and a nil error.
+func (crr *chaCha20RandReader) Read(p []byte) (n int, err error) {
+	for i := range p {
+		p[i] = 0
+	}
+	crr.cipher.XORKeyStream(p, p)
+	return len(p), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (crr *chaCha20RandReader) IsInterfaceNil() bool {
+	return crr == nil
+}
+
+// DeriveFrameRandomSeed derives the random seed of a call frame from the
+// random seed of the transaction, so that the frames of a transaction never
+// share a stream of randomness
+func DeriveFrameRandomSeed(txRandomSeed []byte, frameIndex uint32, scAddress []byte) []byte {
+	info := make([]byte, 0, len(frameRandomSeedInfo)+4+len(scAddress))
+	info = append(info, frameRandomSeedInfo...)
+	info = append(info, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(info[len(frameRandomSeedInfo):], frameIndex)
+	info = append(info, scAddress...)
+
+	return deriveBytes(txRandomSeed, info, RandomSeedLength)
+}
+
+func deriveBytes(secret []byte, info []byte, length int) []byte {
+	derived := make([]byte, length)
+	// HKDF-SHA256 can expand up to 8160 bytes, far more than ever requested here
+	_, _ = io.ReadFull(hkdf.New(sha256.New, secret, nil, info), derived)
+	return derived
+}
@@ -12,3 +12,18 @@ var ErrSubtractionUnderflow = errors.New("subtraction underflow")
 
 // ErrMultiplicationOverflow is raised when there is an overflow because of the multiplication of two numbers
 var ErrMultiplicationOverflow = errors.New("multiplication overflow")
+
+// ErrBigFloatWrongPrecision is raised when a big float does not have the precision or rounding mode of the managed big floats
+var ErrBigFloatWrongPrecision = errors.New("big float has the wrong precision or rounding mode")
+
+// ErrBigFloatExponentOutOfRange is raised when the exponent of a big float is too large or too small
+var ErrBigFloatExponentOutOfRange = errors.New("big float exponent out of range")
+
+// ErrBigFloatDivisionByZero is raised when a big float is divided by zero
+var ErrBigFloatDivisionByZero = errors.New("big float division by zero")
+
+// ErrBigFloatNegativeSqrt is raised when the square root of a negative big float is requested
+var ErrBigFloatNegativeSqrt = errors.New("square root of a negative big float")
+
+// ErrBigFloatInvalidParts is raised when the parts given to build a big float are not valid
+var ErrBigFloatInvalidParts = errors.New("invalid big float parts")
@@ -60,3 +60,57 @@ func TestBackwardsCompatible(t *testing.T) {
 	randomizer.Read(a)
 	require.Equal(t, "7459d163b20b5b0269ce2211a2cc061cc9e512fdcbe025b0fa359014f6619ed0", hex.EncodeToString(a))
 }
+
+func TestChaCha20RandReader(t *testing.T) {
+	t.Parallel()
+
+	var randomizer *chaCha20RandReader
+	require.True(t, randomizer.IsInterfaceNil())
+	randomizer = NewChaCha20RandReader([]byte("seed"))
+	require.False(t, randomizer.IsInterfaceNil())
+	sameRandomizer := NewChaCha20RandReader([]byte("seed"))
+	otherRandomizer := NewChaCha20RandReader([]byte("other seed"))
+
+	a := make([]byte, 100)
+	n, err := randomizer.Read(a)
+	require.Nil(t, err)
+	require.Equal(t, 100, n)
+	require.NotEqual(t, make([]byte, 100), a)
+
+	// reading in pieces follows the same stream
+	b := make([]byte, 100)
+	_, _ = sameRandomizer.Read(b[:30])
+	_, _ = sameRandomizer.Read(b[30:])
+	require.Equal(t, a, b)
+
+	expected, _ := hex.DecodeString("1d52d1249992aa0be82a2913f4de11f1")
+	require.Equal(t, expected, a[:16])
+
+	c := make([]byte, 100)
+	_, _ = otherRandomizer.Read(c)
+	require.NotEqual(t, a, c)
+
+	_, _ = randomizer.Read(b)
+	require.NotEqual(t, a, b)
+
+	n, err = randomizer.Read(nil)
+	require.Nil(t, err)
+	require.Equal(t, 0, n)
+}
+
+func TestDeriveFrameRandomSeed(t *testing.T) {
+	t.Parallel()
+
+	txSeed := []byte("transaction seed")
+	rootSeed := DeriveFrameRandomSeed(txSeed, 0, []byte("contract"))
+	require.Len(t, rootSeed, RandomSeedLength)
+	require.Equal(t, rootSeed, DeriveFrameRandomSeed(txSeed, 0, []byte("contract")))
+
+	require.NotEqual(t, rootSeed, DeriveFrameRandomSeed(txSeed, 1, []byte("contract")))
+	require.NotEqual(t, rootSeed, DeriveFrameRandomSeed(txSeed, 0, []byte("other contract")))
+	require.NotEqual(t, rootSeed, DeriveFrameRandomSeed([]byte("other seed"), 0, []byte("contract")))
+
+	// the derivation is part of consensus, so it is pinned to known values
+	expected, _ := hex.DecodeString("cfdf6db5eb90e07d669fd39308b46eccc9bc82097d794650e3e5b97e65f42a31")
+	require.Equal(t, expected, rootSeed)
+}
@@ -21,16 +21,56 @@ func (c *CryptoHookMock) Ripemd160(data []byte) ([]byte, error) {
 	return c.Result, c.Err
 }
 
+// Sha512 mocked method
+func (c *CryptoHookMock) Sha512(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Sha3256 mocked method
+func (c *CryptoHookMock) Sha3256(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Blake2b256 mocked method
+func (c *CryptoHookMock) Blake2b256(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Blake2b512 mocked method
+func (c *CryptoHookMock) Blake2b512(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Blake2s256 mocked method
+func (c *CryptoHookMock) Blake2s256(data []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
 // VerifyBLS mocked method
 func (c *CryptoHookMock) VerifyBLS(key []byte, msg []byte, sig []byte) error {
 	return c.Err
 }
 
+// VerifyAggregatedBLS mocked method
+func (c *CryptoHookMock) VerifyAggregatedBLS(keys [][]byte, msg []byte, sig []byte) error {
+	return c.Err
+}
+
+// VerifyAggregatedDistinctMessagesBLS mocked method
+func (c *CryptoHookMock) VerifyAggregatedDistinctMessagesBLS(keys [][]byte, msgs [][]byte, sig []byte) error {
+	return c.Err
+}
+
 // VerifyEd25519 mocked method
 func (c *CryptoHookMock) VerifyEd25519(key []byte, msg []byte, sig []byte) error {
 	return c.Err
 }
 
+// VerifyEd25519Batch mocked method
+func (c *CryptoHookMock) VerifyEd25519Batch(keys [][]byte, msgs [][]byte, sigs [][]byte) (int, error) {
+	return -1, c.Err
+}
+
 // VerifySecp256k1 mocked method
 func (c *CryptoHookMock) VerifySecp256k1(key []byte, msg []byte, sig []byte, hashType uint8) error {
 	return c.Err
@@ -45,3 +85,38 @@ func (c *CryptoHookMock) EncodeSecp256k1DERSignature(r, s []byte) []byte {
 func (c *CryptoHookMock) Ecrecover(hash []byte, recoveryID []byte, r []byte, s []byte) ([]byte, error) {
 	return c.Result, c.Err
 }
+
+// RecoverSecp256k1 mocked method
+func (c *CryptoHookMock) RecoverSecp256k1(messageHash, r, s []byte, v uint8) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// VerifySecp256r1 mocked method
+func (c *CryptoHookMock) VerifySecp256r1(key []byte, msg []byte, sig []byte) error {
+	return c.Err
+}
+
+// Bn254G1Add mocked method
+func (c *CryptoHookMock) Bn254G1Add(a []byte, b []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Bn254G1ScalarMul mocked method
+func (c *CryptoHookMock) Bn254G1ScalarMul(point []byte, scalar []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Bn254PairingCheck mocked method
+func (c *CryptoHookMock) Bn254PairingCheck(pairs []byte) (bool, error) {
+	return c.Err == nil, c.Err
+}
+
+// VerifyGroth16 mocked method
+func (c *CryptoHookMock) VerifyGroth16(vk []byte, proof []byte, publicInputs []byte) error {
+	return c.Err
+}
+
+// IsInterfaceNil mocked method
+func (c *CryptoHookMock) IsInterfaceNil() bool {
+	return c == nil
+}
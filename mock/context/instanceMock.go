@@ -13,6 +13,7 @@ import (
 type InstanceMock struct {
 	Code            []byte
 	Exports         wasmer.ExportsMap
+	Imports         map[string]bool
 	Points          uint64
 	Data            uintptr
 	GasLimit        uint64
@@ -28,6 +29,7 @@ func NewInstanceMock(code []byte) *InstanceMock {
 	return &InstanceMock{
 		Code:            code,
 		Exports:         make(wasmer.ExportsMap),
+		Imports:         make(map[string]bool),
 		Points:          0,
 		Data:            0,
 		GasLimit:        0,
@@ -138,10 +140,16 @@ func (instance *InstanceMock) GetMemory() wasmer.MemoryHandler {
 	return instance.Memory
 }
 
+// AddMockImport marks the function with the provided name as imported by the
+// instance, as if the contract called it
+func (instance *InstanceMock) AddMockImport(name string) {
+	instance.Imports[name] = true
+}
+
 // IsFunctionImported mocked method
 func (instance *InstanceMock) IsFunctionImported(name string) bool {
 	_, ok := instance.Exports[name]
-	return ok
+	return ok || instance.Imports[name]
 }
 
 // GetMockInstance gets the mock instance from the runtime of the provided host
@@ -166,6 +166,11 @@ func (r *RuntimeContextMock) GetOriginalTxHash() []byte {
 	return r.OriginalTxHash
 }
 
+// GetOriginalCallerAddr mocked method
+func (r *RuntimeContextMock) GetOriginalCallerAddr() ([]byte, error) {
+	return r.VMInput.CallerAddr, nil
+}
+
 // ExtractCodeUpgradeFromArgs mocked method
 func (r *RuntimeContextMock) ExtractCodeUpgradeFromArgs() ([]byte, []byte, error) {
 	arguments := r.VMInput.Arguments
@@ -40,6 +40,8 @@ type RuntimeContextWrapper struct {
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	GetOriginalTxHashFunc func() []byte
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	GetOriginalCallerAddrFunc func() ([]byte, error)
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	ExtractCodeUpgradeFromArgsFunc func() ([]byte, []byte, error)
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	SignalUserErrorFunc func(message string)
@@ -187,6 +189,10 @@ func NewRuntimeContextWrapper(inputRuntimeContext *arwen.RuntimeContext) *Runtim
 		return runtimeWrapper.runtimeContext.GetOriginalTxHash()
 	}
 
+	runtimeWrapper.GetOriginalCallerAddrFunc = func() ([]byte, error) {
+		return runtimeWrapper.runtimeContext.GetOriginalCallerAddr()
+	}
+
 	runtimeWrapper.ExtractCodeUpgradeFromArgsFunc = func() ([]byte, []byte, error) {
 		return runtimeWrapper.runtimeContext.ExtractCodeUpgradeFromArgs()
 	}
@@ -428,6 +434,11 @@ func (contextWrapper *RuntimeContextWrapper) GetOriginalTxHash() []byte {
 	return contextWrapper.GetOriginalTxHashFunc()
 }
 
+// GetOriginalCallerAddr calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) GetOriginalCallerAddr() ([]byte, error) {
+	return contextWrapper.GetOriginalCallerAddrFunc()
+}
+
 // ExtractCodeUpgradeFromArgs calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
 func (contextWrapper *RuntimeContextWrapper) ExtractCodeUpgradeFromArgs() ([]byte, []byte, error) {
 	return contextWrapper.ExtractCodeUpgradeFromArgsFunc()
@@ -205,6 +205,106 @@ func (host *VMHostMock) CreateNFTOnExecByCallerEnabled() bool {
 	return true
 }
 
+// SecureRandomnessEnabled mocked method
+func (host *VMHostMock) SecureRandomnessEnabled() bool {
+	return true
+}
+
+// AsyncCallESDTPaymentEnabled mocked method
+func (host *VMHostMock) AsyncCallESDTPaymentEnabled() bool {
+	return true
+}
+
+// ExtendedStorageLocksEnabled mocked method
+func (host *VMHostMock) ExtendedStorageLocksEnabled() bool {
+	return true
+}
+
+// ManagedMapEnabled mocked method
+func (host *VMHostMock) ManagedMapEnabled() bool {
+	return true
+}
+
+// ManagedVecEnabled mocked method
+func (host *VMHostMock) ManagedVecEnabled() bool {
+	return true
+}
+
+// BigFloatEnabled mocked method
+func (host *VMHostMock) BigFloatEnabled() bool {
+	return true
+}
+
+// BigIntModularEnabled mocked method
+func (host *VMHostMock) BigIntModularEnabled() bool {
+	return true
+}
+
+// ManagedBufferStringsEnabled mocked method
+func (host *VMHostMock) ManagedBufferStringsEnabled() bool {
+	return true
+}
+
+// ManagedMemoryFreeEnabled mocked method
+func (host *VMHostMock) ManagedMemoryFreeEnabled() bool {
+	return true
+}
+
+// ExtendedHashingEnabled mocked method
+func (host *VMHostMock) ExtendedHashingEnabled() bool {
+	return true
+}
+
+// Secp256ExtensionsEnabled mocked method
+func (host *VMHostMock) Secp256ExtensionsEnabled() bool {
+	return true
+}
+
+// AggregatedBLSEnabled mocked method
+func (host *VMHostMock) AggregatedBLSEnabled() bool {
+	return true
+}
+
+// BN254Enabled mocked method
+func (host *VMHostMock) BN254Enabled() bool {
+	return true
+}
+
+// Ed25519BatchEnabled mocked method
+func (host *VMHostMock) Ed25519BatchEnabled() bool {
+	return true
+}
+
+// MerkleProofEnabled mocked method
+func (host *VMHostMock) MerkleProofEnabled() bool {
+	return true
+}
+
+// AccountInfoEnabled mocked method
+func (host *VMHostMock) AccountInfoEnabled() bool {
+	return true
+}
+
+// TxContextEnabled mocked method
+func (host *VMHostMock) TxContextEnabled() bool {
+	return true
+}
+
+// FixAsyncCallbackResolutionEnabled mocked method
+func (host *VMHostMock) FixAsyncCallbackResolutionEnabled() bool {
+	return true
+}
+
+// NestedCallsGasPriceEnabled mocked method
+func (host *VMHostMock) NestedCallsGasPriceEnabled() bool {
+	return true
+}
+
+// ManagedMemoryLimitsEnabled mocked method
+func (host *VMHostMock) ManagedMemoryLimitsEnabled() bool {
+	return true
+}
+
 // Close -
 func (host *VMHostMock) Close() error {
 	return nil
@@ -43,7 +43,27 @@ type VMHostStub struct {
 	SetRuntimeContextCalled func(runtime arwen.RuntimeContext)
 	GetContextsCalled       func() (arwen.ManagedTypesContext, arwen.BlockchainContext, arwen.MeteringContext, arwen.OutputContext, arwen.RuntimeContext, arwen.StorageContext)
 
-	SetBuiltInFunctionsContainerCalled func(builtInFuncs vmcommon.BuiltInFunctionContainer)
+	SetBuiltInFunctionsContainerCalled      func(builtInFuncs vmcommon.BuiltInFunctionContainer)
+	SecureRandomnessEnabledCalled           func() bool
+	AsyncCallESDTPaymentEnabledCalled       func() bool
+	ExtendedStorageLocksEnabledCalled       func() bool
+	ManagedMapEnabledCalled                 func() bool
+	ManagedVecEnabledCalled                 func() bool
+	BigFloatEnabledCalled                   func() bool
+	BigIntModularEnabledCalled              func() bool
+	ManagedBufferStringsEnabledCalled       func() bool
+	ManagedMemoryFreeEnabledCalled          func() bool
+	ExtendedHashingEnabledCalled            func() bool
+	Secp256ExtensionsEnabledCalled          func() bool
+	AggregatedBLSEnabledCalled              func() bool
+	BN254EnabledCalled                      func() bool
+	Ed25519BatchEnabledCalled               func() bool
+	MerkleProofEnabledCalled                func() bool
+	AccountInfoEnabledCalled                func() bool
+	TxContextEnabledCalled                  func() bool
+	FixAsyncCallbackResolutionEnabledCalled func() bool
+	NestedCallsGasPriceEnabledCalled        func() bool
+	ManagedMemoryLimitsEnabledCalled        func() bool
 }
 
 // GetVersion mocked method
@@ -303,6 +323,166 @@ func (vhs *VMHostStub) CreateNFTOnExecByCallerEnabled() bool {
 	return true
 }
 
+// SecureRandomnessEnabled mocked method
+func (vhs *VMHostStub) SecureRandomnessEnabled() bool {
+	if vhs.SecureRandomnessEnabledCalled != nil {
+		return vhs.SecureRandomnessEnabledCalled()
+	}
+	return true
+}
+
+// AsyncCallESDTPaymentEnabled mocked method
+func (vhs *VMHostStub) AsyncCallESDTPaymentEnabled() bool {
+	if vhs.AsyncCallESDTPaymentEnabledCalled != nil {
+		return vhs.AsyncCallESDTPaymentEnabledCalled()
+	}
+	return true
+}
+
+// ExtendedStorageLocksEnabled mocked method
+func (vhs *VMHostStub) ExtendedStorageLocksEnabled() bool {
+	if vhs.ExtendedStorageLocksEnabledCalled != nil {
+		return vhs.ExtendedStorageLocksEnabledCalled()
+	}
+	return true
+}
+
+// ManagedMapEnabled mocked method
+func (vhs *VMHostStub) ManagedMapEnabled() bool {
+	if vhs.ManagedMapEnabledCalled != nil {
+		return vhs.ManagedMapEnabledCalled()
+	}
+	return true
+}
+
+// ManagedVecEnabled mocked method
+func (vhs *VMHostStub) ManagedVecEnabled() bool {
+	if vhs.ManagedVecEnabledCalled != nil {
+		return vhs.ManagedVecEnabledCalled()
+	}
+	return true
+}
+
+// BigFloatEnabled mocked method
+func (vhs *VMHostStub) BigFloatEnabled() bool {
+	if vhs.BigFloatEnabledCalled != nil {
+		return vhs.BigFloatEnabledCalled()
+	}
+	return true
+}
+
+// BigIntModularEnabled mocked method
+func (vhs *VMHostStub) BigIntModularEnabled() bool {
+	if vhs.BigIntModularEnabledCalled != nil {
+		return vhs.BigIntModularEnabledCalled()
+	}
+	return true
+}
+
+// ManagedBufferStringsEnabled mocked method
+func (vhs *VMHostStub) ManagedBufferStringsEnabled() bool {
+	if vhs.ManagedBufferStringsEnabledCalled != nil {
+		return vhs.ManagedBufferStringsEnabledCalled()
+	}
+	return true
+}
+
+// ManagedMemoryFreeEnabled mocked method
+func (vhs *VMHostStub) ManagedMemoryFreeEnabled() bool {
+	if vhs.ManagedMemoryFreeEnabledCalled != nil {
+		return vhs.ManagedMemoryFreeEnabledCalled()
+	}
+	return true
+}
+
+// ExtendedHashingEnabled mocked method
+func (vhs *VMHostStub) ExtendedHashingEnabled() bool {
+	if vhs.ExtendedHashingEnabledCalled != nil {
+		return vhs.ExtendedHashingEnabledCalled()
+	}
+	return true
+}
+
+// Secp256ExtensionsEnabled mocked method
+func (vhs *VMHostStub) Secp256ExtensionsEnabled() bool {
+	if vhs.Secp256ExtensionsEnabledCalled != nil {
+		return vhs.Secp256ExtensionsEnabledCalled()
+	}
+	return true
+}
+
+// AggregatedBLSEnabled mocked method
+func (vhs *VMHostStub) AggregatedBLSEnabled() bool {
+	if vhs.AggregatedBLSEnabledCalled != nil {
+		return vhs.AggregatedBLSEnabledCalled()
+	}
+	return true
+}
+
+// BN254Enabled mocked method
+func (vhs *VMHostStub) BN254Enabled() bool {
+	if vhs.BN254EnabledCalled != nil {
+		return vhs.BN254EnabledCalled()
+	}
+	return true
+}
+
+// Ed25519BatchEnabled mocked method
+func (vhs *VMHostStub) Ed25519BatchEnabled() bool {
+	if vhs.Ed25519BatchEnabledCalled != nil {
+		return vhs.Ed25519BatchEnabledCalled()
+	}
+	return true
+}
+
+// MerkleProofEnabled mocked method
+func (vhs *VMHostStub) MerkleProofEnabled() bool {
+	if vhs.MerkleProofEnabledCalled != nil {
+		return vhs.MerkleProofEnabledCalled()
+	}
+	return true
+}
+
+// AccountInfoEnabled mocked method
+func (vhs *VMHostStub) AccountInfoEnabled() bool {
+	if vhs.AccountInfoEnabledCalled != nil {
+		return vhs.AccountInfoEnabledCalled()
+	}
+	return true
+}
+
+// TxContextEnabled mocked method
+func (vhs *VMHostStub) TxContextEnabled() bool {
+	if vhs.TxContextEnabledCalled != nil {
+		return vhs.TxContextEnabledCalled()
+	}
+	return true
+}
+
+// FixAsyncCallbackResolutionEnabled mocked method
+func (vhs *VMHostStub) FixAsyncCallbackResolutionEnabled() bool {
+	if vhs.FixAsyncCallbackResolutionEnabledCalled != nil {
+		return vhs.FixAsyncCallbackResolutionEnabledCalled()
+	}
+	return true
+}
+
+// NestedCallsGasPriceEnabled mocked method
+func (vhs *VMHostStub) NestedCallsGasPriceEnabled() bool {
+	if vhs.NestedCallsGasPriceEnabledCalled != nil {
+		return vhs.NestedCallsGasPriceEnabledCalled()
+	}
+	return true
+}
+
+// ManagedMemoryLimitsEnabled mocked method
+func (vhs *VMHostStub) ManagedMemoryLimitsEnabled() bool {
+	if vhs.ManagedMemoryLimitsEnabledCalled != nil {
+		return vhs.ManagedMemoryLimitsEnabledCalled()
+	}
+	return true
+}
+
 // Close -
 func (vhs *VMHostStub) Close() error {
 	return nil
@@ -0,0 +1,692 @@
+package worldmock
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+)
+
+// SmartContractResult is a transfer produced by an execution in one shard,
+// waiting to be delivered to the shard of its receiver.
+type SmartContractResult struct {
+	Hash             []byte
+	PrevTxHash       []byte
+	OriginalTxHash   []byte
+	SenderShard      uint32
+	DestinationShard uint32
+	Sender           []byte
+	Receiver         []byte
+	Value            *big.Int
+	Data             []byte
+	GasLimit         uint64
+	GasLocked        uint64
+	GasPrice         uint64
+	CallType         vm.CallType
+
+	ReturnCallAfterError bool
+}
+
+// SimulatedShard is the view of a single shard: its own MockWorld, in which
+// the accounts of the other shards only appear with their shard ID, and the VM
+// executing the transactions and smart contract results received by the shard.
+type SimulatedShard struct {
+	ID     uint32
+	World  *MockWorld
+	VM     vmcommon.VMExecutionHandler
+	paused bool
+}
+
+// SimulatedExecution records a transaction or a smart contract result
+// executed by the simulator, together with its outcome.
+type SimulatedExecution struct {
+	Round    uint64
+	ShardID  uint32
+	SCR      *SmartContractResult
+	Input    *vmcommon.ContractCallInput
+	VMOutput *vmcommon.VMOutput
+	Err      error
+}
+
+// Succeeded returns true if the execution ended without error and with the Ok return code
+func (execution *SimulatedExecution) Succeeded() bool {
+	return execution.Err == nil && execution.VMOutput != nil && execution.VMOutput.ReturnCode == vmcommon.Ok
+}
+
+// MultiShardSimulator executes transactions on several shards and delivers
+// the resulting cross-shard transfers, async calls and callbacks to their
+// destination shards, round by round, playing the role of the protocol.
+type MultiShardSimulator struct {
+	Shards     []*SimulatedShard
+	Executions []*SimulatedExecution
+
+	shardOrder         []uint32
+	pending            []*SmartContractResult
+	round              uint64
+	numTxs             uint64
+	numResults         uint64
+	esdtTransferParser vmcommon.ESDTTransferParser
+}
+
+// NewMultiShardSimulator creates a simulator with the given number of shards,
+// each with an empty MockWorld and no VM.
+func NewMultiShardSimulator(numShards uint32) *MultiShardSimulator {
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(WorldMarshalizer)
+	sim := &MultiShardSimulator{
+		Shards:             make([]*SimulatedShard, numShards),
+		Executions:         make([]*SimulatedExecution, 0),
+		shardOrder:         make([]uint32, numShards),
+		pending:            make([]*SmartContractResult, 0),
+		esdtTransferParser: esdtTransferParser,
+	}
+
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		world := NewMockWorld()
+		world.SelfShardID = shardID
+		world.MultiShard = true
+		sim.Shards[shardID] = &SimulatedShard{
+			ID:    shardID,
+			World: world,
+		}
+		sim.shardOrder[shardID] = shardID
+	}
+
+	return sim
+}
+
+// Shard returns the shard with the given ID, or nil if it doesn't exist
+func (sim *MultiShardSimulator) Shard(shardID uint32) *SimulatedShard {
+	if shardID >= uint32(len(sim.Shards)) {
+		return nil
+	}
+
+	return sim.Shards[shardID]
+}
+
+// CreateAccount creates an empty account in the given shard.
+func (sim *MultiShardSimulator) CreateAccount(address []byte, shardID uint32) *Account {
+	world := sim.Shards[shardID].World
+	return world.AcctMap.CreateAccount(address, world)
+}
+
+// CreateSmartContractAccount creates a smart contract account in the given shard.
+func (sim *MultiShardSimulator) CreateSmartContractAccount(owner []byte, address []byte, code []byte, shardID uint32) *Account {
+	world := sim.Shards[shardID].World
+	return world.AcctMap.CreateSmartContractAccount(owner, address, code, world)
+}
+
+// GetAccount returns the account with the given address from its own shard,
+// or nil if no shard holds it.
+func (sim *MultiShardSimulator) GetAccount(address []byte) *Account {
+	for _, shard := range sim.Shards {
+		account := shard.World.AcctMap.GetAccount(address)
+		if account != nil && account.ShardID == shard.ID {
+			return account
+		}
+	}
+
+	return nil
+}
+
+// SetShardOrder sets the order in which the shards receive their smart
+// contract results during a round; shards left out receive nothing.
+func (sim *MultiShardSimulator) SetShardOrder(shardIDs ...uint32) {
+	sim.shardOrder = shardIDs
+}
+
+// PauseShard stops the delivery of smart contract results to the given shard,
+// which keeps them pending until resumed.
+func (sim *MultiShardSimulator) PauseShard(shardID uint32) {
+	sim.Shards[shardID].paused = true
+}
+
+// ResumeShard restarts the delivery of smart contract results to the given shard.
+func (sim *MultiShardSimulator) ResumeShard(shardID uint32) {
+	sim.Shards[shardID].paused = false
+}
+
+// CurrentRound returns the number of rounds executed so far.
+func (sim *MultiShardSimulator) CurrentRound() uint64 {
+	return sim.round
+}
+
+// PendingResults returns the smart contract results waiting to be delivered
+// to the given shard, oldest first.
+func (sim *MultiShardSimulator) PendingResults(shardID uint32) []*SmartContractResult {
+	results := make([]*SmartContractResult, 0)
+	for _, scr := range sim.pending {
+		if scr.DestinationShard == shardID {
+			results = append(results, scr)
+		}
+	}
+
+	return results
+}
+
+// NumPendingResults returns the number of smart contract results waiting to
+// be delivered to any shard.
+func (sim *MultiShardSimulator) NumPendingResults() int {
+	return len(sim.pending)
+}
+
+// RunTx executes a transaction in the shard of its receiver and queues the
+// smart contract results it produces for the other shards; nothing is
+// delivered until the next round.
+func (sim *MultiShardSimulator) RunTx(input *vmcommon.ContractCallInput) (*SimulatedExecution, error) {
+	sim.syncAccountShards()
+
+	shard := sim.Shards[sim.shardOf(input.RecipientAddr, 0)]
+	if shard.VM == nil {
+		return nil, ErrNilShardVM
+	}
+
+	sim.numTxs++
+	if len(input.OriginalTxHash) == 0 {
+		input.OriginalTxHash = []byte(fmt.Sprintf("tx-%d", sim.numTxs))
+	}
+	if len(input.CurrentTxHash) == 0 {
+		input.CurrentTxHash = input.OriginalTxHash
+	}
+
+	execution := &SimulatedExecution{
+		Round:   sim.round,
+		ShardID: shard.ID,
+		Input:   input,
+	}
+	sim.Executions = append(sim.Executions, execution)
+
+	shard.World.CreateStateBackup()
+	execution.VMOutput, execution.Err = shard.VM.RunSmartContractCall(input)
+	if !execution.Succeeded() {
+		return execution, shard.World.RollbackChanges()
+	}
+
+	sender := sim.GetAccount(input.CallerAddr)
+	if sender != nil && input.CallValue != nil {
+		sender.Balance = big.NewInt(0).Sub(sender.Balance, input.CallValue)
+	}
+
+	err := sim.applyOutput(shard, input, execution.VMOutput)
+	if err != nil {
+		return execution, err
+	}
+
+	return execution, shard.World.CommitChanges()
+}
+
+// RunRound delivers the smart contract results that were pending when the
+// round started, shard by shard in the configured order and oldest first
+// within a shard. The results produced during the round wait for the next one.
+// It returns the number of delivered results.
+func (sim *MultiShardSimulator) RunRound() (int, error) {
+	sim.round++
+
+	ready := sim.pending
+	sim.pending = make([]*SmartContractResult, 0)
+
+	delivered := make(map[*SmartContractResult]bool)
+	for _, shardID := range sim.shardOrder {
+		shard := sim.Shard(shardID)
+		if shard == nil {
+			return len(delivered), ErrShardNotFound
+		}
+		if shard.paused {
+			continue
+		}
+
+		for _, scr := range ready {
+			if scr.DestinationShard != shardID {
+				continue
+			}
+
+			delivered[scr] = true
+			err := sim.deliver(scr)
+			if err != nil {
+				return len(delivered), err
+			}
+		}
+	}
+
+	leftover := make([]*SmartContractResult, 0, len(ready)-len(delivered))
+	for _, scr := range ready {
+		if !delivered[scr] {
+			leftover = append(leftover, scr)
+		}
+	}
+	sim.pending = append(leftover, sim.pending...)
+
+	return len(delivered), nil
+}
+
+// DeliverNext delivers the oldest smart contract result pending for the given
+// shard, regardless of rounds and pauses; it returns false if there was none.
+func (sim *MultiShardSimulator) DeliverNext(shardID uint32) (bool, error) {
+	for i, scr := range sim.pending {
+		if scr.DestinationShard != shardID {
+			continue
+		}
+
+		sim.pending = append(sim.pending[:i:i], sim.pending[i+1:]...)
+		return true, sim.deliver(scr)
+	}
+
+	return false, nil
+}
+
+// RunUntilQuiescent runs rounds until no smart contract result can be
+// delivered anymore, which leaves pending only the results of paused shards.
+// It returns the number of rounds executed.
+func (sim *MultiShardSimulator) RunUntilQuiescent(maxRounds int) (int, error) {
+	numRounds := 0
+	for sim.hasDeliverableResults() {
+		if numRounds == maxRounds {
+			return numRounds, ErrNotQuiescent
+		}
+
+		_, err := sim.RunRound()
+		if err != nil {
+			return numRounds, err
+		}
+		numRounds++
+	}
+
+	return numRounds, nil
+}
+
+func (sim *MultiShardSimulator) hasDeliverableResults() bool {
+	for _, scr := range sim.pending {
+		shard := sim.Shard(scr.DestinationShard)
+		if shard != nil && !shard.paused && sim.isInShardOrder(shard.ID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (sim *MultiShardSimulator) isInShardOrder(shardID uint32) bool {
+	for _, orderedShardID := range sim.shardOrder {
+		if orderedShardID == shardID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deliver executes a smart contract result in its destination shard, the way
+// the protocol does: built-in payments are processed first, then the called
+// function, if any, is executed by the VM. A failed execution is rolled back
+// and its payment is returned to the sender, for async calls together with
+// the error callback.
+func (sim *MultiShardSimulator) deliver(scr *SmartContractResult) error {
+	sim.syncAccountShards()
+
+	shard := sim.Shard(scr.DestinationShard)
+	if shard == nil {
+		return ErrShardNotFound
+	}
+	if shard.VM == nil {
+		return ErrNilShardVM
+	}
+
+	function, arguments := parseResultData(scr.Data)
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:     scr.Sender,
+			Arguments:      arguments,
+			CallValue:      scr.Value,
+			CallType:       scr.CallType,
+			GasPrice:       scr.GasPrice,
+			GasProvided:    scr.GasLimit,
+			GasLocked:      scr.GasLocked,
+			OriginalTxHash: scr.OriginalTxHash,
+			CurrentTxHash:  scr.Hash,
+			PrevTxHash:     scr.PrevTxHash,
+			ESDTTransfers:  make([]*vmcommon.ESDTTransfer, 0),
+
+			ReturnCallAfterError: scr.ReturnCallAfterError,
+		},
+		RecipientAddr: scr.Receiver,
+		Function:      function,
+	}
+
+	execution := &SimulatedExecution{
+		Round:   sim.round,
+		ShardID: shard.ID,
+		SCR:     scr,
+		Input:   input,
+	}
+	sim.Executions = append(sim.Executions, execution)
+
+	shard.World.CreateStateBackup()
+
+	callInput := input
+	var paymentData []byte
+	if sim.isBuiltinFunction(shard, function) {
+		paymentData, callInput = sim.processBuiltinPayment(shard, execution)
+	}
+
+	if callInput != nil {
+		execution.VMOutput, execution.Err = sim.executeInShard(shard, callInput)
+	}
+
+	if !execution.Succeeded() {
+		err := shard.World.RollbackChanges()
+		if err != nil {
+			return err
+		}
+
+		sim.returnFailedResult(scr, paymentData, execution)
+		return nil
+	}
+
+	err := sim.applyOutput(shard, execution.Input, execution.VMOutput)
+	if err != nil {
+		return err
+	}
+
+	return shard.World.CommitChanges()
+}
+
+// processBuiltinPayment credits the payment of a built-in function call, then
+// returns the raw payment data and the input of the function called after the
+// payment, which is nil if no function follows or the payment failed
+func (sim *MultiShardSimulator) processBuiltinPayment(
+	shard *SimulatedShard,
+	execution *SimulatedExecution,
+) ([]byte, *vmcommon.ContractCallInput) {
+	input := execution.Input
+	parsedTransfer, err := sim.esdtTransferParser.ParseESDTTransfers(input.CallerAddr, input.RecipientAddr, input.Function, input.Arguments)
+	if err != nil {
+		parsedTransfer = nil
+	}
+
+	isCallback := input.CallType == vm.AsynchronousCallBack
+	numPaymentArgs := len(input.Arguments)
+	if parsedTransfer != nil && (len(parsedTransfer.CallFunction) > 0 || isCallback) {
+		numPaymentArgs -= len(parsedTransfer.CallArgs) + 1
+	}
+	if numPaymentArgs > len(input.Arguments) {
+		numPaymentArgs = len(input.Arguments)
+	}
+	paymentData := makeResultData(input.Function, input.Arguments[:numPaymentArgs])
+
+	builtinOutput, err := shard.World.ProcessBuiltInFunction(input)
+	if err != nil || builtinOutput.ReturnCode != vmcommon.Ok {
+		execution.VMOutput, execution.Err = builtinOutput, err
+		return paymentData, nil
+	}
+
+	if parsedTransfer == nil {
+		execution.VMOutput = makeTransferOutput(nil, nil, builtinOutput.GasRemaining)
+		return paymentData, nil
+	}
+
+	hasCallAfter := len(parsedTransfer.CallFunction) > 0 || isCallback
+	if !hasCallAfter || !sim.hasCode(shard, parsedTransfer.RcvAddr) {
+		execution.VMOutput = makeTransferOutput(nil, nil, builtinOutput.GasRemaining)
+		return paymentData, nil
+	}
+
+	callInput := &vmcommon.ContractCallInput{
+		VMInput:       input.VMInput,
+		RecipientAddr: parsedTransfer.RcvAddr,
+		Function:      parsedTransfer.CallFunction,
+	}
+	callInput.Arguments = parsedTransfer.CallArgs
+	callInput.ESDTTransfers = parsedTransfer.ESDTTransfers
+	callInput.GasProvided = gasForCallAfterBuiltin(builtinOutput, parsedTransfer.RcvAddr)
+	execution.Input = callInput
+
+	return paymentData, callInput
+}
+
+// executeInShard runs a call on the VM of the shard, unless the receiver has
+// no code, in which case the call value is simply credited
+func (sim *MultiShardSimulator) executeInShard(shard *SimulatedShard, input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	if !sim.hasCode(shard, input.RecipientAddr) {
+		return makeTransferOutput(input.RecipientAddr, input.CallValue, 0), nil
+	}
+
+	if input.CallType == vm.AsynchronousCallBack && len(input.Function) == 0 {
+		input.Function = arwen.CallbackFunctionName
+	}
+
+	return shard.VM.RunSmartContractCall(input)
+}
+
+// returnFailedResult sends back to the sender of a failed smart contract
+// result its built-in payment and its value; a failed async call returns them
+// together with the error callback, so that the payment reaches the caller
+// even if it is not payable
+func (sim *MultiShardSimulator) returnFailedResult(scr *SmartContractResult, paymentData []byte, execution *SimulatedExecution) {
+	if scr.CallType == vm.AsynchronousCallBack || scr.ReturnCallAfterError {
+		return
+	}
+
+	if scr.CallType == vm.AsynchronousCall {
+		returnCode := vmcommon.ExecutionFailed
+		returnMessage := ""
+		if execution.VMOutput != nil {
+			returnCode = execution.VMOutput.ReturnCode
+			returnMessage = execution.VMOutput.ReturnMessage
+		}
+		if execution.Err != nil {
+			returnMessage = execution.Err.Error()
+		}
+
+		callbackData := makeResultData("", [][]byte{big.NewInt(int64(returnCode)).Bytes(), []byte(returnMessage)})
+		if len(paymentData) > 0 {
+			callbackData = append(append(paymentData, '@'), callbackData...)
+		}
+
+		sim.queueResult(&SmartContractResult{
+			PrevTxHash:     scr.Hash,
+			OriginalTxHash: scr.OriginalTxHash,
+			SenderShard:    scr.DestinationShard,
+			Sender:         scr.Receiver,
+			Receiver:       scr.Sender,
+			Value:          scr.Value,
+			Data:           callbackData,
+			GasLimit:       scr.GasLocked,
+			GasPrice:       scr.GasPrice,
+			CallType:       vm.AsynchronousCallBack,
+
+			ReturnCallAfterError: true,
+		})
+		return
+	}
+
+	if len(paymentData) == 0 && (scr.Value == nil || scr.Value.Sign() == 0) {
+		return
+	}
+
+	sim.queueResult(&SmartContractResult{
+		PrevTxHash:     scr.Hash,
+		OriginalTxHash: scr.OriginalTxHash,
+		SenderShard:    scr.DestinationShard,
+		Sender:         scr.Receiver,
+		Receiver:       scr.Sender,
+		Value:          scr.Value,
+		Data:           paymentData,
+		GasPrice:       scr.GasPrice,
+		CallType:       vm.DirectCall,
+
+		ReturnCallAfterError: true,
+	})
+}
+
+// applyOutput updates the world of the shard with the accounts it holds and
+// queues the output transfers towards the accounts of the other shards
+func (sim *MultiShardSimulator) applyOutput(shard *SimulatedShard, input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) error {
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	localAccounts := make(map[string]*vmcommon.OutputAccount)
+	for _, address := range addresses {
+		outputAccount := vmOutput.OutputAccounts[address]
+		destinationShard := sim.shardOf(outputAccount.Address, shard.ID)
+		if destinationShard == shard.ID {
+			localAccounts[address] = outputAccount
+			continue
+		}
+
+		for _, outputTransfer := range outputAccount.OutputTransfers {
+			sender := outputTransfer.SenderAddress
+			if len(sender) == 0 {
+				sender = input.RecipientAddr
+			}
+
+			sim.queueResult(&SmartContractResult{
+				PrevTxHash:       input.CurrentTxHash,
+				OriginalTxHash:   input.OriginalTxHash,
+				SenderShard:      shard.ID,
+				DestinationShard: destinationShard,
+				Sender:           sender,
+				Receiver:         outputAccount.Address,
+				Value:            outputTransfer.Value,
+				Data:             outputTransfer.Data,
+				GasLimit:         outputTransfer.GasLimit,
+				GasLocked:        outputTransfer.GasLocked,
+				GasPrice:         input.GasPrice,
+				CallType:         outputTransfer.CallType,
+			})
+		}
+	}
+
+	return shard.World.UpdateAccounts(localAccounts, vmOutput.DeletedAccounts)
+}
+
+func (sim *MultiShardSimulator) queueResult(scr *SmartContractResult) {
+	sim.numResults++
+	scr.Hash = []byte(fmt.Sprintf("scr-%d", sim.numResults))
+	scr.DestinationShard = sim.shardOf(scr.Receiver, scr.SenderShard)
+	if scr.Value == nil {
+		scr.Value = big.NewInt(0)
+	}
+
+	sim.pending = append(sim.pending, scr)
+}
+
+// shardOf returns the shard holding the account with the given address, or
+// the default shard if no shard holds it
+func (sim *MultiShardSimulator) shardOf(address []byte, defaultShardID uint32) uint32 {
+	account := sim.GetAccount(address)
+	if account == nil {
+		return defaultShardID
+	}
+
+	return account.ShardID
+}
+
+// syncAccountShards makes every account known to the worlds of the other
+// shards, without balance, storage or code, so that each shard can tell
+// where an address belongs
+func (sim *MultiShardSimulator) syncAccountShards() {
+	for _, shard := range sim.Shards {
+		for _, account := range shard.World.AcctMap {
+			if account.ShardID != shard.ID {
+				continue
+			}
+
+			for _, otherShard := range sim.Shards {
+				if otherShard.ID == shard.ID {
+					continue
+				}
+
+				otherWorld := otherShard.World
+				foreignAccount := otherWorld.AcctMap.GetAccount(account.Address)
+				if foreignAccount == nil {
+					foreignAccount = otherWorld.AcctMap.CreateAccount(account.Address, otherWorld)
+				}
+				foreignAccount.ShardID = account.ShardID
+				foreignAccount.IsSmartContract = account.IsSmartContract
+			}
+		}
+	}
+}
+
+func (sim *MultiShardSimulator) hasCode(shard *SimulatedShard, address []byte) bool {
+	account := shard.World.AcctMap.GetAccount(address)
+	return account != nil && account.ShardID == shard.ID && len(account.Code) > 0
+}
+
+func (sim *MultiShardSimulator) isBuiltinFunction(shard *SimulatedShard, function string) bool {
+	builtinFuncs := shard.World.BuiltinFuncs
+	if builtinFuncs == nil || len(function) == 0 {
+		return false
+	}
+
+	_, err := builtinFuncs.Container.Get(function)
+	return err == nil
+}
+
+func parseResultData(data []byte) (string, [][]byte) {
+	if len(data) == 0 {
+		return "", make([][]byte, 0)
+	}
+
+	tokens := strings.Split(string(data), "@")
+	arguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		argument, err := hex.DecodeString(token)
+		if err != nil {
+			argument = []byte(token)
+		}
+		arguments = append(arguments, argument)
+	}
+
+	return tokens[0], arguments
+}
+
+func makeResultData(function string, arguments [][]byte) []byte {
+	data := function
+	for _, argument := range arguments {
+		data += "@" + hex.EncodeToString(argument)
+	}
+
+	return []byte(data)
+}
+
+// gasForCallAfterBuiltin returns the gas forwarded by a built-in function to
+// the function called on the receiver, which the built-in function passes on
+// as an output transfer, like the host does for intra-shard calls
+func gasForCallAfterBuiltin(builtinOutput *vmcommon.VMOutput, receiver []byte) uint64 {
+	outputAccount, ok := builtinOutput.OutputAccounts[string(receiver)]
+	if !ok || len(outputAccount.OutputTransfers) != 1 {
+		return builtinOutput.GasRemaining
+	}
+
+	return outputAccount.OutputTransfers[0].GasLimit
+}
+
+func makeTransferOutput(receiver []byte, value *big.Int, gasRemaining uint64) *vmcommon.VMOutput {
+	outputAccounts := make(map[string]*vmcommon.OutputAccount)
+	if value != nil && value.Sign() > 0 {
+		outputAccounts[string(receiver)] = &vmcommon.OutputAccount{
+			Address:      receiver,
+			BalanceDelta: value,
+		}
+	}
+
+	return &vmcommon.VMOutput{
+		ReturnData:      make([][]byte, 0),
+		ReturnCode:      vmcommon.Ok,
+		GasRemaining:    gasRemaining,
+		GasRefund:       big.NewInt(0),
+		OutputAccounts:  outputAccounts,
+		DeletedAccounts: make([][]byte, 0),
+		TouchedAccounts: make([][]byte, 0),
+		Logs:            make([]*vmcommon.LogEntry, 0),
+	}
+}
@@ -18,8 +18,14 @@ func NewAccountMap() AccountMap {
 	return make(AccountMap)
 }
 
-// CreateAccount instantiates an empty account for the given address.
+// CreateAccount instantiates an empty account for the given address; a
+// MultiShard world creates it in its own shard.
 func (am AccountMap) CreateAccount(address []byte, world *MockWorld) *Account {
+	shardID := uint32(0)
+	if world != nil && world.MultiShard {
+		shardID = world.SelfShardID
+	}
+
 	newAccount := &Account{
 		Exists:          true,
 		Address:         make([]byte, len(address)),
@@ -29,7 +35,7 @@ func (am AccountMap) CreateAccount(address []byte, world *MockWorld) *Account {
 		Storage:         make(map[string][]byte),
 		Code:            nil,
 		OwnerAddress:    nil,
-		ShardID:         0,
+		ShardID:         shardID,
 		IsSmartContract: false,
 		DeveloperReward: big.NewInt(0),
 		MockWorld:       world,
@@ -229,6 +229,10 @@ func (b *MockWorld) GetCode(acc vmcommon.UserAccountHandler) []byte {
 
 // GetShardOfAddress -
 func (b *MockWorld) GetShardOfAddress(address []byte) uint32 {
+	if b.MultiShard {
+		return b.computeSimulatedShardID(address)
+	}
+
 	account := b.AcctMap.GetAccount(address)
 	if account == nil {
 		return 0
@@ -258,6 +262,11 @@ func (b *MockWorld) IsPayable(sndAddress []byte, rcvAddress []byte) (bool, error
 		return true, nil
 	}
 
+	// the accounts of the other shards are only checked in their own shard
+	if b.MultiShard && account.ShardID != b.SelfShardID {
+		return true, nil
+	}
+
 	metadata := vmcommon.CodeMetadataFromBytes(account.CodeMetadata)
 	if core.IsSmartContractAddress(sndAddress) {
 		return metadata.PayableBySC || metadata.Payable, nil
@@ -33,8 +33,12 @@ func (bi *BlockInfo) GetRandomSeedSlice() []byte {
 }
 
 // MockWorld provides a mock representation of the blockchain to be used in VM tests.
+// A world with MultiShard set plays the role of one shard of a
+// MultiShardSimulator: new accounts are created in its own shard and unknown
+// addresses are considered to be in its own shard as well.
 type MockWorld struct {
 	SelfShardID                uint32
+	MultiShard                 bool
 	AcctMap                    AccountMap
 	AccountsAdapter            vmcommon.AccountsAdapter
 	PreviousBlockInfo          *BlockInfo
@@ -112,9 +116,24 @@ func (b *MockWorld) NumberOfShards() uint32 {
 
 // ComputeId -
 func (b *MockWorld) ComputeId(address []byte) uint32 {
+	if b.MultiShard {
+		return b.computeSimulatedShardID(address)
+	}
+
 	return b.AcctMap.GetAccount(address).ShardID
 }
 
+// computeSimulatedShardID returns the shard of the given address; unknown
+// addresses are considered to be in the current shard, where they would be created
+func (b *MockWorld) computeSimulatedShardID(address []byte) uint32 {
+	account := b.AcctMap.GetAccount(address)
+	if account == nil {
+		return b.SelfShardID
+	}
+
+	return account.ShardID
+}
+
 // SelfId -
 func (b *MockWorld) SelfId() uint32 {
 	return b.SelfShardID
@@ -122,6 +141,10 @@ func (b *MockWorld) SelfId() uint32 {
 
 // SameShard -
 func (b *MockWorld) SameShard(firstAddress []byte, secondAddress []byte) bool {
+	if b.MultiShard {
+		return b.computeSimulatedShardID(firstAddress) == b.computeSimulatedShardID(secondAddress)
+	}
+
 	firstAccount := b.AcctMap.GetAccount(firstAddress)
 	secondAccount := b.AcctMap.GetAccount(secondAddress)
 	return firstAccount.ShardID == secondAccount.ShardID
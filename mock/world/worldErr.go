@@ -8,3 +8,13 @@ var ErrInsufficientFunds = errors.New("insufficient funds")
 
 // ErrNilWorldMock signals that the WorldMock is nil but shouldn't be.
 var ErrNilWorldMock = errors.New("nil worldmock")
+
+// ErrShardNotFound signals that the simulator has no shard with the given ID.
+var ErrShardNotFound = errors.New("shard not found")
+
+// ErrNilShardVM signals that a shard of the simulator has no VM to execute on.
+var ErrNilShardVM = errors.New("nil VM for shard")
+
+// ErrNotQuiescent signals that the shards were still exchanging smart contract
+// results after the maximum number of rounds.
+var ErrNotQuiescent = errors.New("shards not quiescent after the maximum number of rounds")
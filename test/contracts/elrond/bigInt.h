@@ -37,4 +37,9 @@ void      bigIntSetSignedBytes(bigInt destinationHandle, byte *byte, int byteLen
 void      bigIntGetESDTCallValue(bigInt destinationHandle);
 void      bigIntGetESDTExternalBalance(byte *addressOffset, byte *tokenIDOffset, unsigned int tokenIDLen, long long nonce, bigInt result);
 
+void      bigIntModPow(bigInt destinationHandle, bigInt base, bigInt exponent, bigInt modulus);
+void      bigIntModInverse(bigInt destinationHandle, bigInt op, bigInt modulus);
+void      bigIntGCD(bigInt destinationHandle, bigInt op1, bigInt op2);
+void      bigIntExtendedGCD(bigInt gcdHandle, bigInt xHandle, bigInt yHandle, bigInt op1, bigInt op2);
+
 #endif
@@ -39,11 +39,83 @@ int	mBufferToBigIntUnsigned(int mBufferHandle, int bigIntHandle);
 int mBufferToBigIntSigned(int mBufferHandle, int bigIntHandle);
 int	mBufferFromBigIntUnsigned(int mBufferHandle, int bigIntHandle);
 int	mBufferFromBigIntSigned(int mBufferHandle, int bigIntHandle);
+int	managedModPow(int baseHandle, int exponentHandle, int modulusHandle, int resultHandle);
+int	managedModInverse(int opHandle, int modulusHandle, int resultHandle);
+int	managedGCD(int op1Handle, int op2Handle, int resultHandle);
+int	mBufferCompare(int mBufferHandle1, int mBufferHandle2);
+int	mBufferFind(int mBufferHandle, int patternHandle, int startPosition);
+int	mBufferFindByte(int mBufferHandle, int value, int startPosition);
+int	mBufferSplit(int mBufferHandle, int separatorHandle, int destinationHandle);
+int	mBufferParseDecimal(int mBufferHandle, int decimals, int bigIntHandle);
+int	mBufferFormatDecimal(int bigIntHandle, int decimals, int destinationHandle);
+int	mBufferToBase64(int sourceHandle, int destinationHandle);
+int	mBufferFromBase64(int sourceHandle, int destinationHandle);
+int	mBufferFromHex(int sourceHandle, int destinationHandle);
+int	mBufferTopEncodeU64(int destinationHandle, long long value);
+long long	mBufferTopDecodeU64(int sourceHandle);
+int	mBufferNestedEncodeU64(int accumulatorHandle, long long value);
+int	mBufferNestedEncodeBigUint(int accumulatorHandle, int bigIntHandle);
+int	mBufferNestedEncodeBigInt(int accumulatorHandle, int bigIntHandle);
+int	mBufferNestedDecodeBigUint(int sourceHandle, int position, int bigIntHandle);
+int	mBufferNestedDecodeBigInt(int sourceHandle, int position, int bigIntHandle);
 int	mBufferStorageStore(int keyHandle, int mBufferHandle);
 int	mBufferStorageLoad(int keyHandle, int mBufferHandle);
 int	mBufferGetArgument(int id, int mBufferHandle);
 int	mBufferFinish(int mBufferHandle);
 
+// Managed Maps
+int	mMapNew();
+int	mMapPut(int mMapHandle, int keyHandle, int valueHandle);
+int	mMapGet(int mMapHandle, int keyHandle, int outValueHandle);
+int	mMapContains(int mMapHandle, int keyHandle);
+int	mMapRemove(int mMapHandle, int keyHandle, int outValueHandle);
+int	mMapLen(int mMapHandle);
+int	mMapKeys(int mMapHandle, int destinationHandle);
+
+// Managed Vecs
+int	mVecNew();
+int	mVecPush(int mVecHandle, int itemHandle);
+int	mVecGet(int mVecHandle, int index);
+int	mVecSet(int mVecHandle, int index, int itemHandle);
+int	mVecRemove(int mVecHandle, int index);
+int	mVecLen(int mVecHandle);
+int	mVecSlice(int mVecHandle, int startIndex, int endIndex, int destinationHandle);
+int	mVecConcat(int accumulatorHandle, int otherHandle);
+int	mVecPushBigInt(int mVecHandle, int bigIntHandle);
+int	mVecGetBigInt(int mVecHandle, int index, int destinationHandle);
+int	mVecSetBigInt(int mVecHandle, int index, int bigIntHandle);
+int	mVecPushU64(int mVecHandle, long long value);
+long long	mVecGetU64(int mVecHandle, int index);
+int	mVecSetU64(int mVecHandle, int index, long long value);
+int	mVecRemoveU64(int mVecHandle, int index);
+int	mVecLenU64(int mVecHandle);
+int	mVecSliceU64(int mVecHandle, int startIndex, int endIndex, int destinationHandle);
+
+// Releasing managed values
+int	bigIntFree(int bigIntHandle);
+int	bigFloatFree(int bigFloatHandle);
+int	mBufferFree(int mBufferHandle);
+int	mMapFree(int mMapHandle);
+int	freeEC(int ecHandle);
+
+// Big Floats
+int	bigFloatNewFromParts(long long integralPart, long long fractionalPart, int exponent);
+void	bigFloatAdd(int destinationHandle, int op1Handle, int op2Handle);
+void	bigFloatSub(int destinationHandle, int op1Handle, int op2Handle);
+void	bigFloatMul(int destinationHandle, int op1Handle, int op2Handle);
+void	bigFloatDiv(int destinationHandle, int op1Handle, int op2Handle);
+void	bigFloatNeg(int destinationHandle, int opHandle);
+void	bigFloatAbs(int destinationHandle, int opHandle);
+int	bigFloatCmp(int op1Handle, int op2Handle);
+void	bigFloatSqrt(int destinationHandle, int opHandle);
+void	bigFloatPow(int destinationHandle, int opHandle, int exponent);
+void	bigFloatTruncate(int opHandle, int bigIntHandle);
+void	bigFloatFloor(int opHandle, int bigIntHandle);
+void	bigFloatCeil(int opHandle, int bigIntHandle);
+void	bigFloatSetBigInt(int destinationHandle, int bigIntHandle);
+int	mBufferToBigFloat(int mBufferHandle, int bigFloatHandle);
+int	mBufferFromBigFloat(int mBufferHandle, int bigFloatHandle);
+
 // Call-related functions
 void getCaller(byte *callerAddress);
 int getFunction(byte *function);
@@ -147,6 +219,14 @@ int setStorageLock(byte *key, int keyLen, long long timeLock);
 long long getStorageLock(byte *key, int keyLen);
 int isStorageLocked(byte *key, int keyLen);
 int clearStorageLock(byte *key, int keyLen);
+int setStorageLockByNonce(byte *key, int keyLen, long long lockNonce);
+long long getStorageLockByNonce(byte *key, int keyLen);
+int isStorageLockedByNonce(byte *key, int keyLen);
+int clearStorageLockByNonce(byte *key, int keyLen);
+int setStorageLockByRound(byte *key, int keyLen, long long lockRound);
+long long getStorageLockByRound(byte *key, int keyLen);
+int isStorageLockedByRound(byte *key, int keyLen);
+int clearStorageLockByRound(byte *key, int keyLen);
 
 // ESDT-related functions
 int getESDTTokenName(byte *name);
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
 	contextmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -93,6 +94,19 @@ func (callerTest *InstancesTestTemplate) WithWasmerSIGSEGVPassthrough(wasmerSIGS
 	return callerTest
 }
 
+// WithCrypto provides the crypto implementation of the host, e.g. a decorated one
+func (callerTest *InstancesTestTemplate) WithCrypto(vmCrypto crypto.VMCrypto) *InstancesTestTemplate {
+	callerTest.vmCrypto = vmCrypto
+	return callerTest
+}
+
+// WithEnableEpochs changes the enable epochs of the host, e.g. to test the
+// behavior before a feature is enabled
+func (callerTest *InstancesTestTemplate) WithEnableEpochs(setEnableEpochs func(*arwen.VMHostParameters)) *InstancesTestTemplate {
+	callerTest.setEnableEpochs = setEnableEpochs
+	return callerTest
+}
+
 // AndAssertResults starts the test and asserts the results
 func (callerTest *InstancesTestTemplate) AndAssertResults(assertResults func(arwen.VMHost, *contextmock.BlockchainHookStub, *VMOutputVerifier)) {
 	callerTest.assertResults = assertResults
@@ -100,7 +114,7 @@ func (callerTest *InstancesTestTemplate) AndAssertResults(assertResults func(arw
 }
 
 func runTestWithInstances(callerTest *InstancesTestTemplate) {
-	host, blockchainHookStub := defaultTestArwenForContracts(callerTest.tb, callerTest.contracts, callerTest.gasSchedule, callerTest.wasmerSIGSEGVPassthrough)
+	host, blockchainHookStub := defaultTestArwenForContracts(callerTest.tb, callerTest.contracts, callerTest.gasSchedule, callerTest.wasmerSIGSEGVPassthrough, callerTest.vmCrypto, callerTest.setEnableEpochs)
 	defer func() {
 		host.Reset()
 	}()
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
 	mock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -14,12 +15,15 @@ type testTemplateConfig struct {
 	input                    *vmcommon.ContractCallInput
 	useMocks                 bool
 	wasmerSIGSEGVPassthrough bool
+	vmCrypto                 crypto.VMCrypto
+	setEnableEpochs          func(*arwen.VMHostParameters)
 }
 
 // MockInstancesTestTemplate holds the data to build a mock contract call test
 type MockInstancesTestTemplate struct {
 	testTemplateConfig
 	contracts     *[]MockTestSmartContract
+	createInput   *vmcommon.ContractCreateInput
 	setup         func(arwen.VMHost, *worldmock.MockWorld)
 	assertResults func(*worldmock.MockWorld, *VMOutputVerifier)
 }
@@ -48,6 +52,13 @@ func (callerTest *MockInstancesTestTemplate) WithInput(input *vmcommon.ContractC
 	return callerTest
 }
 
+// WithCreateInput provides the ContractCreateInput to be used by the mock
+// contract test, which then deploys a contract instead of calling one
+func (callerTest *MockInstancesTestTemplate) WithCreateInput(input *vmcommon.ContractCreateInput) *MockInstancesTestTemplate {
+	callerTest.createInput = input
+	return callerTest
+}
+
 // WithSetup provides the setup function to be used by the mock contract call test
 func (callerTest *MockInstancesTestTemplate) WithSetup(setup func(arwen.VMHost, *worldmock.MockWorld)) *MockInstancesTestTemplate {
 	callerTest.setup = setup
@@ -60,6 +71,19 @@ func (callerTest *MockInstancesTestTemplate) WithWasmerSIGSEGVPassthrough(wasmer
 	return callerTest
 }
 
+// WithCrypto provides the crypto implementation of the host, e.g. a decorated one
+func (callerTest *MockInstancesTestTemplate) WithCrypto(vmCrypto crypto.VMCrypto) *MockInstancesTestTemplate {
+	callerTest.vmCrypto = vmCrypto
+	return callerTest
+}
+
+// WithEnableEpochs changes the enable epochs of the host, e.g. to test the
+// behavior before a feature is enabled
+func (callerTest *MockInstancesTestTemplate) WithEnableEpochs(setEnableEpochs func(*arwen.VMHostParameters)) *MockInstancesTestTemplate {
+	callerTest.setEnableEpochs = setEnableEpochs
+	return callerTest
+}
+
 // AndAssertResults provides the function that will aserts the results
 func (callerTest *MockInstancesTestTemplate) AndAssertResults(assertResults func(world *worldmock.MockWorld, verify *VMOutputVerifier)) {
 	callerTest.assertResults = assertResults
@@ -67,7 +91,7 @@ func (callerTest *MockInstancesTestTemplate) AndAssertResults(assertResults func
 }
 
 func (callerTest *MockInstancesTestTemplate) runTest() {
-	host, world, imb := DefaultTestArwenForCallWithInstanceMocks(callerTest.tb)
+	host, world, imb := defaultTestArwenForCallWithInstanceMocks(callerTest.tb, callerTest.vmCrypto, callerTest.setEnableEpochs)
 	defer func() {
 		host.Reset()
 	}()
@@ -80,7 +104,13 @@ func (callerTest *MockInstancesTestTemplate) runTest() {
 	// create snapshot (normaly done by node)
 	world.CreateStateBackup()
 
-	vmOutput, err := host.RunSmartContractCall(callerTest.input)
+	var vmOutput *vmcommon.VMOutput
+	var err error
+	if callerTest.createInput != nil {
+		vmOutput, err = host.RunSmartContractCreate(callerTest.createInput)
+	} else {
+		vmOutput, err = host.RunSmartContractCall(callerTest.input)
+	}
 
 	allErrors := host.Runtime().GetAllErrors()
 	verify := NewVMOutputVerifierWithAllErrors(callerTest.tb, vmOutput, err, allErrors)
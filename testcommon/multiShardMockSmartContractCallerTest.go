@@ -0,0 +1,121 @@
+package testcommon
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
+	contextmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
+	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+// DefaultMaxRoundsForMultiShardTests is the number of rounds after which a
+// multi-shard test gives up waiting for the shards to become quiescent
+const DefaultMaxRoundsForMultiShardTests = 100
+
+// MultiShardMockInstancesTestTemplate holds the data to build a mock contract
+// call test executed end to end by a MultiShardSimulator
+type MultiShardMockInstancesTestTemplate struct {
+	tb            testing.TB
+	numShards     uint32
+	input         *vmcommon.ContractCallInput
+	contracts     *[]MockTestSmartContract
+	setup         func(*worldmock.MultiShardSimulator)
+	delivery      func(*worldmock.MultiShardSimulator)
+	assertResults func(*worldmock.MultiShardSimulator, *VMOutputVerifier)
+}
+
+// BuildMultiShardMockInstanceCallTest starts the building process for a mock
+// contract call test executed on the given number of shards
+func BuildMultiShardMockInstanceCallTest(tb testing.TB, numShards uint32) *MultiShardMockInstancesTestTemplate {
+	return &MultiShardMockInstancesTestTemplate{
+		tb:        tb,
+		numShards: numShards,
+		contracts: &[]MockTestSmartContract{},
+		setup:     func(*worldmock.MultiShardSimulator) {},
+		delivery: func(sim *worldmock.MultiShardSimulator) {
+			_, err := sim.RunUntilQuiescent(DefaultMaxRoundsForMultiShardTests)
+			require.Nil(tb, err)
+		},
+	}
+}
+
+// WithContracts provides the contracts to be used by the test, each deployed on its own shard
+func (callerTest *MultiShardMockInstancesTestTemplate) WithContracts(usedContracts ...MockTestSmartContract) *MultiShardMockInstancesTestTemplate {
+	callerTest.contracts = &usedContracts
+	return callerTest
+}
+
+// WithInput provides the ContractCallInput of the transaction starting the test
+func (callerTest *MultiShardMockInstancesTestTemplate) WithInput(input *vmcommon.ContractCallInput) *MultiShardMockInstancesTestTemplate {
+	callerTest.input = input
+	return callerTest
+}
+
+// WithSetup provides the setup function to be used by the test
+func (callerTest *MultiShardMockInstancesTestTemplate) WithSetup(setup func(*worldmock.MultiShardSimulator)) *MultiShardMockInstancesTestTemplate {
+	callerTest.setup = setup
+	return callerTest
+}
+
+// WithDelivery replaces the default delivery of the smart contract results,
+// which runs rounds until the shards are quiescent
+func (callerTest *MultiShardMockInstancesTestTemplate) WithDelivery(delivery func(*worldmock.MultiShardSimulator)) *MultiShardMockInstancesTestTemplate {
+	callerTest.delivery = delivery
+	return callerTest
+}
+
+// AndAssertResults provides the function that asserts the results, given the
+// output of the transaction starting the test
+func (callerTest *MultiShardMockInstancesTestTemplate) AndAssertResults(assertResults func(sim *worldmock.MultiShardSimulator, verify *VMOutputVerifier)) {
+	callerTest.assertResults = assertResults
+	callerTest.runTest()
+}
+
+func (callerTest *MultiShardMockInstancesTestTemplate) runTest() {
+	sim, instanceBuilders := DefaultTestArwenForMultiShardWithInstanceMocks(callerTest.tb, callerTest.numShards)
+	defer func() {
+		for _, shard := range sim.Shards {
+			shard.VM.(arwen.VMHost).Reset()
+		}
+	}()
+
+	for _, mockSC := range *callerTest.contracts {
+		host := sim.Shards[mockSC.shardID].VM.(arwen.VMHost)
+		mockSC.initialize(callerTest.tb, host, instanceBuilders[mockSC.shardID])
+	}
+
+	callerTest.setup(sim)
+
+	execution, err := sim.RunTx(callerTest.input)
+	require.Nil(callerTest.tb, err)
+
+	callerTest.delivery(sim)
+
+	allErrors := sim.Shards[execution.ShardID].VM.(arwen.VMHost).Runtime().GetAllErrors()
+	verify := NewVMOutputVerifierWithAllErrors(callerTest.tb, execution.VMOutput, execution.Err, allErrors)
+	callerTest.assertResults(sim, verify)
+}
+
+// DefaultTestArwenForMultiShardWithInstanceMocks creates a MultiShardSimulator
+// with a host using an InstanceBuilderMock on each shard
+func DefaultTestArwenForMultiShardWithInstanceMocks(tb testing.TB, numShards uint32) (*worldmock.MultiShardSimulator, []*contextmock.InstanceBuilderMock) {
+	sim := worldmock.NewMultiShardSimulator(numShards)
+	instanceBuilders := make([]*contextmock.InstanceBuilderMock, numShards)
+
+	for _, shard := range sim.Shards {
+		world := shard.World
+		host := defaultTestArwen(tb, world, nil, false, nil, nil)
+
+		err := world.InitBuiltinFunctions(host.GetGasScheduleMap())
+		require.Nil(tb, err)
+		host.SetBuiltInFunctionsContainer(world.BuiltinFuncs.Container)
+
+		instanceBuilders[shard.ID] = contextmock.NewInstanceBuilderMock(world)
+		host.Runtime().ReplaceInstanceBuilder(instanceBuilders[shard.ID])
+		shard.VM = host
+	}
+
+	return sim, instanceBuilders
+}
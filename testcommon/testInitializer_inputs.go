@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen"
 	arwenHost "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/arwen/host"
 	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/arwen-wasm-vm/v1_4/crypto"
 	contextmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/context"
 	worldmock "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/mock/world"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
@@ -54,6 +55,10 @@ var ESDTTransferGasCost = uint64(1)
 // ESDTTestTokenName is an exposed value to use in tests
 var ESDTTestTokenName = []byte("TTT-010101")
 
+// UnreachedEpochForTests is an enable epoch which the test hosts never reach,
+// since they only confirm epoch 0
+const UnreachedEpochForTests = ^uint32(0)
+
 // DefaultCodeMetadata is an exposed value to use in tests
 var DefaultCodeMetadata = []byte{3, 0}
 
@@ -177,8 +182,16 @@ func DefaultTestArwenForCallWithInstanceRecorderMock(tb testing.TB, code []byte,
 
 // DefaultTestArwenForCallWithInstanceMocks creates an InstanceBuilderMock
 func DefaultTestArwenForCallWithInstanceMocks(tb testing.TB) (arwen.VMHost, *worldmock.MockWorld, *contextmock.InstanceBuilderMock) {
+	return defaultTestArwenForCallWithInstanceMocks(tb, nil, nil)
+}
+
+func defaultTestArwenForCallWithInstanceMocks(
+	tb testing.TB,
+	vmCrypto crypto.VMCrypto,
+	setEnableEpochs func(*arwen.VMHostParameters),
+) (arwen.VMHost, *worldmock.MockWorld, *contextmock.InstanceBuilderMock) {
 	world := worldmock.NewMockWorld()
-	host := DefaultTestArwen(tb, world)
+	host := defaultTestArwen(tb, world, nil, false, vmCrypto, setEnableEpochs)
 
 	instanceBuilderMock := contextmock.NewInstanceBuilderMock(world)
 	host.Runtime().ReplaceInstanceBuilder(instanceBuilderMock)
@@ -255,6 +268,8 @@ func defaultTestArwenForContracts(
 	contracts []*InstanceTestSmartContract,
 	gasSchedule config.GasScheduleMap,
 	wasmerSIGSEGVPassthrough bool,
+	vmCrypto crypto.VMCrypto,
+	setEnableEpochs func(*arwen.VMHostParameters),
 ) (arwen.VMHost, *contextmock.BlockchainHookStub) {
 
 	stubBlockchainHook := &contextmock.BlockchainHookStub{}
@@ -287,7 +302,7 @@ func defaultTestArwenForContracts(
 		return nil
 	}
 
-	host := DefaultTestArwenWithGasSchedule(tb, stubBlockchainHook, gasSchedule, wasmerSIGSEGVPassthrough)
+	host := defaultTestArwen(tb, stubBlockchainHook, gasSchedule, wasmerSIGSEGVPassthrough, vmCrypto, setEnableEpochs)
 	return host, stubBlockchainHook
 }
 
@@ -309,14 +324,16 @@ func DefaultTestArwenWithWorldMockWithGasSchedule(tb testing.TB, customGasSchedu
 
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
 	host, err := arwenHost.NewArwenVM(world, &arwen.VMHostParameters{
-		VMType:                   DefaultVMType,
-		BlockGasLimit:            uint64(1000),
-		GasSchedule:              gasSchedule,
-		BuiltInFuncContainer:     world.BuiltinFuncs.Container,
-		ElrondProtectedKeyPrefix: []byte("ELROND"),
-		ESDTTransferParser:       esdtTransferParser,
-		EpochNotifier:            &worldmock.EpochNotifierStub{},
-		WasmerSIGSEGVPassthrough: false,
+		VMType:                         DefaultVMType,
+		BlockGasLimit:                  uint64(1000),
+		GasSchedule:                    gasSchedule,
+		BuiltInFuncContainer:           world.BuiltinFuncs.Container,
+		ElrondProtectedKeyPrefix:       []byte("ELROND"),
+		ESDTTransferParser:             esdtTransferParser,
+		EpochNotifier:                  &worldmock.EpochNotifierStub{},
+		WasmerSIGSEGVPassthrough:       false,
+		ManagedMemoryLimitsEnableEpoch: UnreachedEpochForTests,
+		SecureRandomnessEnableEpoch:    UnreachedEpochForTests,
 	})
 	require.Nil(tb, err)
 	require.NotNil(tb, host)
@@ -335,6 +352,23 @@ func DefaultTestArwenWithGasSchedule(
 	blockchain vmcommon.BlockchainHook,
 	customGasSchedule config.GasScheduleMap,
 	wasmerSIGSEGVPassthrough bool,
+) arwen.VMHost {
+	return defaultTestArwen(tb, blockchain, customGasSchedule, wasmerSIGSEGVPassthrough, nil, nil)
+}
+
+// DefaultTestArwenWithCrypto creates a host configured with a configured
+// blockchain hook and with the given crypto implementation, e.g. a decorated one
+func DefaultTestArwenWithCrypto(tb testing.TB, blockchain vmcommon.BlockchainHook, vmCrypto crypto.VMCrypto) arwen.VMHost {
+	return defaultTestArwen(tb, blockchain, nil, false, vmCrypto, nil)
+}
+
+func defaultTestArwen(
+	tb testing.TB,
+	blockchain vmcommon.BlockchainHook,
+	customGasSchedule config.GasScheduleMap,
+	wasmerSIGSEGVPassthrough bool,
+	vmCrypto crypto.VMCrypto,
+	setEnableEpochs func(*arwen.VMHostParameters),
 ) arwen.VMHost {
 	gasSchedule := customGasSchedule
 	if gasSchedule == nil {
@@ -342,7 +376,7 @@ func DefaultTestArwenWithGasSchedule(
 	}
 
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
-	host, err := arwenHost.NewArwenVM(blockchain, &arwen.VMHostParameters{
+	hostParameters := &arwen.VMHostParameters{
 		VMType:                   DefaultVMType,
 		BlockGasLimit:            uint64(1000),
 		GasSchedule:              gasSchedule,
@@ -352,7 +386,15 @@ func DefaultTestArwenWithGasSchedule(
 		EpochNotifier:            &worldmock.EpochNotifierStub{},
 		WasmerSIGSEGVPassthrough: wasmerSIGSEGVPassthrough,
 		UseDifferentGasCostForReadingCachedStorageEpoch: 0,
-	})
+		ManagedMemoryLimitsEnableEpoch:                  UnreachedEpochForTests,
+		SecureRandomnessEnableEpoch:                     UnreachedEpochForTests,
+		VMCrypto:                                        vmCrypto,
+	}
+	if setEnableEpochs != nil {
+		setEnableEpochs(hostParameters)
+	}
+
+	host, err := arwenHost.NewArwenVM(blockchain, hostParameters)
 	require.Nil(tb, err)
 	require.NotNil(tb, host)
 
@@ -462,6 +504,18 @@ func (contractInput *ContractCallInputBuilder) WithCurrentTxHash(txHash []byte)
 	return contractInput
 }
 
+// WithOriginalTxHash provides the OriginalTxHash for ContractCallInputBuilder
+func (contractInput *ContractCallInputBuilder) WithOriginalTxHash(txHash []byte) *ContractCallInputBuilder {
+	contractInput.ContractCallInput.OriginalTxHash = txHash
+	return contractInput
+}
+
+// WithGasPrice provides the gas price of ContractCallInputBuilder
+func (contractInput *ContractCallInputBuilder) WithGasPrice(gasPrice uint64) *ContractCallInputBuilder {
+	contractInput.ContractCallInput.VMInput.GasPrice = gasPrice
+	return contractInput
+}
+
 func (contractInput *ContractCallInputBuilder) initESDTTransferIfNeeded() {
 	if len(contractInput.ESDTTransfers) == 0 {
 		contractInput.ESDTTransfers = make([]*vmcommon.ESDTTransfer, 1)